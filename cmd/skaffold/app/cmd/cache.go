@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+var cacheFlags = struct {
+	cacheFile string
+	output    string
+}{}
+
+var pruneFlags = cache.PruneOptions{}
+
+// for testing
+var (
+	listCacheEntries = cache.ListEntries
+	pruneCache       = cache.Prune
+	clearCache       = cache.Clear
+)
+
+// NewCmdCache describes the CLI command to inspect and clean up the artifact cache.
+func NewCmdCache() *cobra.Command {
+	return NewCmd("cache").
+		WithDescription("Inspect and clean up the cache of built artifacts").
+		WithPersistentFlagAdder(cmdCacheFlags).
+		WithCommands(cmdCacheList(), cmdCachePrune(), cmdCacheClear())
+}
+
+func cmdCacheFlags(f *pflag.FlagSet) {
+	f.StringVar(&cacheFlags.cacheFile, "cache-file", "", "Specify the location of the cache file (default $HOME/.skaffold/cache)")
+	f.StringVarP(&cacheFlags.output, "output", "o", "plain", "Type of output: `plain` or `json`.")
+}
+
+func cmdCacheList() *cobra.Command {
+	return NewCmd("list [ARTIFACT...]").
+		WithDescription("List the entries of the artifact cache").
+		WithLongDescription("List the entries of the artifact cache for the given artifacts, or all the entries if no artifact is given.").
+		WithExample("List the cached artifacts and whether their images still exist", "cache list").
+		WithExample("List the cached entries of an artifact", "cache list leeroy-web").
+		WithExample("List the cached artifacts in json format", "cache list -o json").
+		WithArgs(cobra.ArbitraryArgs, listCache)
+}
+
+func cmdCachePrune() *cobra.Command {
+	return NewCmd("prune").
+		WithDescription("Remove entries from the artifact cache").
+		WithLongDescription("Remove the entries of the artifact cache that match any of the given criteria. Entries created by older versions of Skaffold have no creation time and are considered the oldest.").
+		WithExample("Remove the entries older than a week", "cache prune --older-than 168h").
+		WithExample("Keep only the 100 most recent entries", "cache prune --keep 100").
+		WithExample("Remove the entries for images that no longer exist", "cache prune --missing").
+		WithFlagAdder(cmdCachePruneFlags).
+		NoArgs(doPruneCache)
+}
+
+func cmdCachePruneFlags(f *pflag.FlagSet) {
+	f.DurationVar(&pruneFlags.OlderThan, "older-than", 0, "Remove the entries older than this duration")
+	f.IntVar(&pruneFlags.KeepLatest, "keep", 0, "Remove all but this number of most recent entries")
+	f.BoolVar(&pruneFlags.Missing, "missing", false, "Remove the entries for images that exist neither locally nor remotely")
+	f.BoolVar(&pruneFlags.DryRun, "dry-run", false, "Only print the entries that would be removed")
+}
+
+func cmdCacheClear() *cobra.Command {
+	return NewCmd("clear").
+		WithDescription("Remove all the entries from the artifact cache").
+		WithExample("Clear the artifact cache", "cache clear").
+		NoArgs(doClearCache)
+}
+
+func listCache(ctx context.Context, out io.Writer, artifacts []string) error {
+	if err := checkCacheOutput(); err != nil {
+		return err
+	}
+
+	entries, err := listCacheEntries(ctx, cacheDockerConfig(), cacheFlags.cacheFile, artifacts)
+	if err != nil {
+		return err
+	}
+	return printCacheEntries(out, entries)
+}
+
+func doPruneCache(ctx context.Context, out io.Writer) error {
+	if err := checkCacheOutput(); err != nil {
+		return err
+	}
+	if pruneFlags.OlderThan == 0 && pruneFlags.KeepLatest == 0 && !pruneFlags.Missing {
+		return fmt.Errorf("nothing to prune: use at least one of --older-than, --keep or --missing")
+	}
+
+	pruned, err := pruneCache(ctx, cacheDockerConfig(), cacheFlags.cacheFile, pruneFlags)
+	if err != nil {
+		return err
+	}
+	if cacheFlags.output == "json" {
+		return printCacheEntries(out, pruned)
+	}
+
+	if pruneFlags.DryRun {
+		fmt.Fprintf(out, "Would remove %d entries\n", len(pruned))
+	} else {
+		fmt.Fprintf(out, "Removed %d entries\n", len(pruned))
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+	return printCacheEntries(out, pruned)
+}
+
+func doClearCache(_ context.Context, out io.Writer) error {
+	if err := checkCacheOutput(); err != nil {
+		return err
+	}
+
+	count, err := clearCache(cacheFlags.cacheFile)
+	if err != nil {
+		return err
+	}
+	if cacheFlags.output == "json" {
+		return json.NewEncoder(out).Encode(struct {
+			Removed int `json:"removed"`
+		}{count})
+	}
+	fmt.Fprintf(out, "Removed %d entries\n", count)
+	return nil
+}
+
+func checkCacheOutput() error {
+	switch cacheFlags.output {
+	case "plain", "json":
+		return nil
+	default:
+		return fmt.Errorf(`invalid output type: %q. Must be "plain" or "json"`, cacheFlags.output)
+	}
+}
+
+type cacheEntries struct {
+	Entries []cache.Entry `json:"entries"`
+}
+
+func printCacheEntries(out io.Writer, entries []cache.Entry) error {
+	if cacheFlags.output == "json" {
+		if entries == nil {
+			entries = []cache.Entry{}
+		}
+		return json.NewEncoder(out).Encode(cacheEntries{Entries: entries})
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ARTIFACT\tHASH\tDIGEST\tAGE\tLOCAL\tREMOTE")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", orNone(e.Image), shorten(e.Hash), orNone(shorten(e.Digest)), age(e), yesNo(e.Local), yesNo(e.Remote))
+	}
+	return w.Flush()
+}
+
+func shorten(s string) string {
+	const maxLength = 19 // enough for "sha256:" and 12 hex characters.
+	if len(s) > maxLength {
+		return s[:maxLength]
+	}
+	return s
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+func age(e cache.Entry) string {
+	if e.Created.IsZero() {
+		return "unknown"
+	}
+	return duration.HumanDuration(e.Age())
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func cacheDockerConfig() *runcontext.RunContext {
+	return &runcontext.RunContext{Opts: opts}
+}
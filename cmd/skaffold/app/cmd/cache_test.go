@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCacheCommands(t *testing.T) {
+	created := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	entries := []cache.Entry{
+		{Hash: "0123456789abcdef0123456789abcdef", Image: "app", Digest: "sha256:0123456789abcdef0123", Created: created, Remote: true},
+		{Hash: "hash2", ID: "sha256:id", Image: "other"},
+	}
+
+	tests := []struct {
+		description       string
+		args              []string
+		shouldErr         bool
+		expectedArtifacts []string
+		expected          string
+	}{
+		{
+			description:       "list as json",
+			args:              []string{"cache", "list", "-o", "json"},
+			expectedArtifacts: []string{},
+			expected:          `{"entries":[{"hash":"0123456789abcdef0123456789abcdef","image":"app","digest":"sha256:0123456789abcdef0123","created":"2021-06-01T00:00:00Z","existsLocally":false,"existsRemotely":true,"missing":false},{"hash":"hash2","image":"other","id":"sha256:id","created":"0001-01-01T00:00:00Z","existsLocally":false,"existsRemotely":false,"missing":false}]}` + "\n",
+		},
+		{
+			description:       "list entries of an artifact created by an older version",
+			args:              []string{"cache", "list", "other"},
+			expectedArtifacts: []string{"other"},
+			expected: "ARTIFACT  HASH   DIGEST  AGE      LOCAL  REMOTE\n" +
+				"other     hash2  <none>  unknown  no     no\n",
+		},
+		{
+			description: "prune needs criteria",
+			args:        []string{"cache", "prune"},
+			shouldErr:   true,
+		},
+		{
+			description: "prune",
+			args:        []string{"cache", "prune", "--missing"},
+			expected:    "Removed 0 entries\n",
+		},
+		{
+			description: "clear",
+			args:        []string{"cache", "clear", "-o", "json"},
+			expected:    `{"removed":2}` + "\n",
+		},
+		{
+			description: "invalid output",
+			args:        []string{"cache", "list", "-o", "yaml"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&listCacheEntries, func(_ context.Context, _ docker.Config, _ string, artifacts []string) ([]cache.Entry, error) {
+				t.CheckDeepEqual(test.expectedArtifacts, artifacts)
+				if len(artifacts) == 0 {
+					return entries, nil
+				}
+				var filtered []cache.Entry
+				for _, e := range entries {
+					if util.StrSliceContains(artifacts, e.Image) {
+						filtered = append(filtered, e)
+					}
+				}
+				return filtered, nil
+			})
+			t.Override(&pruneCache, func(context.Context, docker.Config, string, cache.PruneOptions) ([]cache.Entry, error) {
+				return nil, nil
+			})
+			t.Override(&clearCache, func(string) (int, error) { return 2, nil })
+
+			var out bytes.Buffer
+			cmd := NewCmdCache()
+			cmd.SetArgs(test.args[1:])
+			cmd.SetOut(&out)
+			err := cmd.Execute()
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, out.String())
+			}
+		})
+	}
+}
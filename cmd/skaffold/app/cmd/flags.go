@@ -139,6 +139,38 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "explain-cache",
+		Usage:         "Explain why each artifact that isn't found in the cache needs to be rebuilt",
+		Value:         &opts.ExplainCache,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
+	{
+		Name:          "shared-cache",
+		Usage:         "Location of an artifact cache shared with other users, to reuse images they already built and pushed. Supports `http://`, `https://` and `file://` URLs",
+		Value:         &opts.SharedCache.URL,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
+	{
+		Name:          "shared-cache-mode",
+		Usage:         "Controls how Skaffold uses the shared artifact cache (see `shared-cache`). One of `read-write` (default) or `read-only`. `read-only` looks up artifacts without publishing the ones that were built",
+		Value:         &opts.SharedCache.Mode,
+		DefValue:      "read-write",
+		FlagAddMethod: "Var",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
+	{
+		Name:          "shared-cache-ttl",
+		Usage:         "Ignore shared artifact cache entries older than this duration. Zero means entries never expire",
+		Value:         &opts.SharedCache.TTL,
+		DefValue:      time.Duration(0),
+		FlagAddMethod: "DurationVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "remote-cache-dir",
 		Usage:         "Specify the location of the git repositories cache (default $HOME/.skaffold/repos)",
@@ -219,6 +251,87 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "log-format",
+		Usage:         "Format of the application logs. One of `text` (default) or `json`. `json` prints one object per line with the pod, container, namespace, image, timestamp and message",
+		Value:         &opts.LogFormat,
+		DefValue:      "text",
+		FlagAddMethod: "Var",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-include",
+		Usage:         "Only show application log lines matching a filter: `container=<glob>`, `label:<key>=<value>` or `message=<regexp>`. Repeat to show lines matching any of the filters",
+		Value:         &opts.LogFilters.Include,
+		DefValue:      []string{},
+		FlagAddMethod: "StringArrayVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-exclude",
+		Usage:         "Hide application log lines matching a filter: `container=<glob>`, `label:<key>=<value>` or `message=<regexp>`",
+		Value:         &opts.LogFilters.Exclude,
+		DefValue:      []string{},
+		FlagAddMethod: "StringArrayVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-highlight",
+		Usage:         "Highlight the parts of application log lines matching a regexp",
+		Value:         &opts.LogFilters.Highlight,
+		DefValue:      []string{},
+		FlagAddMethod: "StringArrayVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-highlight-errors",
+		Usage:         "Highlight errors, panics and stack traces in application logs",
+		Value:         &opts.LogFilters.HighlightErrors,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-dir",
+		Usage:         "Also write the application logs of each container to a file in a sub-directory of this directory named after the run ID",
+		Value:         &opts.LogFiles.Dir,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-file-max-size",
+		Usage:         "Size in megabytes a log file written with --log-dir can reach before it's rotated. 0 disables rotation",
+		Value:         &opts.LogFiles.MaxSizeMB,
+		DefValue:      10,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "log-file-max-backups",
+		Usage:         "Number of rotated log files to keep for each container with --log-dir",
+		Value:         &opts.LogFiles.MaxBackups,
+		DefValue:      3,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "apply"},
+	},
+	{
+		Name:          "test-report",
+		Usage:         "Write a report of the tests run by Skaffold. One of `junit` or `json`. `skaffold dev` rewrites the report after each iteration with the tests of all the iterations",
+		Value:         &opts.TestReport.Format,
+		DefValue:      "",
+		FlagAddMethod: "Var",
+		DefinedOn:     []string{"dev", "run", "debug", "test"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "test-report-file",
+		Usage:         "File the test report is written to. Defaults to `skaffold-test-report.xml` or `skaffold-test-report.json` in the current directory",
+		Value:         &opts.TestReport.File,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "test"},
+	},
 	{
 		Name:     "tail",
 		Usage:    "Stream logs from deployed objects",
@@ -291,6 +404,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "port-forwarder",
+		Usage:         "How ports are forwarded. One of `kubectl` (default), which runs a `kubectl port-forward` process per port, or `native`, which forwards ports in-process with client-go and reconnects when pods are replaced",
+		Value:         &opts.PortForwarder,
+		DefValue:      "kubectl",
+		FlagAddMethod: "Var",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
 	{
 		Name:          "status-check",
 		Usage:         "Wait for deployed resources to stabilize",
@@ -395,13 +516,21 @@ var flagRegistry = []Flag{
 	},
 	{
 		Name:          "trigger",
-		Usage:         "How is change detection triggered? (polling, notify, or manual)",
+		Usage:         "How is change detection triggered? (polling, notify, manual, or webhook)",
 		Value:         &opts.Trigger,
 		DefValue:      "notify",
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "webhook-port",
+		Usage:         "tcp port on which the webhook trigger listens for requests",
+		Value:         &opts.WebhookPort,
+		DefValue:      constants.DefaultWebhookPort,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug"},
+	},
 	{
 		Name:     "auto-build",
 		Usage:    "When set to false, builds wait for API request instead of running automatically",
@@ -559,6 +688,23 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "test-concurrency",
+		Usage:         "Number of artifacts tested concurrently. Set to 0 to test all artifacts in parallel. The tests of an artifact always run sequentially. Overrides testConcurrency in skaffold.yaml.",
+		Value:         &opts.TestConcurrency,
+		DefValue:      -1,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "run", "debug", "test"},
+	},
+	{
+		Name:          "test-fail-fast",
+		Usage:         "Stop all the tests as soon as one fails. Set to false to run all the tests and report all the failures.",
+		Value:         &opts.TestFailFast,
+		DefValue:      true,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "test"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "v2",
 		Usage:         "Next skaffold config (v2). Use kpt to render/hydrate and deploy manifests.",
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/sirupsen/logrus"
 
@@ -76,6 +77,12 @@ func createNewRunner(out io.Writer, opts config.SkaffoldOptions) (runner.Runner,
 		event.InititializationFailed(err)
 		return nil, nil, nil, fmt.Errorf("creating runner: %w", err)
 	}
+	if dir := runCtx.LogFiles().Dir; dir != "" && runCtx.Tail() {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, nil, nil, fmt.Errorf("creating application logs directory: %w", err)
+		}
+		output.Default.Fprintln(out, "Writing application logs to", dir)
+	}
 	return runner, configs, runCtx, nil
 }
 
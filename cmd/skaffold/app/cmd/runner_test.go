@@ -17,8 +17,11 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/blang/semver"
@@ -110,3 +113,36 @@ func TestCreateNewRunner(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateNewRunnerLogDir(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&validation.DefaultConfig, validation.Options{CheckDeploySource: false})
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return docker.NewLocalDaemon(&testutil.FakeAPIClient{
+				ErrVersion: true,
+			}, nil, false, nil), nil
+		})
+		t.Override(&update.GetLatestAndCurrentVersion, func() (semver.Version, semver.Version, error) {
+			return semver.Version{}, semver.Version{}, nil
+		})
+		tmpDir := t.NewTempDir().
+			Write("skaffold.yaml", fmt.Sprintf("apiVersion: %s\nkind: Config\n", latestV1.Version)).
+			Chdir()
+
+		var out bytes.Buffer
+		_, _, runCtx, err := createNewRunner(&out, config.SkaffoldOptions{
+			ConfigurationFile: "skaffold.yaml",
+			Trigger:           "polling",
+			Tail:              true,
+			LogFiles:          config.LogFileOptions{Dir: tmpDir.Path("logs")},
+		})
+		t.CheckNoError(err)
+
+		logDir := runCtx.LogFiles().Dir
+		t.CheckDeepEqual(filepath.Join(tmpDir.Path("logs"), runCtx.RunID), logDir)
+		t.CheckDeepEqual(fmt.Sprintf("Writing application logs to %s\n", logDir), out.String())
+		info, err := os.Stat(logDir)
+		t.CheckNoError(err)
+		t.CheckTrue(info.IsDir())
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
@@ -40,6 +41,15 @@ import (
 type ImageDetails struct {
 	Digest string `yaml:"digest,omitempty"`
 	ID     string `yaml:"id,omitempty"`
+	// Image is the name of the artifact that was built.
+	Image string `yaml:"image,omitempty"`
+	// Tag is the tag the image was last built or pushed with.
+	Tag string `yaml:"tag,omitempty"`
+	// Created is when the entry was added to the cache.
+	Created time.Time `yaml:"created,omitempty"`
+	// Inputs holds the hash of each input of the artifact hash.
+	// They are only kept for the last entry used for each artifact, to explain future rebuilds.
+	Inputs map[string]string `yaml:"inputs,omitempty"`
 }
 
 // ArtifactCache is a map of [artifact dependencies hash : ImageDetails]
@@ -54,11 +64,17 @@ type cache struct {
 	client             docker.LocalDaemon
 	cfg                Config
 	cacheFile          string
+	shared             *sharedCache
+	explain            bool
+	inputsByHash       map[string]hashInputs
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
 	lister             DependencyLister
 }
 
+// for testing
+var currentTime = time.Now
+
 // DependencyLister fetches a list of dependencies for an artifact
 type DependencyLister func(ctx context.Context, artifact *latestV1.Artifact) ([]string, error)
 
@@ -70,6 +86,8 @@ type Config interface {
 	GetCluster() config.Cluster
 	CacheArtifacts() bool
 	CacheFile() string
+	SharedCache() config.SharedCacheOptions
+	ExplainCache() bool
 	Mode() config.RunMode
 }
 
@@ -91,6 +109,11 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		return &noCache{}, nil
 	}
 
+	shared, err := newSharedCache(cfg.SharedCache())
+	if err != nil {
+		logrus.Warnf("Error configuring shared cache, not using it: %v", err)
+	}
+
 	client, err := docker.NewAPIClient(cfg)
 	if err != nil {
 		// error only if any pipeline is local.
@@ -122,6 +145,9 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 		client:             client,
 		cfg:                cfg,
 		cacheFile:          cacheFile,
+		shared:             shared,
+		explain:            cfg.ExplainCache(),
+		inputsByHash:       map[string]hashInputs{},
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
 		lister:             dependencies,
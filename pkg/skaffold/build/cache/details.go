@@ -20,6 +20,8 @@ import (
 	"context"
 	"io"
 
+	"github.com/sirupsen/logrus"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
 
@@ -39,6 +41,8 @@ func (d failed) Hash() string {
 // Not found, needs building
 type needsBuilding struct {
 	hash string
+	// reason explains why the artifact needs building, only if requested.
+	reason string
 }
 
 func (d needsBuilding) Hash() string {
@@ -114,7 +118,14 @@ func (d needsPushing) Push(ctx context.Context, out io.Writer, c *cache) error {
 	c.cacheMutex.Lock()
 	e := c.artifactCache[d.hash]
 	e.Digest = digest
+	e.Tag = d.tag
 	c.artifactCache[d.hash] = e
 	c.cacheMutex.Unlock()
+
+	if c.shared != nil {
+		if err := c.shared.publish(ctx, d.hash, d.tag, digest); err != nil {
+			logrus.Warnf("error publishing %s to shared cache: %v", d.tag, err)
+		}
+	}
 	return nil
 }
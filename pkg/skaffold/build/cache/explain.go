@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxExplainedInputs limits how many inputs are listed for each kind of change.
+const maxExplainedInputs = 3
+
+// recordInputs stores the inputs of an artifact's hash in its cache entry.
+// Inputs are only kept for the last entry used for each artifact, to keep the cache file small.
+func (c *cache) recordInputs(imageName, hash string) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	inputs, found := c.inputsByHash[hash]
+	if !found || inputs == nil {
+		return
+	}
+	entry, found := c.artifactCache[hash]
+	if !found {
+		return
+	}
+
+	for h, e := range c.artifactCache {
+		if h != hash && e.Image == imageName && e.Inputs != nil {
+			e.Inputs = nil
+			c.artifactCache[h] = e
+		}
+	}
+	if entry.Image == "" {
+		entry.Image = imageName
+	}
+	entry.Inputs = inputs
+	c.artifactCache[hash] = entry
+}
+
+// explainRebuild tells why an artifact with the given hash and inputs needs building.
+func (c *cache) explainRebuild(imageName, hash string, inputs hashInputs) string {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	if _, found := c.artifactCache[hash]; found {
+		return "its cached image no longer exists"
+	}
+
+	var previous *ImageDetails
+	for _, e := range c.artifactCache {
+		e := e
+		if e.Image == imageName && e.Inputs != nil && (previous == nil || e.Created.After(previous.Created)) {
+			previous = &e
+		}
+	}
+	if previous == nil {
+		return "no previous build was found in the cache"
+	}
+
+	return diffInputs(previous.Inputs, inputs)
+}
+
+// diffInputs describes how the inputs of an artifact changed, for example
+// "src/main.go and buildArgs.VERSION changed".
+func diffInputs(previous, current map[string]string) string {
+	var changed, added, removed []string
+	for k, v := range current {
+		prev, found := previous[k]
+		switch {
+		case !found:
+			added = append(added, inputName(k))
+		case prev != v:
+			changed = append(changed, inputName(k))
+		}
+	}
+	for k := range previous {
+		if _, found := current[k]; !found {
+			removed = append(removed, inputName(k))
+		}
+	}
+
+	var parts []string
+	if len(changed) > 0 {
+		parts = append(parts, fmt.Sprintf("%s changed", enumerate(changed)))
+	}
+	if len(added) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %s added", enumerate(added), wasOrWere(added)))
+	}
+	if len(removed) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %s removed", enumerate(removed), wasOrWere(removed)))
+	}
+	if len(parts) == 0 {
+		return "its inputs changed"
+	}
+	return joinWithAnd(parts)
+}
+
+// inputName turns the key of an input into something readable.
+func inputName(key string) string {
+	switch {
+	case key == "config":
+		return "its configuration"
+	case strings.HasPrefix(key, "file:"):
+		return strings.TrimPrefix(key, "file:")
+	case strings.HasPrefix(key, "artifact:"):
+		return "artifact " + strings.TrimPrefix(key, "artifact:")
+	default:
+		return key
+	}
+}
+
+func enumerate(names []string) string {
+	sort.Strings(names)
+	if len(names) > maxExplainedInputs {
+		shown := append(names[:maxExplainedInputs:maxExplainedInputs], fmt.Sprintf("%d more", len(names)-maxExplainedInputs))
+		return joinWithAnd(shown)
+	}
+	return joinWithAnd(names)
+}
+
+func joinWithAnd(items []string) string {
+	if len(items) == 1 {
+		return items[0]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+func wasOrWere(items []string) string {
+	if len(items) == 1 {
+		return "was"
+	}
+	return "were"
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDiffInputs(t *testing.T) {
+	tests := []struct {
+		description string
+		previous    map[string]string
+		current     map[string]string
+		expected    string
+	}{
+		{
+			description: "changed file and build arg",
+			previous:    map[string]string{"config": "a", "file:src/main.go": "a", "buildArgs.VERSION": "a"},
+			current:     map[string]string{"config": "a", "file:src/main.go": "b", "buildArgs.VERSION": "b"},
+			expected:    "buildArgs.VERSION and src/main.go changed",
+		},
+		{
+			description: "added and removed files",
+			previous:    map[string]string{"file:old.go": "a", "file:main.go": "a"},
+			current:     map[string]string{"file:new.go": "a", "file:main.go": "a"},
+			expected:    "new.go was added and old.go was removed",
+		},
+		{
+			description: "configuration and dependent artifact",
+			previous:    map[string]string{"config": "a", "artifact:base": "a"},
+			current:     map[string]string{"config": "b", "artifact:base": "b"},
+			expected:    "artifact base and its configuration changed",
+		},
+		{
+			description: "many files",
+			previous:    map[string]string{},
+			current:     map[string]string{"file:a": "a", "file:b": "b", "file:c": "c", "file:d": "d", "file:e": "e"},
+			expected:    "a, b, c and 2 more were added",
+		},
+		{
+			description: "same inputs",
+			previous:    map[string]string{"config": "a"},
+			current:     map[string]string{"config": "a"},
+			expected:    "its inputs changed",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, diffInputs(test.previous, test.current))
+		})
+	}
+}
+
+func TestCacheBuildExplain(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("dep1", "content1").
+			Write("dep2", "content2").
+			Chdir()
+
+		tags := map[string]string{"artifact": "artifact:tag"}
+		artifact := &latestV1.Artifact{
+			ImageName: "artifact",
+			ArtifactType: latestV1.ArtifactType{DockerArtifact: &latestV1.DockerArtifact{
+				BuildArgs: map[string]*string{"VERSION": util.StringPtr("1")},
+			}},
+		}
+		artifacts := []*latestV1.Artifact{artifact}
+		deps := depLister(map[string][]string{"artifact": {"dep1", "dep2"}})
+
+		t.Override(&docker.DefaultAuthHelper, stubAuth{})
+		dockerDaemon := fakeLocalDaemon(&testutil.FakeAPIClient{})
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return dockerDaemon, nil
+		})
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+
+		cfg := &mockConfig{
+			pipeline:  latestV1.Pipeline{Build: latestV1.BuildConfig{BuildType: latestV1.BuildType{LocalBuild: &latestV1.LocalBuild{}}}},
+			cacheFile: tmpDir.Path("cache"),
+			explain:   true,
+		}
+		store := make(mockArtifactStore)
+		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return true, nil }, deps, graph.ToArtifactGraph(artifacts), store)
+		t.CheckNoError(err)
+
+		// First build: nothing to compare to
+		var out bytes.Buffer
+		builder := &mockBuilder{dockerDaemon: dockerDaemon, store: store}
+		_, err = artifactCache.Build(context.Background(), &out, tags, artifacts, builder.Build)
+		t.CheckNoError(err)
+		t.CheckContains("rebuilding artifact because no previous build was found in the cache", out.String())
+
+		// Second build: change a file and a build arg
+		tmpDir.Write("dep1", "new content")
+		artifact.DockerArtifact.BuildArgs["VERSION"] = util.StringPtr("2")
+		out.Reset()
+		builder = &mockBuilder{dockerDaemon: dockerDaemon, store: store}
+		_, err = artifactCache.Build(context.Background(), &out, tags, artifacts, builder.Build)
+		t.CheckNoError(err)
+		t.CheckContains("rebuilding artifact because buildArgs.VERSION, dep1 and its configuration changed", out.String())
+
+		// Only the last entry keeps its inputs
+		withInputs := 0
+		for _, e := range artifactCache.(*cache).artifactCache {
+			if e.Inputs != nil {
+				withInputs++
+			}
+		}
+		t.CheckDeepEqual(1, withInputs)
+	})
+}
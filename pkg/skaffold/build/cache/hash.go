@@ -25,11 +25,15 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
@@ -49,11 +53,27 @@ type artifactHasher interface {
 	hash(ctx context.Context, a *latestV1.Artifact) (string, error)
 }
 
+// inputsRecorder is implemented by artifactHashers that record the inputs of the hashes they compute.
+type inputsRecorder interface {
+	inputs(imageName string) hashInputs
+}
+
+// hashInputs maps each input of an artifact's hash to the hash of its value.
+// Keys are `config`, `file:<path>`, `buildArgs.<name>`, `env.<name>` or `artifact:<image>`.
+type hashInputs map[string]string
+
 type artifactHasherImpl struct {
-	artifacts graph.ArtifactGraph
-	lister    DependencyLister
-	mode      config.RunMode
-	syncStore *util.SyncStore
+	artifacts   graph.ArtifactGraph
+	lister      DependencyLister
+	mode        config.RunMode
+	syncStore   *util.SyncStore
+	inputsStore sync.Map
+}
+
+// singleHash is the hash of a single artifact, ignoring its required artifacts.
+type singleHash struct {
+	hash   string
+	inputs hashInputs
 }
 
 // newArtifactHasher returns a new instance of an artifactHasher. Use newArtifactHasherFunc instead of calling this function directly.
@@ -72,12 +92,16 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latestV1.Artifact) (st
 	})
 	defer endTrace()
 
-	hash, err := h.safeHash(ctx, a)
+	single, err := h.safeHash(ctx, a)
 	if err != nil {
 		endTrace(instrumentation.TraceEndError(err))
 		return "", err
 	}
-	hashes := []string{hash}
+	hashes := []string{single.hash}
+	inputs := hashInputs{}
+	for k, v := range single.inputs {
+		inputs[k] = v
+	}
 	for _, dep := range sortedDependencies(a, h.artifacts) {
 		depHash, err := h.hash(ctx, dep)
 		if err != nil {
@@ -85,7 +109,9 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latestV1.Artifact) (st
 			return "", err
 		}
 		hashes = append(hashes, depHash)
+		inputs["artifact:"+dep.ImageName] = depHash
 	}
+	h.inputsStore.Store(a.ImageName, inputs)
 
 	if len(hashes) == 1 {
 		return hashes[0], nil
@@ -93,40 +119,51 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latestV1.Artifact) (st
 	return encode(hashes)
 }
 
-func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latestV1.Artifact) (string, error) {
+// inputs returns the inputs of the last hash computed for an artifact.
+func (h *artifactHasherImpl) inputs(imageName string) hashInputs {
+	if inputs, found := h.inputsStore.Load(imageName); found {
+		return inputs.(hashInputs)
+	}
+	return nil
+}
+
+func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latestV1.Artifact) (singleHash, error) {
 	val := h.syncStore.Exec(a.ImageName,
 		func() interface{} {
-			hash, err := singleArtifactHash(ctx, h.lister, a, h.mode)
+			hash, inputs, err := singleArtifactHash(ctx, h.lister, a, h.mode)
 			if err != nil {
 				return err
 			}
-			return hash
+			return singleHash{hash: hash, inputs: inputs}
 		})
 	switch t := val.(type) {
 	case error:
-		return "", t
-	case string:
+		return singleHash{}, t
+	case singleHash:
 		return t, nil
 	default:
-		return "", fmt.Errorf("internal error when retrieving cache result of type %T", t)
+		return singleHash{}, fmt.Errorf("internal error when retrieving cache result of type %T", t)
 	}
 }
 
 // singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
-func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latestV1.Artifact, mode config.RunMode) (string, error) {
+// It also returns the hash of each of its inputs.
+func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latestV1.Artifact, mode config.RunMode) (string, hashInputs, error) {
 	var inputs []string
+	named := hashInputs{}
 
 	// Append the artifact's configuration
 	config, err := artifactConfigFunc(a)
 	if err != nil {
-		return "", fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
+		return "", nil, fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
 	}
 	inputs = append(inputs, config)
+	named["config"] = hashString(config)
 
 	// Append the digest of each input file
 	deps, err := depLister(ctx, a)
 	if err != nil {
-		return "", fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+		return "", nil, fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
 	}
 	sort.Strings(deps)
 
@@ -138,20 +175,61 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 				continue // Ignore files that don't exist
 			}
 
-			return "", fmt.Errorf("getting hash for %q: %w", d, err)
+			return "", nil, fmt.Errorf("getting hash for %q: %w", d, err)
 		}
 		inputs = append(inputs, h)
+		named["file:"+relativeToWorkspace(a.Workspace, d)] = h
 	}
 
 	// add build args for the artifact if specified
 	args, err := hashBuildArgs(a, mode)
 	if err != nil {
-		return "", fmt.Errorf("hashing build args: %w", err)
+		return "", nil, fmt.Errorf("hashing build args: %w", err)
 	}
 	if args != nil {
 		inputs = append(inputs, args...)
+		prefix := buildArgsPrefix(a)
+		for _, arg := range args {
+			kv := strings.SplitN(arg, "=", 2)
+			named[prefix+kv[0]] = hashString(arg)
+		}
+	}
+
+	hash, err := encode(inputs)
+	if err != nil {
+		return "", nil, err
+	}
+	return hash, named, nil
+}
+
+// hashString hashes values that might be sensitive, such as build args, before they are recorded.
+func hashString(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// buildArgsPrefix names the build args of an artifact as they appear in its configuration.
+func buildArgsPrefix(a *latestV1.Artifact) string {
+	if a.BuildpackArtifact != nil || a.KoArtifact != nil {
+		return "env."
+	}
+	return "buildArgs."
+}
+
+func relativeToWorkspace(workspace, path string) string {
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return path
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(absWorkspace, absPath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
 	}
-	return encode(inputs)
+	return filepath.ToSlash(rel)
 }
 
 func encode(inputs []string) (string, error) {
@@ -185,6 +263,8 @@ func hashBuildArgs(artifact *latestV1.Artifact, mode config.RunMode) ([]string,
 		args, err = docker.EvalBuildArgs(mode, artifact.Workspace, artifact.KanikoArtifact.DockerfilePath, artifact.KanikoArtifact.BuildArgs, nil)
 	case artifact.BuildpackArtifact != nil:
 		env, err = buildpacks.GetEnv(artifact, mode)
+	case artifact.KoArtifact != nil:
+		return misc.EvaluateEnv(artifact.KoArtifact.Env)
 	case artifact.CustomArtifact != nil && artifact.CustomArtifact.Dependencies.Dockerfile != nil:
 		args, err = util.EvaluateEnvTemplateMap(artifact.CustomArtifact.Dependencies.Dockerfile.BuildArgs)
 	default:
@@ -45,6 +45,17 @@ func (c *cache) lookupArtifacts(ctx context.Context, tags tag.ImageTags, artifac
 		i := i
 		go func() {
 			details[i] = c.lookup(ctx, artifacts[i], tags[artifacts[i].ImageName], h)
+			if r, ok := h.(inputsRecorder); ok && details[i].Hash() != "" {
+				inputs := r.inputs(artifacts[i].ImageName)
+				c.cacheMutex.Lock()
+				c.inputsByHash[details[i].Hash()] = inputs
+				c.cacheMutex.Unlock()
+
+				if d, ok := details[i].(needsBuilding); ok && c.explain {
+					d.reason = c.explainRebuild(artifacts[i].ImageName, d.hash, inputs)
+					details[i] = d
+				}
+			}
 			wg.Done()
 		}()
 	}
@@ -64,22 +75,28 @@ func (c *cache) lookup(ctx context.Context, a *latestV1.Artifact, tag string, h
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
 
+	isLocal, err := c.isLocalImage(a.ImageName)
+	if err != nil {
+		return failed{err}
+	}
+
 	c.cacheMutex.RLock()
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
 	if !cacheHit {
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
-			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
-			return needsBuilding{hash: hash}
+			// The shared cache might still know about an image pushed by someone else.
+			if isLocal || c.shared == nil {
+				logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
+				return needsBuilding{hash: hash}
+			}
 		}
 	}
 
-	if isLocal, err := c.isLocalImage(a.ImageName); err != nil {
-		return failed{err}
-	} else if isLocal {
+	if isLocal {
 		return c.lookupLocal(ctx, hash, tag, entry)
 	}
-	return c.lookupRemote(ctx, hash, tag, entry)
+	return c.lookupRemote(ctx, a.ImageName, hash, tag, entry)
 }
 
 func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
@@ -107,32 +124,59 @@ func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDe
 	return needsBuilding{hash: hash}
 }
 
-func (c *cache) lookupRemote(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
+func (c *cache) lookupRemote(ctx context.Context, imageName, hash, tag string, entry ImageDetails) cacheDetails {
+	if entry.Digest != "" {
+		if details, found := c.lookupDigest(hash, tag, entry.Digest); found {
+			return details
+		}
+	}
+
+	// Image exists locally
+	if entry.ID != "" && c.client != nil && c.client.ImageExists(ctx, entry.ID) {
+		return needsPushing{hash: hash, tag: tag, imageID: entry.ID}
+	}
+
+	// Image was pushed by another user of the shared cache
+	if c.shared != nil {
+		if digest, found := c.shared.lookup(ctx, hash); found && digest != entry.Digest {
+			if details, found := c.lookupDigest(hash, tag, digest); found {
+				c.cacheMutex.Lock()
+				c.artifactCache[hash] = ImageDetails{Digest: digest, Image: imageName, Tag: tag, Created: currentTime()}
+				c.cacheMutex.Unlock()
+				return details
+			}
+		}
+	}
+
+	return needsBuilding{hash: hash}
+}
+
+// lookupDigest checks if an image with the given digest exists in the registry of the tag.
+func (c *cache) lookupDigest(hash, tag, digest string) (cacheDetails, bool) {
 	if remoteDigest, err := docker.RemoteDigest(tag, c.cfg); err == nil {
 		// Image exists remotely with the same tag and digest
-		if remoteDigest == entry.Digest {
-			return found{hash: hash}
+		if remoteDigest == digest {
+			return found{hash: hash}, true
 		}
 	}
 
 	// Image exists remotely with a different tag
-	fqn := tag + "@" + entry.Digest // Actual tag will be ignored but we need the registry and the digest part of it.
+	fqn := tag + "@" + digest // Actual tag will be ignored but we need the registry and the digest part of it.
 	if remoteDigest, err := docker.RemoteDigest(fqn, c.cfg); err == nil {
-		if remoteDigest == entry.Digest {
-			return needsRemoteTagging{hash: hash, tag: tag, digest: entry.Digest}
+		if remoteDigest == digest {
+			return needsRemoteTagging{hash: hash, tag: tag, digest: digest}, true
 		}
 	}
 
-	// Image exists locally
-	if entry.ID != "" && c.client != nil && c.client.ImageExists(ctx, entry.ID) {
-		return needsPushing{hash: hash, tag: tag, imageID: entry.ID}
-	}
-
-	return needsBuilding{hash: hash}
+	return nil, false
 }
 
 func (c *cache) tryImport(ctx context.Context, a *latestV1.Artifact, tag string, hash string) (ImageDetails, error) {
-	entry := ImageDetails{}
+	entry := ImageDetails{
+		Image:   a.ImageName,
+		Tag:     tag,
+		Created: currentTime(),
+	}
 
 	if importMissing, err := c.importMissingImage(a.ImageName); err != nil {
 		return entry, err
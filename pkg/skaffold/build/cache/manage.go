@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// Entry describes an entry of the artifact cache and whether its image still exists.
+type Entry struct {
+	Hash    string    `json:"hash"`
+	Image   string    `json:"image,omitempty"`
+	Tag     string    `json:"tag,omitempty"`
+	Digest  string    `json:"digest,omitempty"`
+	ID      string    `json:"id,omitempty"`
+	Created time.Time `json:"created"`
+	// Local is true if the image exists in the local Docker daemon.
+	Local bool `json:"existsLocally"`
+	// Remote is true if the image exists in its registry.
+	Remote bool `json:"existsRemotely"`
+	// Missing is true if the image is known to exist neither locally nor remotely.
+	Missing bool `json:"missing"`
+}
+
+// Age returns how long ago the entry was added, or zero if unknown.
+func (e Entry) Age() time.Duration {
+	if e.Created.IsZero() {
+		return 0
+	}
+	return currentTime().Sub(e.Created)
+}
+
+// PruneOptions selects the cache entries to remove.
+type PruneOptions struct {
+	// OlderThan removes entries added longer ago than this duration, if not zero.
+	OlderThan time.Duration
+	// KeepLatest removes all but the given number of most recent entries, if not zero.
+	KeepLatest int
+	// Missing removes entries for images that no longer exist.
+	Missing bool
+	// DryRun only reports the entries that would be removed.
+	DryRun bool
+}
+
+// ListEntries returns the entries of a cache file for the given artifacts, or all the entries
+// if no artifact is given, most recent first.
+// An empty cacheFile means the default cache file.
+func ListEntries(ctx context.Context, cfg docker.Config, cacheFile string, artifacts []string) ([]Entry, error) {
+	_, artifactCache, err := loadArtifactCache(cacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(artifacts) > 0 {
+		artifactCache = filterArtifacts(artifactCache, artifacts)
+	}
+	return checkEntries(ctx, cfg, artifactCache), nil
+}
+
+// Prune removes entries from a cache file and returns the removed entries.
+// An empty cacheFile means the default cache file.
+func Prune(ctx context.Context, cfg docker.Config, cacheFile string, opts PruneOptions) ([]Entry, error) {
+	file, artifactCache, err := loadArtifactCache(cacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	if opts.Missing {
+		entries = checkEntries(ctx, cfg, artifactCache)
+	} else {
+		entries = toEntries(artifactCache)
+	}
+
+	var pruned []Entry
+	for i, entry := range entries {
+		if shouldPrune(entry, i, opts) {
+			pruned = append(pruned, entry)
+		}
+	}
+
+	if opts.DryRun || len(pruned) == 0 {
+		return pruned, nil
+	}
+
+	for _, entry := range pruned {
+		delete(artifactCache, entry.Hash)
+	}
+	if err := saveArtifactCache(file, artifactCache); err != nil {
+		return nil, fmt.Errorf("saving cache file %q: %w", file, err)
+	}
+	return pruned, nil
+}
+
+// Clear removes all the entries from a cache file and returns how many were removed.
+// An empty cacheFile means the default cache file.
+func Clear(cacheFile string) (int, error) {
+	file, artifactCache, err := loadArtifactCache(cacheFile)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := saveArtifactCache(file, ArtifactCache{}); err != nil {
+		return 0, fmt.Errorf("saving cache file %q: %w", file, err)
+	}
+	return len(artifactCache), nil
+}
+
+// shouldPrune tells if the i-th most recent entry matches the prune options.
+// Entries without a creation time were added by older versions of Skaffold and are considered the oldest.
+func shouldPrune(entry Entry, i int, opts PruneOptions) bool {
+	if opts.OlderThan > 0 && (entry.Created.IsZero() || entry.Age() > opts.OlderThan) {
+		return true
+	}
+	if opts.KeepLatest > 0 && i >= opts.KeepLatest {
+		return true
+	}
+	return opts.Missing && entry.Missing
+}
+
+func loadArtifactCache(cacheFile string) (string, ArtifactCache, error) {
+	file, err := resolveCacheFile(cacheFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolving cache file: %w", err)
+	}
+
+	artifactCache, err := retrieveArtifactCache(file)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading cache file %q: %w", file, err)
+	}
+	return file, artifactCache, nil
+}
+
+// toEntries lists the entries of an artifact cache, most recent first.
+func filterArtifacts(artifactCache ArtifactCache, artifacts []string) ArtifactCache {
+	filtered := ArtifactCache{}
+	for hash, details := range artifactCache {
+		for _, a := range artifacts {
+			if details.Image == a {
+				filtered[hash] = details
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func toEntries(artifactCache ArtifactCache) []Entry {
+	var entries []Entry
+	for hash, details := range artifactCache {
+		entries = append(entries, Entry{
+			Hash:    hash,
+			Image:   details.Image,
+			Tag:     details.Tag,
+			Digest:  details.Digest,
+			ID:      details.ID,
+			Created: details.Created,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Created.Equal(entries[j].Created) {
+			return entries[i].Created.After(entries[j].Created)
+		}
+		return entries[i].Hash < entries[j].Hash
+	})
+	return entries
+}
+
+// checkEntries lists the entries of an artifact cache and checks if their images still exist.
+// An entry is only marked as missing when the Docker daemon and the registry both report its image as not found:
+// entries that can't be checked, e.g. because the daemon or the registry can't be reached, are kept.
+func checkEntries(ctx context.Context, cfg docker.Config, artifactCache ArtifactCache) []Entry {
+	localDocker, err := docker.NewAPIClient(cfg)
+	if err != nil {
+		logrus.Warnf("Unable to connect to Docker, not checking local images: %v", err)
+		localDocker = nil
+	}
+
+	entries := toEntries(artifactCache)
+	for i := range entries {
+		entry := &entries[i]
+		checked, unknown := false, false
+
+		if entry.ID != "" {
+			checked = true
+			if localDocker == nil {
+				unknown = true
+			} else {
+				_, _, err := localDocker.ImageInspectWithRaw(ctx, entry.ID)
+				switch {
+				case err == nil:
+					entry.Local = true
+				case !client.IsErrNotFound(err):
+					unknown = true
+					logrus.Warnf("Unable to check if image %s exists locally: %v", entry.ID, err)
+				}
+			}
+		}
+		if entry.Digest != "" && entry.Tag != "" {
+			checked = true
+			remoteDigest, err := docker.RemoteDigest(entry.Tag+"@"+entry.Digest, cfg)
+			switch {
+			case err == nil:
+				entry.Remote = remoteDigest == entry.Digest
+			case !isRegistryNotFound(err):
+				unknown = true
+				logrus.Warnf("Unable to check if image %s exists remotely: %v", entry.Tag, err)
+			}
+		}
+
+		entry.Missing = checked && !unknown && !entry.Local && !entry.Remote
+	}
+	return entries
+}
+
+// isRegistryNotFound tells if a registry error means that an image doesn't exist.
+func isRegistryNotFound(err error) bool {
+	var terr *transport.Error
+	if !errors.As(err, &terr) {
+		return false
+	}
+	for _, diagnostic := range terr.Errors {
+		if diagnostic.Code == transport.ManifestUnknownErrorCode || diagnostic.Code == transport.NameUnknownErrorCode {
+			return true
+		}
+	}
+	return terr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var (
+	manageNow       = time.Date(2021, 6, 10, 0, 0, 0, 0, time.UTC)
+	manifestUnknown = fmt.Errorf("getting image: %w", &transport.Error{
+		StatusCode: http.StatusNotFound,
+		Errors:     []transport.Diagnostic{{Code: transport.ManifestUnknownErrorCode}},
+	})
+)
+
+func manageArtifactCache() ArtifactCache {
+	return ArtifactCache{
+		// exists locally
+		"hash1": {ID: "sha256:local", Image: "app", Created: manageNow.Add(-1 * time.Hour)},
+		// exists remotely
+		"hash2": {Digest: "sha256:remote", Tag: "gcr.io/p/app:v1", Image: "app", Created: manageNow.Add(-48 * time.Hour)},
+		// gone
+		"hash3": {ID: "sha256:gone", Image: "other", Created: manageNow.Add(-2 * time.Hour)},
+		// created by an older skaffold
+		"hash4": {Digest: "sha256:old"},
+	}
+}
+
+func setupManageTest(t *testutil.T) string {
+	t.Override(&currentTime, func() time.Time { return manageNow })
+	t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+		return fakeLocalDaemon((&testutil.FakeAPIClient{}).Add("app:local", "sha256:local")), nil
+	})
+	t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+		if identifier == "gcr.io/p/app:v1@sha256:remote" {
+			return "sha256:remote", nil
+		}
+		return "", manifestUnknown
+	})
+
+	cacheFile := t.NewTempDir().Path("cache")
+	t.CheckNoError(saveArtifactCache(cacheFile, manageArtifactCache()))
+	return cacheFile
+}
+
+func TestListEntries(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cacheFile := setupManageTest(t)
+
+		entries, err := ListEntries(context.Background(), &mockConfig{}, cacheFile, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]Entry{
+			{Hash: "hash1", ID: "sha256:local", Image: "app", Created: manageNow.Add(-1 * time.Hour), Local: true},
+			{Hash: "hash3", ID: "sha256:gone", Image: "other", Created: manageNow.Add(-2 * time.Hour), Missing: true},
+			{Hash: "hash2", Digest: "sha256:remote", Tag: "gcr.io/p/app:v1", Image: "app", Created: manageNow.Add(-48 * time.Hour), Remote: true},
+			{Hash: "hash4", Digest: "sha256:old"},
+		}, entries)
+		t.CheckDeepEqual(time.Hour, entries[0].Age())
+		t.CheckDeepEqual(time.Duration(0), entries[3].Age())
+	})
+}
+
+func TestListEntriesOfArtifacts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cacheFile := setupManageTest(t)
+
+		entries, err := ListEntries(context.Background(), &mockConfig{}, cacheFile, []string{"other", "unknown"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]Entry{
+			{Hash: "hash3", ID: "sha256:gone", Image: "other", Created: manageNow.Add(-2 * time.Hour), Missing: true},
+		}, entries)
+	})
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		description string
+		opts        PruneOptions
+		expected    []string
+		remaining   []string
+	}{
+		{
+			description: "older than",
+			opts:        PruneOptions{OlderThan: 24 * time.Hour},
+			expected:    []string{"hash2", "hash4"},
+			remaining:   []string{"hash1", "hash3"},
+		},
+		{
+			description: "keep latest",
+			opts:        PruneOptions{KeepLatest: 1},
+			expected:    []string{"hash3", "hash2", "hash4"},
+			remaining:   []string{"hash1"},
+		},
+		{
+			description: "missing",
+			opts:        PruneOptions{Missing: true},
+			expected:    []string{"hash3"},
+			remaining:   []string{"hash1", "hash2", "hash4"},
+		},
+		{
+			description: "dry run",
+			opts:        PruneOptions{Missing: true, DryRun: true},
+			expected:    []string{"hash3"},
+			remaining:   []string{"hash1", "hash2", "hash3", "hash4"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cacheFile := setupManageTest(t)
+
+			pruned, err := Prune(context.Background(), &mockConfig{}, cacheFile, test.opts)
+			t.CheckNoError(err)
+
+			var hashes []string
+			for _, e := range pruned {
+				hashes = append(hashes, e.Hash)
+			}
+			t.CheckDeepEqual(test.expected, hashes)
+
+			artifactCache, err := retrieveArtifactCache(cacheFile)
+			t.CheckNoError(err)
+			var remaining []string
+			for _, e := range toEntries(artifactCache) {
+				remaining = append(remaining, e.Hash)
+			}
+			t.CheckElementsMatch(test.remaining, remaining)
+		})
+	}
+}
+
+func TestClear(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cacheFile := setupManageTest(t)
+
+		count, err := Clear(cacheFile)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(4, count)
+
+		artifactCache, err := retrieveArtifactCache(cacheFile)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(ArtifactCache{}, artifactCache)
+	})
+}
+
+func TestCheckEntriesErrors(t *testing.T) {
+	artifactCache := ArtifactCache{
+		"local":  {ID: "sha256:local"},
+		"remote": {Digest: "sha256:remote", Tag: "gcr.io/p/app:v1"},
+	}
+
+	tests := []struct {
+		description     string
+		localErr        bool
+		apiClientErr    error
+		remoteErr       error
+		expectedMissing map[string]bool
+	}{
+		{
+			description:     "images not found",
+			remoteErr:       manifestUnknown,
+			expectedMissing: map[string]bool{"local": true, "remote": true},
+		},
+		{
+			description:     "daemon error",
+			localErr:        true,
+			remoteErr:       manifestUnknown,
+			expectedMissing: map[string]bool{"local": false, "remote": true},
+		},
+		{
+			description:     "daemon unreachable",
+			apiClientErr:    errors.New("cannot connect"),
+			remoteErr:       manifestUnknown,
+			expectedMissing: map[string]bool{"local": false, "remote": true},
+		},
+		{
+			description:     "registry error",
+			remoteErr:       errors.New("dial tcp: connection refused"),
+			expectedMissing: map[string]bool{"local": true, "remote": false},
+		},
+		{
+			description: "registry unauthorized",
+			remoteErr: &transport.Error{
+				StatusCode: http.StatusUnauthorized,
+				Errors:     []transport.Diagnostic{{Code: transport.UnauthorizedErrorCode}},
+			},
+			expectedMissing: map[string]bool{"local": true, "remote": false},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeLocalDaemon(&testutil.FakeAPIClient{ErrImageInspect: test.localErr}), test.apiClientErr
+			})
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) {
+				return "", test.remoteErr
+			})
+
+			entries := checkEntries(context.Background(), &mockConfig{}, artifactCache)
+
+			missing := map[string]bool{}
+			for _, entry := range entries {
+				missing[entry.Hash] = entry.Missing
+			}
+			t.CheckDeepEqual(test.expectedMissing, missing)
+		})
+	}
+}
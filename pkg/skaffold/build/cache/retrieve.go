@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -73,8 +74,11 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 			return nil, result.err
 
 		case needsBuilding:
-			eventV2.CacheCheckMiss(artifact.ImageName)
+			eventV2.CacheCheckMiss(artifact.ImageName, result.reason)
 			output.Yellow.Fprintln(out, "Not found. Building")
+			if result.reason != "" {
+				output.Default.Fprintf(out, "   rebuilding %s because %s\n", artifact.ImageName, result.reason)
+			}
 			hashByName[artifact.ImageName] = result.Hash()
 			needToBuild = append(needToBuild, artifact)
 			continue
@@ -111,6 +115,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		}
 
 		// Image is already built
+		c.recordInputs(artifact.ImageName, result.Hash())
 		c.cacheMutex.RLock()
 		entry := c.artifactCache[result.Hash()]
 		c.cacheMutex.RUnlock()
@@ -151,6 +156,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		logrus.Warnf("error adding artifacts to cache; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
 	}
+	c.publishArtifacts(ctx, bRes, hashByName)
 
 	if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
 		logrus.Warnf("error saving cache file; caching may not work as expected: %v", err)
@@ -177,7 +183,10 @@ func maintainArtifactOrder(built []graph.Artifact, artifacts []*latestV1.Artifac
 
 func (c *cache) addArtifacts(ctx context.Context, bRes []graph.Artifact, hashByName map[string]string) error {
 	for _, a := range bRes {
-		entry := ImageDetails{}
+		entry := ImageDetails{
+			Image:   a.ImageName,
+			Created: currentTime(),
+		}
 		isLocal, err := c.isLocalImage(a.ImageName)
 		if err != nil {
 			return err
@@ -197,10 +206,12 @@ func (c *cache) addArtifacts(ctx context.Context, bRes []graph.Artifact, hashByN
 				return fmt.Errorf("parsing reference %q: %w", a.Tag, err)
 			}
 			entry.Digest = ref.Digest
+			entry.Tag = strings.TrimSuffix(a.Tag, "@"+ref.Digest)
 		}
 		c.cacheMutex.Lock()
 		c.artifactCache[hashByName[a.ImageName]] = entry
 		c.cacheMutex.Unlock()
+		c.recordInputs(a.ImageName, hashByName[a.ImageName])
 	}
 	return nil
 }
@@ -344,9 +344,11 @@ type mockConfig struct {
 	cacheFile             string
 	mode                  config.RunMode
 	pipeline              latestV1.Pipeline
+	explain               bool
 }
 
 func (c *mockConfig) CacheArtifacts() bool                              { return true }
 func (c *mockConfig) CacheFile() string                                 { return c.cacheFile }
+func (c *mockConfig) ExplainCache() bool                                { return c.explain }
 func (c *mockConfig) Mode() config.RunMode                              { return c.mode }
 func (c *mockConfig) PipelineForImage(string) (latestV1.Pipeline, bool) { return c.pipeline, true }
@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+const (
+	// sharedCacheTokenEnv holds an optional bearer token sent to http shared caches.
+	sharedCacheTokenEnv = "SKAFFOLD_SHARED_CACHE_TOKEN"
+
+	sharedCacheTimeout = 10 * time.Second
+)
+
+// sharedEntry is what the shared cache knows about an artifact hash.
+// Only digests are shared since image IDs are only meaningful to a given Docker daemon.
+type sharedEntry struct {
+	Digest  string    `json:"digest"`
+	Image   string    `json:"image,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// sharedStore reads and writes entries of a shared cache, keyed by artifact hash.
+type sharedStore interface {
+	// Get returns the entry for a hash, or nil if there is none.
+	Get(ctx context.Context, hash string) (*sharedEntry, error)
+	Put(ctx context.Context, hash string, entry sharedEntry) error
+}
+
+// sharedCache looks up artifacts built by other users.
+type sharedCache struct {
+	store    sharedStore
+	readOnly bool
+	ttl      time.Duration
+}
+
+// newSharedCache returns the shared cache configured by the user, or nil if there is none.
+func newSharedCache(opts config.SharedCacheOptions) (*sharedCache, error) {
+	if !opts.Enabled() {
+		return nil, nil
+	}
+
+	store, err := newSharedStore(opts.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sharedCache{
+		store:    store,
+		readOnly: opts.Mode.ReadOnly(),
+		ttl:      opts.TTL,
+	}, nil
+}
+
+// newSharedStore returns the sharedStore for a `http://`, `https://` or `file://` URL.
+// Object stores such as S3 or GCS are supported through their HTTP interfaces.
+func newSharedStore(location string) (sharedStore, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing shared cache url %q: %w", location, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return &httpStore{
+			baseURL: strings.TrimSuffix(u.String(), "/"),
+			token:   os.Getenv(sharedCacheTokenEnv),
+			client:  &http.Client{Timeout: sharedCacheTimeout},
+		}, nil
+	case "file":
+		return &fileStore{dir: filepath.FromSlash(u.Path)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported shared cache url %q: expected one of http://, https:// or file://", location)
+	}
+}
+
+// lookup returns a digest built by someone else for a given hash.
+func (s *sharedCache) lookup(ctx context.Context, hash string) (string, bool) {
+	entry, err := s.store.Get(ctx, hash)
+	if err != nil {
+		logrus.Debugf("Could not read shared cache entry %s: %v", hash, err)
+		return "", false
+	}
+	if entry == nil || entry.Digest == "" {
+		return "", false
+	}
+	if s.ttl > 0 && currentTime().Sub(entry.Created) > s.ttl {
+		logrus.Debugf("Ignoring shared cache entry %s older than %v", hash, s.ttl)
+		return "", false
+	}
+	return entry.Digest, true
+}
+
+// publish shares the digest of an artifact that was built and pushed.
+func (s *sharedCache) publish(ctx context.Context, hash, tag, digest string) error {
+	if s.readOnly || hash == "" || digest == "" {
+		return nil
+	}
+
+	image := tag
+	if ref, err := docker.ParseReference(tag); err == nil {
+		image = ref.BaseName
+	}
+
+	return s.store.Put(ctx, hash, sharedEntry{
+		Digest:  digest,
+		Image:   image,
+		Created: currentTime(),
+	})
+}
+
+// publishArtifacts shares the artifacts that were built and pushed with other users.
+func (c *cache) publishArtifacts(ctx context.Context, bRes []graph.Artifact, hashByName map[string]string) {
+	if c.shared == nil {
+		return
+	}
+
+	for _, a := range bRes {
+		hash := hashByName[a.ImageName]
+		c.cacheMutex.RLock()
+		entry := c.artifactCache[hash]
+		c.cacheMutex.RUnlock()
+
+		// Images that were only loaded into the local Docker daemon have no digest.
+		if entry.Digest == "" {
+			continue
+		}
+		if err := c.shared.publish(ctx, hash, a.Tag, entry.Digest); err != nil {
+			logrus.Warnf("error publishing %s to shared cache: %v", a.ImageName, err)
+		}
+	}
+}
+
+// httpStore keeps one JSON document per hash under a base URL.
+type httpStore struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func (s *httpStore) Get(ctx context.Context, hash string) (*sharedEntry, error) {
+	resp, err := s.do(ctx, http.MethodGet, hash, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var entry sharedEntry
+		if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+			return nil, fmt.Errorf("decoding shared cache entry: %w", err)
+		}
+		return &entry, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("reading shared cache entry: %s", resp.Status)
+	}
+}
+
+func (s *httpStore) Put(ctx context.Context, hash string, entry sharedEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, hash, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("writing shared cache entry: %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *httpStore) do(ctx context.Context, method, hash string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+"/"+hash+".json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return s.client.Do(req)
+}
+
+// fileStore keeps one JSON file per hash in a directory, typically on a shared filesystem.
+type fileStore struct {
+	dir string
+}
+
+func (s *fileStore) Get(_ context.Context, hash string) (*sharedEntry, error) {
+	data, err := ioutil.ReadFile(s.path(hash))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry sharedEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("decoding shared cache entry: %w", err)
+	}
+	return &entry, nil
+}
+
+func (s *fileStore) Put(_ context.Context, hash string, entry sharedEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+
+	// Write then rename so that concurrent readers never see a partial entry.
+	tmp, err := ioutil.TempFile(s.dir, hash+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(hash))
+}
+
+func (s *fileStore) path(hash string) string {
+	return filepath.Join(s.dir, hash+".json")
+}
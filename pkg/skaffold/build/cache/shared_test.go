@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLookupShared(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description   string
+		shared        map[string]sharedEntry
+		ttl           time.Duration
+		expected      cacheDetails
+		expectedCache ArtifactCache
+	}{
+		{
+			description:   "miss",
+			shared:        map[string]sharedEntry{},
+			expected:      needsBuilding{hash: "hash"},
+			expectedCache: ArtifactCache{},
+		},
+		{
+			description: "hit",
+			shared: map[string]sharedEntry{
+				"hash": {Digest: "digest", Created: now},
+			},
+			expected:      found{hash: "hash"},
+			expectedCache: ArtifactCache{"hash": {Digest: "digest", Image: "artifact", Tag: "tag", Created: now}},
+		},
+		{
+			description: "hit with different tag",
+			shared: map[string]sharedEntry{
+				"hash": {Digest: "otherdigest", Created: now},
+			},
+			expected:      needsRemoteTagging{hash: "hash", tag: "tag", digest: "otherdigest"},
+			expectedCache: ArtifactCache{"hash": {Digest: "otherdigest", Image: "artifact", Tag: "tag", Created: now}},
+		},
+		{
+			description: "image missing from registry",
+			shared: map[string]sharedEntry{
+				"hash": {Digest: "unknowndigest", Created: now},
+			},
+			expected:      needsBuilding{hash: "hash"},
+			expectedCache: ArtifactCache{},
+		},
+		{
+			description: "expired",
+			shared: map[string]sharedEntry{
+				"hash": {Digest: "digest", Created: now.Add(-2 * time.Hour)},
+			},
+			ttl:           time.Hour,
+			expected:      needsBuilding{hash: "hash"},
+			expectedCache: ArtifactCache{},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&currentTime, func() time.Time { return now })
+			t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+				switch {
+				case identifier == "tag":
+					return "digest", nil
+				case identifier == "tag@otherdigest":
+					return "otherdigest", nil
+				default:
+					return "", errors.New("unknown remote tag")
+				}
+			})
+
+			cache := &cache{
+				isLocalImage:       func(string) (bool, error) { return false, nil },
+				importMissingImage: func(imageName string) (bool, error) { return false, nil },
+				artifactCache:      map[string]ImageDetails{},
+				client:             fakeLocalDaemon(&testutil.FakeAPIClient{ErrImagePull: true}),
+				cfg:                &mockConfig{mode: config.RunModes.Build},
+				shared:             &sharedCache{store: &mockSharedStore{entries: test.shared}, ttl: test.ttl},
+			}
+			t.Override(&newArtifactHasherFunc, func(_ graph.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher {
+				return mockHasher{"hash"}
+			})
+			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latestV1.Artifact{{
+				ImageName: "artifact",
+			}})
+
+			if !reflect.DeepEqual(test.expected, details[0]) {
+				t.Errorf("Expected result different from actual result. Expected: \n%v, \nActual: \n%v", test.expected, details)
+			}
+			t.CheckDeepEqual(test.expectedCache, cache.artifactCache)
+		})
+	}
+}
+
+func TestPublishArtifacts(t *testing.T) {
+	tests := []struct {
+		description string
+		readOnly    bool
+		expected    []string
+	}{
+		{
+			description: "read-write",
+			expected:    []string{"hash1"},
+		},
+		{
+			description: "read-only",
+			readOnly:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			digest := "sha256:" + strings.Repeat("a", 64)
+			store := &mockSharedStore{entries: map[string]sharedEntry{}}
+			cache := &cache{
+				artifactCache: map[string]ImageDetails{
+					"hash1": {Digest: digest},
+					"hash2": {ID: "imageID"},
+				},
+				shared: &sharedCache{store: store, readOnly: test.readOnly},
+			}
+
+			cache.publishArtifacts(context.Background(), []graph.Artifact{
+				{ImageName: "pushed", Tag: "gcr.io/project/pushed:tag@" + digest},
+				{ImageName: "local", Tag: "local:tag"},
+			}, map[string]string{"pushed": "hash1", "local": "hash2"})
+
+			var published []string
+			for hash, entry := range store.entries {
+				published = append(published, hash)
+				t.CheckDeepEqual(digest, entry.Digest)
+				t.CheckDeepEqual("gcr.io/project/pushed", entry.Image)
+			}
+			t.CheckDeepEqual(test.expected, published)
+		})
+	}
+}
+
+func TestSharedStores(t *testing.T) {
+	var lock sync.Mutex
+	objects := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			object, found := objects[r.URL.Path]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(object))
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			objects[r.URL.Path] = string(body)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		description string
+		url         func(t *testutil.T) string
+	}{
+		{
+			description: "http",
+			url:         func(*testutil.T) string { return server.URL + "/cache/" },
+		},
+		{
+			description: "file",
+			url:         func(t *testutil.T) string { return "file://" + t.NewTempDir().Path("cache") },
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{sharedCacheTokenEnv: "secret"})
+
+			store, err := newSharedStore(test.url(t))
+			t.CheckNoError(err)
+
+			entry, err := store.Get(context.Background(), "hash")
+			t.CheckNoError(err)
+			t.CheckDeepEqual((*sharedEntry)(nil), entry)
+
+			created := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+			err = store.Put(context.Background(), "hash", sharedEntry{Digest: "sha256:abc", Image: "image", Created: created})
+			t.CheckNoError(err)
+
+			entry, err = store.Get(context.Background(), "hash")
+			t.CheckNoError(err)
+			t.CheckDeepEqual(&sharedEntry{Digest: "sha256:abc", Image: "image", Created: created}, entry)
+		})
+	}
+}
+
+func TestNewSharedStoreInvalid(t *testing.T) {
+	_, err := newSharedStore("s3://bucket/cache")
+	testutil.CheckError(t, true, err)
+	testutil.CheckDeepEqual(t, true, strings.Contains(err.Error(), "unsupported shared cache url"))
+}
+
+type mockSharedStore struct {
+	entries map[string]sharedEntry
+}
+
+func (s *mockSharedStore) Get(_ context.Context, hash string) (*sharedEntry, error) {
+	if entry, found := s.entries[hash]; found {
+		return &entry, nil
+	}
+	return nil, nil
+}
+
+func (s *mockSharedStore) Put(_ context.Context, hash string, entry sharedEntry) error {
+	s.entries[hash] = entry
+	return nil
+}
@@ -31,13 +31,14 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const initContainer = "kaniko-init-container"
 
-func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latestV1.KanikoArtifact, tag string, requiredImages map[string]*string) (string, error) {
+func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latestV1.KanikoArtifact, builderHooks []latestV1.ContainerHook, tag string, requiredImages map[string]*string) (string, error) {
 	generatedEnvs, err := generateEnvFromImage(tag)
 	if err != nil {
 		return "", fmt.Errorf("error processing generated env variables from image uri: %w", err)
@@ -81,6 +82,15 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 		return "", fmt.Errorf("copying sources: %w", err)
 	}
 
+	if err := b.runBuilderHooks(ctx, out, builderHooks, pod.Name); err != nil {
+		return "", err
+	}
+
+	// Generate a file to successfully terminate the init container.
+	if out, err := b.kubectlcli.RunOut(ctx, "exec", pod.Name, "-c", initContainer, "-n", b.Namespace, "--", "touch", "/tmp/complete"); err != nil {
+		return "", fmt.Errorf("finishing upload of the build context: %s", out)
+	}
+
 	// Wait for the pods to succeed while streaming the logs
 	waitForLogs := streamLogs(ctx, out, pod.Name, pods)
 
@@ -96,7 +106,6 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
 // Via kubectl exec, we extract the tarball to the empty dir
-// Then, via kubectl exec, create the /tmp/complete file via kubectl exec to complete the init container
 func (b *Builder) copyKanikoBuildContext(ctx context.Context, workspace string, artifactName string, artifact *latestV1.KanikoArtifact, pods corev1.PodInterface, podName string) error {
 	if err := kubernetes.WaitForPodInitialized(ctx, pods, podName); err != nil {
 		return fmt.Errorf("waiting for pod to initialize: %w", err)
@@ -120,11 +129,24 @@ func (b *Builder) copyKanikoBuildContext(ctx context.Context, workspace string,
 		return fmt.Errorf("uploading build context: %s", out.String())
 	}
 
-	// Generate a file to successfully terminate the init container.
-	if out, err := b.kubectlcli.RunOut(ctx, "exec", podName, "-c", initContainer, "-n", b.Namespace, "--", "touch", "/tmp/complete"); err != nil {
-		return fmt.Errorf("finishing upload of the build context: %s", out)
+	return nil
+}
+
+// runBuilderHooks runs the builder lifecycle hooks in the init container, from the build context directory.
+// The kaniko container only starts once the init container completes, so the hooks can modify the build context.
+func (b *Builder) runBuilderHooks(ctx context.Context, out io.Writer, builderHooks []latestV1.ContainerHook, podName string) error {
+	if len(builderHooks) == 0 {
+		return nil
 	}
 
+	output.Default.Fprintln(out, "Starting builder hooks...")
+	for i, h := range builderHooks {
+		args := append([]string{podName, "-c", initContainer, "-n", b.Namespace, "--", "sh", "-c", fmt.Sprintf(`cd %s && exec "$@"`, kaniko.DefaultEmptyDirMountPath), "sh"}, h.Command...)
+		if err := b.kubectlcli.Run(ctx, nil, out, "exec", args...); err != nil {
+			return fmt.Errorf("failed to execute builder hook %d: %w", i+1, err)
+		}
+	}
+	output.Default.Fprintln(out, "Completed builder hooks")
 	return nil
 }
 
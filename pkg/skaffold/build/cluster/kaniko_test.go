@@ -17,11 +17,16 @@ limitations under the License.
 package cluster
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -96,3 +101,49 @@ func TestEnvInterpolation_Latest(t *testing.T) {
 	}
 	testutil.CheckElementsMatch(t, expected, actual)
 }
+
+func TestRunBuilderHooks(t *testing.T) {
+	tests := []struct {
+		description string
+		hooks       []latestV1.ContainerHook
+		commands    util.Command
+		expectedOut string
+		shouldErr   bool
+	}{
+		{
+			description: "no hooks",
+			commands:    testutil.CmdRun("unexpected"),
+		},
+		{
+			description: "hooks run in the init container",
+			hooks:       []latestV1.ContainerHook{{Command: []string{"make", "generate"}}, {Command: []string{"ls"}}},
+			commands: testutil.
+				CmdRunWithOutput(`kubectl --context kubecontext exec kaniko-abc -c kaniko-init-container -n ns -- sh -c cd /kaniko/buildcontext && exec "$@" sh make generate`, "generated\n").
+				AndRunWithOutput(`kubectl --context kubecontext exec kaniko-abc -c kaniko-init-container -n ns -- sh -c cd /kaniko/buildcontext && exec "$@" sh ls`, "Dockerfile\n"),
+			expectedOut: "Starting builder hooks...\ngenerated\nDockerfile\nCompleted builder hooks\n",
+		},
+		{
+			description: "hook fails",
+			hooks:       []latestV1.ContainerHook{{Command: []string{"false"}}},
+			commands:    testutil.CmdRunErr(`kubectl --context kubecontext exec kaniko-abc -c kaniko-init-container -n ns -- sh -c cd /kaniko/buildcontext && exec "$@" sh false`, errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			b := &Builder{
+				ClusterDetails: &latestV1.ClusterDetails{Namespace: "ns"},
+				kubectlcli:     &kubectl.CLI{KubeContext: "kubecontext"},
+			}
+
+			var out bytes.Buffer
+			err := b.runBuilderHooks(context.Background(), &out, test.hooks, "kaniko-abc")
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedOut, out.String())
+			}
+		})
+	}
+}
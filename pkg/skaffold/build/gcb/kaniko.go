@@ -47,15 +47,35 @@ func (b *Builder) kanikoBuildSpec(a *latestV1.Artifact, tag string) (cloudbuild.
 		return cloudbuild.Build{}, fmt.Errorf("unable to evaluate env variables: %w", err)
 	}
 
+	steps := builderHookSteps(k.InitImage, a.LifecycleHooks.BuilderHooks)
+	steps = append(steps, &cloudbuild.BuildStep{
+		Name: b.KanikoImage,
+		Args: kanikoArgs,
+		Env:  env,
+	})
+
 	return cloudbuild.Build{
-		Steps: []*cloudbuild.BuildStep{{
-			Name: b.KanikoImage,
-			Args: kanikoArgs,
-			Env:  env,
-		}},
+		Steps: steps,
 	}, nil
 }
 
+// builderHookSteps creates a build step for each builder lifecycle hook.
+// The steps run in order, from the build context directory, before the kaniko executor starts.
+func builderHookSteps(image string, builderHooks []latestV1.ContainerHook) []*cloudbuild.BuildStep {
+	var steps []*cloudbuild.BuildStep
+	for _, h := range builderHooks {
+		if len(h.Command) == 0 {
+			continue
+		}
+		steps = append(steps, &cloudbuild.BuildStep{
+			Name:       image,
+			Entrypoint: h.Command[0],
+			Args:       h.Command[1:],
+		})
+	}
+	return steps
+}
+
 func envFromVars(env []v1.EnvVar) []string {
 	s := make([]string, 0, len(env))
 	for _, envVar := range env {
@@ -444,6 +444,41 @@ func TestKanikoBuildSpec(t *testing.T) {
 	}
 }
 
+func TestKanikoBuildSpecWithBuilderHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+		builder := NewBuilder(&mockBuilderContext{artifactStore: mockArtifactStore{}}, &latestV1.GoogleCloudBuild{
+			KanikoImage: "gcr.io/kaniko-project/executor",
+		})
+		artifact := &latestV1.Artifact{
+			ImageName: "img1",
+			ArtifactType: latestV1.ArtifactType{
+				KanikoArtifact: &latestV1.KanikoArtifact{
+					DockerfilePath: "Dockerfile",
+					InitImage:      "busybox",
+				},
+			},
+			LifecycleHooks: latestV1.BuildHooks{
+				BuilderHooks: []latestV1.ContainerHook{
+					{Command: []string{"sh", "-c", "make generate"}},
+					{Command: []string{"ls"}},
+				},
+			},
+		}
+
+		desc, err := builder.kanikoBuildSpec(artifact, "gcr.io/nginx")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]*cloudbuild.BuildStep{
+			{Name: "busybox", Entrypoint: "sh", Args: []string{"-c", "make generate"}},
+			{Name: "busybox", Entrypoint: "ls", Args: []string{}},
+			{Name: "gcr.io/kaniko-project/executor", Args: []string{"--destination", "gcr.io/nginx", "--dockerfile", "Dockerfile"}},
+		}, desc.Steps)
+	})
+}
+
 type mockArtifactStore map[string]string
 
 func (m mockArtifactStore) GetImageTag(imageName string) (string, bool) { return m[imageName], true }
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	kobuild "github.com/google/ko/pkg/build"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+)
+
+const (
+	// defaultBaseImage is the base image used by ko when none is configured.
+	defaultBaseImage = "gcr.io/distroless/static:nonroot"
+
+	// defaultPlatform is the platform ko builds for when none is configured.
+	defaultPlatform = "linux/amd64"
+)
+
+// Build builds an artifact with ko: https://github.com/google/ko
+func (b *Builder) Build(ctx context.Context, out io.Writer, a *latestV1.Artifact, tag string) (string, error) {
+	instrumentation.AddAttributesToCurrentSpanFromContext(ctx, map[string]string{
+		"BuildType":   "ko",
+		"Context":     instrumentation.PII(a.Workspace),
+		"Destination": instrumentation.PII(tag),
+	})
+
+	result, err := b.build(ctx, out, a)
+	if err != nil {
+		return "", err
+	}
+
+	if b.pushImages {
+		return b.push(result, tag)
+	}
+	return b.load(ctx, out, result, tag)
+}
+
+// build runs `go build` and adds the binary to the base image, or to each image of
+// the base image index for multi-platform builds, with the layout of images built by ko.
+func (b *Builder) build(ctx context.Context, out io.Writer, a *latestV1.Artifact) (kobuild.Result, error) {
+	env, err := goEnv(a.KoArtifact)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := listPackage(ctx, a, env)
+	if err != nil {
+		return nil, err
+	}
+
+	output.Default.Fprintf(out, "Building %s with ko\n", pkg.ImportPath)
+	platforms := platformSpec(a.KoArtifact.Platforms)
+	base, err := b.getBase(ctx, a.KoArtifact.BaseImage, platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	switch base := base.(type) {
+	case v1.ImageIndex:
+		return b.buildIndex(ctx, out, a, pkg, env, base, platforms)
+	case v1.Image:
+		return b.buildImage(ctx, out, a, pkg, env, base, nil)
+	default:
+		return nil, fmt.Errorf("unexpected base image of type %T", base)
+	}
+}
+
+// getBase retrieves the base image.
+// A multi-platform build needs the full image index, otherwise
+// the base image for the requested platform is enough.
+func (b *Builder) getBase(ctx context.Context, baseImage, platforms string) (kobuild.Result, error) {
+	if baseImage == "" {
+		baseImage = defaultBaseImage
+	}
+
+	opts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithUserAgent(version.UserAgentWithClient()),
+	}
+	multiPlatform := isMultiPlatform(platforms)
+	if !multiPlatform {
+		p, err := parsePlatform(platforms)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, remote.WithPlatform(p))
+	}
+
+	desc, err := docker.RemoteDescriptor(baseImage, b.cfg, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("getting base image %q: %w", baseImage, err)
+	}
+
+	switch desc.MediaType {
+	case types.OCIImageIndex, types.DockerManifestList:
+		if multiPlatform {
+			return desc.ImageIndex()
+		}
+	}
+	return desc.Image()
+}
+
+// push pushes the image, or image index for multi-platform builds, to the registry.
+func (b *Builder) push(result kobuild.Result, tag string) (string, error) {
+	switch r := result.(type) {
+	case v1.ImageIndex:
+		return docker.PushIndex(r, tag, b.cfg)
+	case v1.Image:
+		return docker.PushImage(r, tag, b.cfg)
+	default:
+		return "", fmt.Errorf("unexpected ko build result of type %T", result)
+	}
+}
+
+// load loads the image into the local Docker daemon.
+// For multi-platform builds, only the image matching the daemon's platform is loaded.
+func (b *Builder) load(ctx context.Context, out io.Writer, result kobuild.Result, tag string) (string, error) {
+	img, err := b.localImage(ctx, result)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+
+	imageID, err := b.localDocker.Load(ctx, out, r, tag)
+	r.Close()
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+	return imageID, nil
+}
+
+func (b *Builder) localImage(ctx context.Context, result kobuild.Result) (v1.Image, error) {
+	switch r := result.(type) {
+	case v1.Image:
+		return r, nil
+	case v1.ImageIndex:
+		v, err := b.localDocker.ServerVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := r.IndexManifest()
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range manifest.Manifests {
+			if desc.Platform != nil && desc.Platform.OS == v.Os && desc.Platform.Architecture == v.Arch {
+				return r.Image(desc.Digest)
+			}
+		}
+		return nil, fmt.Errorf("no image built for the docker daemon platform %s/%s", v.Os, v.Arch)
+	default:
+		return nil, fmt.Errorf("unexpected ko build result of type %T", result)
+	}
+}
+
+// importPath returns the Go import path, or the local package path, of the main package to build.
+// Image names using the `ko://` scheme take precedence over the configured main package.
+func importPath(a *latestV1.Artifact) string {
+	if strings.HasPrefix(a.ImageName, kobuild.StrictScheme) {
+		return strings.TrimPrefix(a.ImageName, kobuild.StrictScheme)
+	}
+	if a.KoArtifact.Main != "" {
+		return a.KoArtifact.Main
+	}
+	return "."
+}
+
+// platformSpec returns the platforms in the comma-separated format used by ko.
+func platformSpec(platforms []string) string {
+	if len(platforms) == 0 {
+		return defaultPlatform
+	}
+	return strings.Join(platforms, ",")
+}
+
+func isMultiPlatform(platforms string) bool {
+	return platforms == "all" || strings.Contains(platforms, ",")
+}
+
+// parsePlatform parses a platform of the form `os[/arch[/variant]]`.
+func parsePlatform(platform string) (v1.Platform, error) {
+	var p v1.Platform
+	parts := strings.Split(strings.TrimSpace(platform), "/")
+	if len(parts) > 3 {
+		return p, fmt.Errorf("too many slashes in platform %q, expected os[/arch[/variant]]", platform)
+	}
+	p.OS = parts[0]
+	if len(parts) > 1 {
+		p.Architecture = parts[1]
+	}
+	if len(parts) > 2 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
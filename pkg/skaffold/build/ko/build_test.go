@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestImportPath(t *testing.T) {
+	tests := []struct {
+		description string
+		imageName   string
+		main        string
+		expected    string
+	}{
+		{
+			description: "default to context directory",
+			imageName:   "gcr.io/project/app",
+			expected:    ".",
+		},
+		{
+			description: "main package",
+			imageName:   "gcr.io/project/app",
+			main:        "./cmd/app",
+			expected:    "./cmd/app",
+		},
+		{
+			description: "ko scheme",
+			imageName:   "ko://github.com/org/repo/cmd/app",
+			expected:    "github.com/org/repo/cmd/app",
+		},
+		{
+			description: "ko scheme takes precedence over main",
+			imageName:   "ko://github.com/org/repo/cmd/app",
+			main:        "./cmd/other",
+			expected:    "github.com/org/repo/cmd/app",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			path := importPath(&latestV1.Artifact{
+				ImageName: test.imageName,
+				ArtifactType: latestV1.ArtifactType{
+					KoArtifact: &latestV1.KoArtifact{Main: test.main},
+				},
+			})
+
+			t.CheckDeepEqual(test.expected, path)
+		})
+	}
+}
+
+func TestPlatformSpec(t *testing.T) {
+	tests := []struct {
+		description   string
+		platforms     []string
+		expected      string
+		multiPlatform bool
+	}{
+		{
+			description: "default",
+			expected:    "linux/amd64",
+		},
+		{
+			description: "single platform",
+			platforms:   []string{"linux/arm64"},
+			expected:    "linux/arm64",
+		},
+		{
+			description:   "multiple platforms",
+			platforms:     []string{"linux/amd64", "linux/arm/v7"},
+			expected:      "linux/amd64,linux/arm/v7",
+			multiPlatform: true,
+		},
+		{
+			description:   "all",
+			platforms:     []string{"all"},
+			expected:      "all",
+			multiPlatform: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			spec := platformSpec(test.platforms)
+
+			t.CheckDeepEqual(test.expected, spec)
+			t.CheckDeepEqual(test.multiPlatform, isMultiPlatform(spec))
+		})
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		expected    v1.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "os only",
+			platform:    "linux",
+			expected:    v1.Platform{OS: "linux"},
+		},
+		{
+			description: "os and arch",
+			platform:    "linux/amd64",
+			expected:    v1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			description: "os, arch and variant",
+			platform:    "linux/arm/v7",
+			expected:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			description: "too many slashes",
+			platform:    "linux/arm/v7/extra",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p, err := parsePlatform(test.platform)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, p)
+			}
+		})
+	}
+}
@@ -17,13 +17,13 @@ limitations under the License.
 package ko
 
 import (
-	"testing"
+	"context"
 
-	kobuild "github.com/google/ko/pkg/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 )
 
-func TestStub(t *testing.T) {
-	if KoScheme != kobuild.StrictScheme {
-		t.Fatal()
-	}
+// GetDependencies returns dependencies listed for a ko artifact
+func GetDependencies(ctx context.Context, workspace string, a *latestV1.KoArtifact) ([]string, error) {
+	return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore)
 }
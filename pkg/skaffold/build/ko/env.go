@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// goEnv returns the environment of the `go` commands run for an artifact:
+// the environment of the Skaffold process followed by the artifact's env, so that the latter wins.
+// The Skaffold process environment itself is never modified.
+func goEnv(a *latestV1.KoArtifact) ([]string, error) {
+	env, err := misc.EvaluateEnv(a.Env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate env variables: %w", err)
+	}
+
+	return append(os.Environ(), env...), nil
+}
+
+// platformEnv returns the environment used to cross-compile for the given platform.
+// It comes first in the `go build` environment, so it can be overridden.
+func platformEnv(p v1.Platform) ([]string, error) {
+	env := []string{
+		"CGO_ENABLED=0",
+		"GOOS=" + p.OS,
+		"GOARCH=" + p.Architecture,
+	}
+
+	if strings.HasPrefix(p.Architecture, "arm") && p.Variant != "" {
+		goarm, err := goarm(p.Variant)
+		if err != nil {
+			return nil, err
+		}
+		if goarm != "" {
+			env = append(env, "GOARM="+goarm)
+		}
+	}
+	return env, nil
+}
+
+// goarm returns the `GOARM` value for an arm variant of the form `v<n>`.
+// Variants before v5 aren't supported by the go toolchain and variants after v7 build as v7.
+func goarm(variant string) (string, error) {
+	if !strings.HasPrefix(variant, "v") {
+		return "", fmt.Errorf("unexpected arm variant %q, expected v<n>", variant)
+	}
+
+	v, err := strconv.Atoi(strings.TrimPrefix(variant, "v"))
+	if err != nil {
+		return "", fmt.Errorf("parsing arm variant %q: %w", variant, err)
+	}
+	switch {
+	case v < 5:
+		return "", nil
+	case v > 7:
+		return "7", nil
+	default:
+		return strconv.Itoa(v), nil
+	}
+}
+
+// buildArgs returns the arguments of the `go build` command writing the binary of the package to `out`.
+func buildArgs(a *latestV1.KoArtifact, pkg string, out string, disableOptimizations bool) []string {
+	args := []string{"build"}
+	if disableOptimizations {
+		// Disable optimizations (-N) and inlining (-l).
+		args = append(args, "-gcflags", "all=-N -l")
+	}
+	args = append(args, a.Flags...)
+	if len(a.Ldflags) > 0 {
+		args = append(args, "-ldflags="+strings.Join(a.Ldflags, " "))
+	}
+	return append(args, "-trimpath", "-o", out, pkg)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGoEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latestV1.KoArtifact
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "empty",
+			artifact:    &latestV1.KoArtifact{},
+		},
+		{
+			description: "env",
+			artifact:    &latestV1.KoArtifact{Env: []string{"GOPRIVATE=example.com", "CGO_ENABLED=1"}},
+			expected:    []string{"GOPRIVATE=example.com", "CGO_ENABLED=1"},
+		},
+		{
+			description: "env template",
+			artifact:    &latestV1.KoArtifact{Env: []string{"GOPRIVATE={{.PRIVATE_REPO}}"}},
+			expected:    []string{"GOPRIVATE=git.example.com"},
+		},
+		{
+			description: "invalid env",
+			artifact:    &latestV1.KoArtifact{Env: []string{"GOPRIVATE"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{
+				"GOPRIVATE":    "",
+				"PRIVATE_REPO": "git.example.com",
+			})
+
+			env, err := goEnv(test.artifact)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				// The artifact's env comes last so that it wins over the Skaffold environment.
+				t.CheckDeepEqual(os.Environ(), env[:len(env)-len(test.expected)])
+				t.CheckDeepEqual(test.expected, env[len(env)-len(test.expected):], cmpopts.EquateEmpty())
+			}
+			t.CheckDeepEqual("", os.Getenv("GOPRIVATE"))
+		})
+	}
+}
+
+func TestPlatformEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    v1.Platform
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "os and arch",
+			platform:    v1.Platform{OS: "linux", Architecture: "amd64"},
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64"},
+		},
+		{
+			description: "arm variant",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"},
+		},
+		{
+			description: "arm variant after v7",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v8"},
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"},
+		},
+		{
+			description: "arm variant before v5",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v4"},
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm"},
+		},
+		{
+			description: "invalid arm variant",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "seven"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			env, err := platformEnv(test.platform)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, env)
+		})
+	}
+}
+
+func TestBuildArgs(t *testing.T) {
+	tests := []struct {
+		description          string
+		artifact             *latestV1.KoArtifact
+		disableOptimizations bool
+		expected             []string
+	}{
+		{
+			description: "default",
+			artifact:    &latestV1.KoArtifact{},
+			expected:    []string{"build", "-trimpath", "-o", "out", "example.com/app"},
+		},
+		{
+			description: "flags and ldflags",
+			artifact: &latestV1.KoArtifact{
+				Flags:   []string{"-v", "-tags=netgo"},
+				Ldflags: []string{"-s", "-w", "-X main.version=1.0"},
+			},
+			expected: []string{"build", "-v", "-tags=netgo", "-ldflags=-s -w -X main.version=1.0", "-trimpath", "-o", "out", "example.com/app"},
+		},
+		{
+			description:          "debug",
+			artifact:             &latestV1.KoArtifact{Flags: []string{"-v"}},
+			disableOptimizations: true,
+			expected:             []string{"build", "-gcflags", "all=-N -l", "-v", "-trimpath", "-o", "out", "example.com/app"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			args := buildArgs(test.artifact, "example.com/app", "out", test.disableOptimizations)
+
+			t.CheckDeepEqual(test.expected, args)
+		})
+	}
+}
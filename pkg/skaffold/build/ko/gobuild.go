@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// goPackage is the subset of the `go list -json` output used to build a package.
+type goPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string
+}
+
+// listPackage resolves the package pattern, relative to the artifact context, to a single main package.
+func listPackage(ctx context.Context, a *latestV1.Artifact, env []string) (*goPackage, error) {
+	pattern := importPath(a)
+
+	cmd := exec.CommandContext(ctx, "go", "list", "-json", pattern)
+	cmd.Dir = a.Workspace
+	cmd.Env = env
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("listing go package %q: %w", pattern, err)
+	}
+
+	var pkgs []goPackage
+	for dec := json.NewDecoder(bytes.NewReader(out)); dec.More(); {
+		var pkg goPackage
+		if err := dec.Decode(&pkg); err != nil {
+			return nil, fmt.Errorf("parsing go package %q: %w", pattern, err)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%q cannot be built by ko: found %d packages, expected 1", pattern, len(pkgs))
+	}
+	if pkgs[0].Name != "main" {
+		return nil, fmt.Errorf("%q cannot be built by ko: it is not a main package", pkgs[0].ImportPath)
+	}
+	return &pkgs[0], nil
+}
+
+// goBuild builds the binary of the package for the given platform into a temporary directory.
+// The caller is responsible for removing the directory of the returned binary.
+func (b *Builder) goBuild(ctx context.Context, out io.Writer, a *latestV1.Artifact, pkg *goPackage, env []string, platform v1.Platform) (string, error) {
+	penv, err := platformEnv(platform)
+	if err != nil {
+		return "", fmt.Errorf("building %q for %s: %w", pkg.ImportPath, platformString(platform), err)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "ko")
+	if err != nil {
+		return "", err
+	}
+	binary := filepath.Join(tmpDir, "out")
+
+	cmd := exec.CommandContext(ctx, "go", buildArgs(a.KoArtifact, pkg.ImportPath, binary, b.mode == config.RunModes.Debug)...)
+	cmd.Dir = a.Workspace
+	cmd.Env = append(penv, env...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		os.RemoveAll(tmpDir)
+		return "", fmt.Errorf("building %q for %s: %w", pkg.ImportPath, platformString(platform), err)
+	}
+	return binary, nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestListPackage(t *testing.T) {
+	tests := []struct {
+		description string
+		command     *testutil.FakeCmd
+		expected    *goPackage
+		shouldErr   bool
+	}{
+		{
+			description: "main package",
+			command: testutil.CmdRunOut("go list -json ./cmd/app",
+				`{"ImportPath": "example.com/app/cmd/app", "Name": "main", "Dir": "/app/cmd/app"}`),
+			expected: &goPackage{ImportPath: "example.com/app/cmd/app", Name: "main", Dir: "/app/cmd/app"},
+		},
+		{
+			description: "not a main package",
+			command:     testutil.CmdRunOut("go list -json ./cmd/app", `{"ImportPath": "example.com/app/cmd/app", "Name": "app"}`),
+			shouldErr:   true,
+		},
+		{
+			description: "several packages",
+			command: testutil.CmdRunOut("go list -json ./cmd/app",
+				`{"ImportPath": "example.com/app/cmd/app", "Name": "main"}
+{"ImportPath": "example.com/app/cmd/app/other", "Name": "main"}`),
+			shouldErr: true,
+		},
+		{
+			description: "go list error",
+			command:     testutil.CmdRunOutErr("go list -json ./cmd/app", "", errors.New("no Go files")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.command)
+
+			pkg, err := listPackage(context.Background(), &latestV1.Artifact{
+				ImageName: "gcr.io/project/app",
+				ArtifactType: latestV1.ArtifactType{
+					KoArtifact: &latestV1.KoArtifact{Main: "./cmd/app"},
+				},
+			}, []string{"GOPRIVATE=example.com"})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, pkg)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// The image layout is the one of images built by ko: https://github.com/google/ko#the-ko-model
+const (
+	// appDir is the directory of the binary in the image.
+	appDir = "/ko-app"
+
+	// kodataRoot is where the `kodata` directory of the main package is copied in the image.
+	kodataRoot = "/var/run/ko"
+)
+
+// buildIndex builds an image for each platform of the base image index matching the requested platforms.
+func (b *Builder) buildIndex(ctx context.Context, out io.Writer, a *latestV1.Artifact, pkg *goPackage, env []string, base v1.ImageIndex, platforms string) (v1.ImageIndex, error) {
+	manifest, err := base.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var adds []mutate.IndexAddendum
+	for _, desc := range manifest.Manifests {
+		if desc.MediaType != types.OCIManifestSchema1 && desc.MediaType != types.DockerManifestSchema2 {
+			return nil, fmt.Errorf("base image manifest %q has unexpected media type %q", desc.Digest, desc.MediaType)
+		}
+		if !matchesPlatform(platforms, desc.Platform) {
+			continue
+		}
+
+		img, err := base.Image(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		img, err = b.buildImage(ctx, out, a, pkg, env, img, desc.Platform)
+		if err != nil {
+			return nil, err
+		}
+		adds = append(adds, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				URLs:        desc.URLs,
+				MediaType:   desc.MediaType,
+				Annotations: desc.Annotations,
+				Platform:    desc.Platform,
+			},
+		})
+	}
+	if len(adds) == 0 {
+		return nil, fmt.Errorf("no platform of the base image matches %q", platforms)
+	}
+
+	mt, err := base.MediaType()
+	if err != nil {
+		return nil, err
+	}
+	return mutate.IndexMediaType(mutate.AppendManifests(empty.Index, adds...), mt), nil
+}
+
+// buildImage builds the binary for the platform, or the platform of the base image if nil,
+// and adds it to the base image.
+func (b *Builder) buildImage(ctx context.Context, out io.Writer, a *latestV1.Artifact, pkg *goPackage, env []string, base v1.Image, platform *v1.Platform) (v1.Image, error) {
+	if platform == nil {
+		cf, err := base.ConfigFile()
+		if err != nil {
+			return nil, err
+		}
+		platform = &v1.Platform{
+			OS:           cf.OS,
+			Architecture: cf.Architecture,
+			OSVersion:    cf.OSVersion,
+		}
+	}
+
+	binary, err := b.goBuild(ctx, out, a, pkg, env, *platform)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(filepath.Dir(binary))
+
+	return appImage(base, pkg, binary)
+}
+
+// appImage adds the kodata directory of the package and its binary to the base image,
+// and sets the binary as the entrypoint.
+func appImage(base v1.Image, pkg *goPackage, binary string) (v1.Image, error) {
+	appPath := path.Join(appDir, appFilename(pkg.ImportPath))
+
+	dataLayer, err := layer(func(tw *tar.Writer) error {
+		return tarKoData(tw, filepath.Join(pkg.Dir, "kodata"), kodataRoot)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating kodata layer: %w", err)
+	}
+	binaryLayer, err := layer(func(tw *tar.Writer) error {
+		return tarBinary(tw, binary, appPath)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating binary layer: %w", err)
+	}
+
+	img, err := mutate.Append(base,
+		mutate.Addendum{
+			Layer: dataLayer,
+			History: v1.History{
+				Author:    "ko",
+				CreatedBy: "ko build " + pkg.ImportPath,
+				Comment:   "kodata contents, at $KO_DATA_PATH",
+			},
+		},
+		mutate.Addendum{
+			Layer: binaryLayer,
+			History: v1.History{
+				Author:    "ko",
+				CreatedBy: "ko build " + pkg.ImportPath,
+				Comment:   "go build output, at " + appPath,
+			},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	cfg = cfg.DeepCopy()
+	cfg.Config.Entrypoint = []string{appPath}
+	cfg.Config.Env = append(withAppDirInPath(cfg.Config.Env), "KO_DATA_PATH="+kodataRoot)
+	cfg.Author = "github.com/google/ko"
+
+	return mutate.ConfigFile(img, cfg)
+}
+
+// appFilename returns the name of the binary in the image, after the last element of the import path.
+func appFilename(importPath string) string {
+	base := path.Base(importPath)
+	if base == "." || base == "/" {
+		return "ko-app"
+	}
+	return base
+}
+
+// withAppDirInPath appends the binary directory to the `PATH` of the image environment.
+func withAppDirInPath(env []string) []string {
+	for i, kv := range env {
+		if strings.HasPrefix(kv, "PATH=") {
+			env[i] = kv + ":" + appDir
+			return env
+		}
+	}
+	return append(env, "PATH="+appDir)
+}
+
+// layer creates an image layer from the tarball written by the given function.
+func layer(write func(tw *tar.Writer) error) (v1.Layer, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := write(tw); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	content := buf.Bytes()
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	}, tarball.WithCompressedCaching)
+}
+
+// tarBinary adds the binary at `name`, along with its parent directories, to the tarball.
+// Modes are fixed so that the layer doesn't depend on the umask or the OS of the host.
+func tarBinary(tw *tar.Writer, binary, name string) error {
+	if err := tarDirectories(tw, path.Dir(name)); err != nil {
+		return err
+	}
+	return tarFile(tw, binary, name)
+}
+
+func tarDirectories(tw *tar.Writer, dir string) error {
+	if dir == "/" || dir == "." {
+		return nil
+	}
+	if err := tarDirectories(tw, path.Dir(dir)); err != nil {
+		return err
+	}
+	return tw.WriteHeader(&tar.Header{
+		Name:     dir,
+		Typeflag: tar.TypeDir,
+		Mode:     0555,
+	})
+}
+
+func tarFile(tw *tar.Writer, file, name string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Size:     info.Size(),
+		Typeflag: tar.TypeReg,
+		Mode:     0555,
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// tarKoData adds the content of the kodata directory, if any, under `root` in the tarball.
+// Symbolic links are followed.
+func tarKoData(tw *tar.Writer, dir, root string) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     root,
+		Typeflag: tar.TypeDir,
+		Mode:     0555,
+	}); err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) && root == kodataRoot {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		hostPath := filepath.Join(dir, entry.Name())
+		name := path.Join(root, entry.Name())
+
+		info, err := os.Stat(hostPath)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			err = tarKoData(tw, hostPath, name)
+		} else {
+			err = tarFile(tw, hostPath, name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesPlatform checks if a platform of the base image index is one of the requested platforms.
+func matchesPlatform(platforms string, p *v1.Platform) bool {
+	if platforms == "all" {
+		return true
+	}
+	if p == nil {
+		return false
+	}
+
+	for _, platform := range strings.Split(platforms, ",") {
+		requested, err := parsePlatform(platform)
+		if err != nil {
+			continue
+		}
+		if (requested.OS == "" || requested.OS == p.OS) &&
+			(requested.Architecture == "" || requested.Architecture == p.Architecture) &&
+			(requested.Variant == "" || requested.Variant == p.Variant) {
+			return true
+		}
+	}
+	return false
+}
+
+func platformString(p v1.Platform) string {
+	if p.Variant != "" {
+		return fmt.Sprintf("%s/%s/%s", p.OS, p.Architecture, p.Variant)
+	}
+	return fmt.Sprintf("%s/%s", p.OS, p.Architecture)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"io"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAppImage(t *testing.T) {
+	tests := []struct {
+		description   string
+		files         map[string]string
+		expectedFiles []string
+	}{
+		{
+			description:   "without kodata",
+			files:         map[string]string{"bin": "binary"},
+			expectedFiles: []string{"/var/run/ko", "/ko-app", "/ko-app/app"},
+		},
+		{
+			description: "with kodata",
+			files: map[string]string{
+				"bin":                  "binary",
+				"app/kodata/index.txt": "index",
+				"app/kodata/static/a":  "a",
+			},
+			expectedFiles: []string{"/var/run/ko", "/var/run/ko/index.txt", "/var/run/ko/static", "/var/run/ko/static/a", "/ko-app", "/ko-app/app"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			base, err := mutate.Config(empty.Image, v1.Config{Env: []string{"PATH=/usr/bin"}})
+			t.CheckNoError(err)
+
+			img, err := appImage(base, &goPackage{ImportPath: "example.com/app", Dir: tmpDir.Path("app")}, tmpDir.Path("bin"))
+			t.CheckNoError(err)
+
+			cfg, err := img.ConfigFile()
+			t.CheckNoError(err)
+			t.CheckDeepEqual([]string{"/ko-app/app"}, cfg.Config.Entrypoint)
+			t.CheckDeepEqual([]string{"PATH=/usr/bin:/ko-app", "KO_DATA_PATH=/var/run/ko"}, cfg.Config.Env)
+
+			layers, err := img.Layers()
+			t.CheckNoError(err)
+			var files []string
+			for _, layer := range layers {
+				rc, err := layer.Uncompressed()
+				t.CheckNoError(err)
+				tr := tar.NewReader(rc)
+				for {
+					hdr, err := tr.Next()
+					if err == io.EOF {
+						break
+					}
+					t.CheckNoError(err)
+					files = append(files, hdr.Name)
+				}
+				rc.Close()
+			}
+			t.CheckDeepEqual(test.expectedFiles, files)
+		})
+	}
+}
+
+func TestMatchesPlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   string
+		platform    *v1.Platform
+		expected    bool
+	}{
+		{
+			description: "all",
+			platforms:   "all",
+			platform:    &v1.Platform{OS: "linux", Architecture: "s390x"},
+			expected:    true,
+		},
+		{
+			description: "one of the platforms",
+			platforms:   "linux/amd64,linux/arm64",
+			platform:    &v1.Platform{OS: "linux", Architecture: "arm64"},
+			expected:    true,
+		},
+		{
+			description: "variant",
+			platforms:   "linux/arm/v7",
+			platform:    &v1.Platform{OS: "linux", Architecture: "arm", Variant: "v6"},
+		},
+		{
+			description: "any variant",
+			platforms:   "linux/arm",
+			platform:    &v1.Platform{OS: "linux", Architecture: "arm", Variant: "v6"},
+			expected:    true,
+		},
+		{
+			description: "no platform",
+			platforms:   "linux/amd64",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, matchesPlatform(test.platforms, test.platform))
+		})
+	}
+}
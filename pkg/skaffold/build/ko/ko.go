@@ -1,25 +0,0 @@
-/*
-Copyright 2021 The Skaffold Authors
-
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
-
-    http://www.apache.org/licenses/LICENSE-2.0
-
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
-
-package ko
-
-import (
-	kobuild "github.com/google/ko/pkg/build"
-)
-
-// KoScheme is the prefix used to disambiguate image references and Go import paths.
-// Adding the const here to force import of a ko package.
-const KoScheme = kobuild.StrictScheme
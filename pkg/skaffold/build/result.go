@@ -37,10 +37,10 @@ var (
 	buffSize = bufferedLinesPerArtifact
 )
 
-// logAggregator provides an interface to create an output writer for each artifact build and later aggregate the logs in build order.
+// LogAggregator provides an interface to create an output writer for each artifact build and later aggregate the logs in build order.
 // The order of output is not guaranteed between multiple builds running concurrently.
-type logAggregator interface {
-	// GetWriter returns an output writer tracked by the logAggregator
+type LogAggregator interface {
+	// GetWriter returns an output writer tracked by the LogAggregator
 	GetWriter() (w io.Writer, close func(), err error)
 	// PrintInOrder prints the output from each allotted writer in build order.
 	// It blocks until the instantiated capacity of io writers have been all allotted and closed, or the context is cancelled.
@@ -125,7 +125,9 @@ func (n *noopLogAggregatorImpl) GetWriter() (io.Writer, func(), error) {
 
 func (n *noopLogAggregatorImpl) PrintInOrder(context.Context) {}
 
-func newLogAggregator(out io.Writer, capacity int, concurrency int) logAggregator {
+// NewLogAggregator creates a LogAggregator for `capacity` writers, that are used by up to `concurrency` concurrent tasks.
+// Sequential tasks write directly to the output.
+func NewLogAggregator(out io.Writer, capacity int, concurrency int) LogAggregator {
 	if concurrency == 1 {
 		return &noopLogAggregatorImpl{out: out}
 	}
@@ -40,7 +40,7 @@ type scheduler struct {
 	artifacts       []*latestV1.Artifact
 	nodes           []node // size len(artifacts)
 	artifactBuilder ArtifactBuilder
-	logger          logAggregator
+	logger          LogAggregator
 	results         ArtifactStore
 	concurrencySem  countingSemaphore
 }
@@ -50,7 +50,7 @@ func newScheduler(artifacts []*latestV1.Artifact, artifactBuilder ArtifactBuilde
 		artifacts:       artifacts,
 		nodes:           createNodes(artifacts),
 		artifactBuilder: artifactBuilder,
-		logger:          newLogAggregator(out, len(artifacts), concurrency),
+		logger:          NewLogAggregator(out, len(artifacts), concurrency),
 		results:         store,
 		concurrencySem:  newCountingSemaphore(concurrency),
 	}
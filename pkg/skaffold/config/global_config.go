@@ -38,6 +38,8 @@ type ContextConfig struct {
 	K3dDisableLoad       *bool         `yaml:"k3d-disable-load,omitempty"`
 	CollectMetrics       *bool         `yaml:"collect-metrics,omitempty"`
 	UpdateCheckConfig    *UpdateConfig `yaml:"update,omitempty"`
+	// PortReservations are the local ports reserved for the port forwards of each project.
+	PortReservations []*PortReservations `yaml:"port-reservations,omitempty"`
 }
 
 // SurveyConfig is the survey config information
@@ -58,3 +60,10 @@ type UpdateConfig struct {
 	// TODO (tejaldesai) Move ContextConfig.UpdateCheck config within this struct
 	LastPrompted string `yaml:"last-prompted,omitempty"`
 }
+
+// PortReservations are the local ports reserved for the port forwards of a project,
+// keyed by forwarded resource.
+type PortReservations struct {
+	Project string         `yaml:"project"`
+	Ports   map[string]int `yaml:"ports,omitempty"`
+}
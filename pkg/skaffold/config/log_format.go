@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "errors"
+
+// These are the list of accepted values for flag `--log-format`.
+const (
+	// print application logs as colored text lines with a prefix
+	textLogFormat = "text"
+	// print application logs as one JSON object per line
+	jsonLogFormat = "json"
+)
+
+// LogFilterOptions holds the application log filters given with flags
+// `--log-include`, `--log-exclude`, `--log-highlight` and `--log-highlight-errors`.
+type LogFilterOptions struct {
+	Include         []string
+	Exclude         []string
+	Highlight       []string
+	HighlightErrors bool
+}
+
+// LogFileOptions holds where and how application logs are persisted, given with flags
+// `--log-dir`, `--log-file-max-size` and `--log-file-max-backups`.
+type LogFileOptions struct {
+	// Dir is the directory logs are written to. Logs aren't persisted if empty.
+	Dir string
+	// MaxSizeMB is the size in megabytes a log file can reach before it's rotated.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated log files kept per container.
+	MaxBackups int
+}
+
+// LogFormatOption holds the value of flag `--log-format`
+// Valid flag values are `text`(default) or `json`.
+type LogFormatOption struct {
+	value string
+}
+
+func (l *LogFormatOption) Type() string {
+	return "string"
+}
+
+func (l *LogFormatOption) Value() string {
+	return l.value
+}
+
+func (l *LogFormatOption) Set(v string) error {
+	switch v {
+	case textLogFormat, jsonLogFormat:
+		l.value = v
+		return nil
+	default:
+		return errors.New("value must be one of `text` or `json`")
+	}
+}
+
+func (l *LogFormatOption) SetNil() error {
+	l.value = textLogFormat
+	return nil
+}
+
+func (l *LogFormatOption) String() string {
+	if l.value == "" {
+		return textLogFormat
+	}
+	return l.value
+}
+
+// JSON specifies if application logs are printed as JSON objects by flag value
+func (l *LogFormatOption) JSON() bool {
+	return l.value == jsonLogFormat
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLogFormatOption(t *testing.T) {
+	tests := []struct {
+		description string
+		option      string
+		shouldErr   bool
+		json        bool
+	}{
+		{
+			description: "text",
+			option:      "text",
+		},
+		{
+			description: "json",
+			option:      "json",
+			json:        true,
+		},
+		{
+			description: "invalid",
+			option:      "yaml",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opt := &LogFormatOption{}
+			err := opt.Set(test.option)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.json, opt.JSON())
+		})
+	}
+}
@@ -45,7 +45,9 @@ type SkaffoldOptions struct {
 	Notification          bool
 	Tail                  bool
 	SkipTests             bool
+	TestFailFast          bool
 	CacheArtifacts        bool
+	ExplainCache          bool
 	EnableRPC             bool
 	Force                 bool
 	NoPrune               bool
@@ -77,6 +79,12 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	SharedCache        SharedCacheOptions
+	LogFormat          LogFormatOption
+	LogFilters         LogFilterOptions
+	LogFiles           LogFileOptions
+	TestReport         TestReportOptions
+	PortForwarder      PortForwarderOption
 	Trigger            string
 	KubeContext        string
 	KubeConfig         string
@@ -92,7 +100,9 @@ type SkaffoldOptions struct {
 	Command            string
 	RPCPort            int
 	RPCHTTPPort        int
+	WebhookPort        int
 	BuildConcurrency   int
+	TestConcurrency    int
 	MakePathsAbsolute  *bool
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
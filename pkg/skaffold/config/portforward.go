@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -211,3 +212,52 @@ func (p PortForwardOptions) ForwardDebug(runMode RunMode) bool {
 	// forward debug-related ports.
 	return p.forwardDebug || (p.compat && runMode == RunModes.Debug)
 }
+
+// These are the list of accepted values for flag `--port-forwarder`.
+const (
+	// kubectlForwarder runs a `kubectl port-forward` process per forwarded port.
+	kubectlForwarder = "kubectl"
+	// nativeForwarder forwards ports in-process with client-go.
+	nativeForwarder = "native"
+)
+
+// PortForwarderOption holds the value of flag `--port-forwarder`
+// Valid flag values are `kubectl`(default) or `native`.
+type PortForwarderOption struct {
+	value string
+}
+
+func (p *PortForwarderOption) Type() string {
+	return "string"
+}
+
+func (p *PortForwarderOption) Value() string {
+	return p.value
+}
+
+func (p *PortForwarderOption) Set(v string) error {
+	switch v {
+	case kubectlForwarder, nativeForwarder:
+		p.value = v
+		return nil
+	default:
+		return errors.New("value must be one of `kubectl` or `native`")
+	}
+}
+
+func (p *PortForwarderOption) SetNil() error {
+	p.value = kubectlForwarder
+	return nil
+}
+
+func (p *PortForwarderOption) String() string {
+	if p.value == "" {
+		return kubectlForwarder
+	}
+	return p.value
+}
+
+// Native specifies if ports are forwarded in-process with client-go by flag value
+func (p *PortForwarderOption) Native() bool {
+	return p.value == nativeForwarder
+}
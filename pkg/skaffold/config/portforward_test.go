@@ -114,3 +114,34 @@ func TestPortForwardOptions_Forwards(t *testing.T) {
 		}
 	}
 }
+
+func TestPortForwarderOption(t *testing.T) {
+	tests := []struct {
+		description string
+		option      string
+		shouldErr   bool
+		native      bool
+	}{
+		{
+			description: "kubectl",
+			option:      "kubectl",
+		},
+		{
+			description: "native",
+			option:      "native",
+			native:      true,
+		},
+		{
+			description: "invalid",
+			option:      "ssh",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opt := &PortForwarderOption{}
+			err := opt.Set(test.option)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.native, opt.Native())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// These are the list of accepted values for flag `--shared-cache-mode`.
+const (
+	// lookup artifacts in the shared cache and publish the ones that were built
+	readWrite = "read-write"
+	// lookup artifacts in the shared cache but never publish to it
+	readOnly = "read-only"
+)
+
+// SharedCacheOptions configures the artifact cache shared between users.
+type SharedCacheOptions struct {
+	// URL is the location of the shared cache, or empty if disabled.
+	URL  string
+	Mode SharedCacheModeOption
+	// TTL is how long shared cache entries are trusted for. Zero means forever.
+	TTL time.Duration
+}
+
+// Enabled returns true if a shared cache is configured.
+func (o SharedCacheOptions) Enabled() bool {
+	return o.URL != ""
+}
+
+// SharedCacheModeOption holds the value of flag `--shared-cache-mode`
+// Valid flag values are `read-write`(default) or `read-only`.
+type SharedCacheModeOption struct {
+	value string
+}
+
+func (s *SharedCacheModeOption) Type() string {
+	return "string"
+}
+
+func (s *SharedCacheModeOption) Value() string {
+	return s.value
+}
+
+func (s *SharedCacheModeOption) Set(v string) error {
+	switch v {
+	case readWrite, readOnly:
+		s.value = v
+		return nil
+	default:
+		return errors.New("value must be one of `read-write` or `read-only`")
+	}
+}
+
+func (s *SharedCacheModeOption) SetNil() error {
+	s.value = readWrite
+	return nil
+}
+
+func (s *SharedCacheModeOption) String() string {
+	if s.value == "" {
+		return readWrite
+	}
+	return s.value
+}
+
+// ReadOnly specifies if publishing artifacts to the shared cache is disabled by flag value
+func (s *SharedCacheModeOption) ReadOnly() bool {
+	return s.value == readOnly
+}
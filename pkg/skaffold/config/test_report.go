@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "errors"
+
+// These are the list of accepted values for flag `--test-report`.
+const (
+	// JUnitTestReport writes test reports as JUnit XML
+	JUnitTestReport = "junit"
+	// JSONTestReport writes test reports as JSON
+	JSONTestReport = "json"
+)
+
+// TestReportOptions holds how test results are reported, given with flags
+// `--test-report` and `--test-report-file`.
+type TestReportOptions struct {
+	// Format is the format of the report. No report is written if empty.
+	Format TestReportFormat
+	// File is the file the report is written to.
+	File string
+}
+
+// TestReportFormat holds the value of flag `--test-report`
+// Valid flag values are `junit`, `json` or empty for no report.
+type TestReportFormat struct {
+	value string
+}
+
+func (f *TestReportFormat) Type() string {
+	return "string"
+}
+
+func (f *TestReportFormat) Value() string {
+	return f.value
+}
+
+func (f *TestReportFormat) Set(v string) error {
+	switch v {
+	case "", JUnitTestReport, JSONTestReport:
+		f.value = v
+		return nil
+	default:
+		return errors.New("value must be one of `junit` or `json`")
+	}
+}
+
+func (f *TestReportFormat) String() string {
+	return f.value
+}
+
+// Enabled specifies if a test report should be written.
+func (f *TestReportFormat) Enabled() bool {
+	return f.value != ""
+}
+
+// ReportFile returns the file the report is written to,
+// defaulting to a file named after the format in the current directory.
+func (o TestReportOptions) ReportFile() string {
+	if o.File != "" {
+		return o.File
+	}
+	if o.Format.Value() == JUnitTestReport {
+		return "skaffold-test-report.xml"
+	}
+	return "skaffold-test-report.json"
+}
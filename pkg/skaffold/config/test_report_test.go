@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTestReportOptions(t *testing.T) {
+	tests := []struct {
+		description  string
+		format       string
+		file         string
+		shouldErr    bool
+		enabled      bool
+		expectedFile string
+	}{
+		{
+			description:  "no report",
+			expectedFile: "skaffold-test-report.json",
+		},
+		{
+			description:  "junit",
+			format:       "junit",
+			enabled:      true,
+			expectedFile: "skaffold-test-report.xml",
+		},
+		{
+			description:  "json with file",
+			format:       "json",
+			file:         "reports/tests.json",
+			enabled:      true,
+			expectedFile: "reports/tests.json",
+		},
+		{
+			description: "invalid",
+			format:      "html",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opts := TestReportOptions{File: test.file}
+			err := opts.Format.Set(test.format)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			t.CheckDeepEqual(test.enabled, opts.Format.Enabled())
+			t.CheckDeepEqual(test.expectedFile, opts.ReportFile())
+		})
+	}
+}
@@ -461,6 +461,51 @@ func UpdateUserSurveyTaken(configFile string, id string) error {
 	return nil
 }
 
+// GetPortReservations returns the local ports reserved for the port forwards of a project.
+func GetPortReservations(configFile string, project string) (map[string]int, error) {
+	cfg, err := ReadConfigFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Global == nil {
+		return nil, nil
+	}
+	for _, r := range cfg.Global.PortReservations {
+		if r.Project == project {
+			return r.Ports, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdatePortReservations persists the local ports reserved for the port forwards of a project.
+func UpdatePortReservations(configFile string, project string, ports map[string]int) error {
+	configFile, err := ResolveConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+	// The cached config is stale once reservations have been written during this session.
+	fullConfig, err := ReadConfigFileNoCache(configFile)
+	if err != nil {
+		return err
+	}
+	if fullConfig.Global == nil {
+		fullConfig.Global = &ContextConfig{}
+	}
+	fullConfig.Global.PortReservations = updatedPortReservations(fullConfig.Global.PortReservations, project, ports)
+	return WriteFullConfig(configFile, fullConfig)
+}
+
+func updatedPortReservations(reservations []*PortReservations, project string, ports map[string]int) []*PortReservations {
+	for _, r := range reservations {
+		if r.Project == project {
+			r.Ports = ports
+			return reservations
+		}
+	}
+	return append(reservations, &PortReservations{Project: project, Ports: ports})
+}
+
 func updatedUserSurveys(us []*UserSurvey, id string) []*UserSurvey {
 	for _, s := range us {
 		if s.ID == id {
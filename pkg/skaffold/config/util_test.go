@@ -784,3 +784,58 @@ kubeContexts: []`,
 		})
 	}
 }
+
+func TestPortReservations(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         string
+		expectedCfg *GlobalConfig
+	}{
+		{
+			description: "add project to empty config",
+			expectedCfg: &GlobalConfig{
+				Global: &ContextConfig{
+					PortReservations: []*PortReservations{{Project: "/app/skaffold.yaml", Ports: map[string]int{"service-web-default-8080": 9000}}},
+				},
+				ContextConfigs: []*ContextConfig{},
+			},
+		},
+		{
+			description: "update existing project",
+			cfg: `
+global:
+  port-reservations:
+  - project: /other/skaffold.yaml
+    ports:
+      service-db-default-5432: 5432
+  - project: /app/skaffold.yaml
+    ports:
+      service-web-default-8080: 8080
+kubeContexts: []`,
+			expectedCfg: &GlobalConfig{
+				Global: &ContextConfig{
+					PortReservations: []*PortReservations{
+						{Project: "/other/skaffold.yaml", Ports: map[string]int{"service-db-default-5432": 5432}},
+						{Project: "/app/skaffold.yaml", Ports: map[string]int{"service-web-default-8080": 9000}},
+					},
+				},
+				ContextConfigs: []*ContextConfig{},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			configFile := t.TempFile("config", []byte(test.cfg))
+			t.Override(&ReadConfigFile, ReadConfigFileNoCache)
+
+			err := UpdatePortReservations(configFile, "/app/skaffold.yaml", map[string]int{"service-web-default-8080": 9000})
+			t.CheckNoError(err)
+
+			cfg, cfgErr := ReadConfigFileNoCache(configFile)
+			t.CheckErrorAndDeepEqual(false, cfgErr, test.expectedCfg, cfg)
+
+			ports, err := GetPortReservations(configFile, "/app/skaffold.yaml")
+			t.CheckErrorAndDeepEqual(false, err, map[string]int{"service-web-default-8080": 9000}, ports)
+		})
+	}
+}
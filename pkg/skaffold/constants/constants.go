@@ -31,6 +31,7 @@ const (
 	Render      = Phase("Render")
 	Deploy      = Phase("Deploy")
 	StatusCheck = Phase("StatusCheck")
+	Verify      = Phase("Verify")
 	PortForward = Phase("PortForward")
 	Sync        = Phase("Sync")
 	DevInit     = Phase("DevInit")
@@ -52,8 +53,13 @@ const (
 
 	DefaultKustomizationPath = "."
 
+	DefaultComposeFilePath = "docker-compose.yml"
+
 	DefaultBusyboxImage = "gcr.io/k8s-skaffold/skaffold-helpers/busybox"
 
+	// DefaultStructureTestImage is the image used to run container structure tests inside the cluster.
+	DefaultStructureTestImage = "gcr.io/gcp-runtimes/container-structure-test:latest"
+
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/k8s-skaffold/skaffold-debug-support"
 
@@ -63,6 +69,7 @@ const (
 
 	DefaultRPCPort     = 50051
 	DefaultRPCHTTPPort = 50052
+	DefaultWebhookPort = 50053
 
 	DefaultPortForwardAddress = "127.0.0.1"
 
@@ -83,6 +90,7 @@ type Phase string
 var (
 	Pod     latestV1.ResourceType = "pod"
 	Service latestV1.ResourceType = "service"
+	Ingress latestV1.ResourceType = "ingress"
 
 	DefaultLocalConcurrency = 1
 )
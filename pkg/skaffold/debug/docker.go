@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	registryv1 "github.com/google/go-containerregistry/pkg/v1"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/annotations"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+// SupportFilesMountPath is where the debugging support files are expected in a container.
+const SupportFilesMountPath = "/dbg"
+
+// TransformContainer configures a container run by a local Docker daemon for debugging, as suited
+// for the runtime of its image. The container's entrypoint, command, environment and exposed ports
+// are rewritten in place. Returns the debugging configuration of the container, and the ID of the
+// image providing the debugging support files (an empty string if not required), or an error if
+// the container could not be transformed.
+func TransformContainer(artifact graph.Artifact, cfg *container.Config, imageConfig registryv1.Config) (annotations.ContainerDebugConfiguration, string, error) {
+	ic := imageConfiguration{
+		artifact:   artifact.ImageName,
+		env:        envAsMap(imageConfig.Env),
+		entrypoint: dupArray(imageConfig.Entrypoint),
+		arguments:  dupArray(imageConfig.Cmd),
+		labels:     dupMap(imageConfig.Labels),
+		workingDir: imageConfig.WorkingDir,
+	}
+
+	// The transforms work on Kubernetes container definitions: wrap the docker container
+	// in a single-container pod, so that debug ports don't clash with the exposed ports.
+	podSpec := v1.PodSpec{Containers: []v1.Container{{
+		Name:    artifact.ImageName,
+		Image:   artifact.Tag,
+		Command: dupArray(cfg.Entrypoint),
+		Args:    dupArray(cfg.Cmd),
+		Ports:   containerPorts(imageConfig.ExposedPorts, cfg.ExposedPorts),
+	}}}
+	c := &podSpec.Containers[0]
+	for name, value := range envAsMap(cfg.Env) {
+		c.Env = append(c.Env, v1.EnvVar{Name: name, Value: value})
+	}
+	sort.Slice(c.Env, func(i, j int) bool { return c.Env[i].Name < c.Env[j].Name })
+
+	portAlloc := func(desiredPort int32) int32 {
+		return allocatePort(&podSpec, desiredPort)
+	}
+	configuration, requiredImage, err := transformContainer(c, ic, portAlloc)
+	if err != nil {
+		return annotations.ContainerDebugConfiguration{}, "", err
+	}
+	configuration.Artifact = ic.artifact
+	if configuration.WorkingDir == "" {
+		configuration.WorkingDir = ic.workingDir
+	}
+
+	cfg.Entrypoint = c.Command
+	cfg.Cmd = c.Args
+	cfg.Env = nil
+	for _, envVar := range c.Env {
+		cfg.Env = append(cfg.Env, fmt.Sprintf("%s=%s", envVar.Name, envVar.Value))
+	}
+	for _, port := range configuration.Ports {
+		if cfg.ExposedPorts == nil {
+			cfg.ExposedPorts = nat.PortSet{}
+		}
+		cfg.ExposedPorts[nat.Port(fmt.Sprintf("%d/tcp", port))] = struct{}{}
+	}
+	return configuration, requiredImage, nil
+}
+
+// containerPorts converts the ports exposed by an image and a container into Kubernetes container ports.
+func containerPorts(imagePorts map[string]struct{}, exposedPorts nat.PortSet) []v1.ContainerPort {
+	var specs []string
+	for spec := range imagePorts {
+		specs = append(specs, spec)
+	}
+	for spec := range exposedPorts {
+		if _, found := imagePorts[string(spec)]; !found {
+			specs = append(specs, string(spec))
+		}
+	}
+	sort.Strings(specs)
+
+	var ports []v1.ContainerPort
+	for _, spec := range specs {
+		proto, port := nat.SplitProtoPort(spec)
+		portNum, err := nat.ParsePort(port)
+		if err != nil || portNum == 0 {
+			continue
+		}
+		ports = append(ports, v1.ContainerPort{ContainerPort: int32(portNum), Protocol: v1.Protocol(strings.ToUpper(proto))})
+	}
+	return ports
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	registryv1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/annotations"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTransformContainer(t *testing.T) {
+	tests := []struct {
+		description           string
+		cfg                   container.Config
+		imageConfig           registryv1.Config
+		expectedCfg           container.Config
+		expectedConfiguration annotations.ContainerDebugConfiguration
+		expectedImage         string
+		shouldErr             bool
+	}{
+		{
+			description: "go",
+			imageConfig: registryv1.Config{
+				Entrypoint: []string{"app"},
+				Env:        []string{"GOMAXPROCS=1"},
+				WorkingDir: "/work",
+			},
+			expectedCfg: container.Config{
+				Entrypoint:   []string{"/dbg/go/bin/dlv", "exec", "--headless", "--continue", "--accept-multiclient", "--listen=:56268", "--api-version=2", "app"},
+				ExposedPorts: nat.PortSet{"56268/tcp": {}},
+			},
+			expectedConfiguration: annotations.ContainerDebugConfiguration{
+				Artifact:   "image",
+				Runtime:    "go",
+				WorkingDir: "/work",
+				Ports:      map[string]uint32{"dlv": 56268},
+			},
+			expectedImage: "go",
+		},
+		{
+			description: "nodejs with exposed port clash",
+			cfg: container.Config{
+				Env:          []string{"FOO=bar"},
+				ExposedPorts: nat.PortSet{"9229/tcp": {}},
+			},
+			imageConfig: registryv1.Config{
+				Cmd: []string{"node", "index.js"},
+				Env: []string{"NODE_VERSION=14"},
+			},
+			expectedCfg: container.Config{
+				Cmd:          []string{"node", "--inspect=0.0.0.0:9230", "index.js"},
+				Env:          []string{"FOO=bar", "PATH=/dbg/nodejs/bin"},
+				ExposedPorts: nat.PortSet{"9229/tcp": {}, "9230/tcp": {}},
+			},
+			expectedConfiguration: annotations.ContainerDebugConfiguration{
+				Artifact: "image",
+				Runtime:  "nodejs",
+				Ports:    map[string]uint32{"devtools": 9230},
+			},
+			expectedImage: "nodejs",
+		},
+		{
+			description: "unknown runtime",
+			imageConfig: registryv1.Config{Entrypoint: []string{"app"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cfg := test.cfg
+
+			configuration, image, err := TransformContainer(graph.Artifact{ImageName: "image", Tag: "image:tag"}, &cfg, test.imageConfig)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(test.expectedCfg, cfg)
+			t.CheckDeepEqual(test.expectedConfiguration, configuration)
+			t.CheckDeepEqual(test.expectedImage, image)
+		})
+	}
+}
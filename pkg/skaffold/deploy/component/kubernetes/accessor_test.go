@@ -39,6 +39,12 @@ func (m mockAccessConfig) Mode() config.RunMode { return "" }
 
 func (m mockAccessConfig) PortForwardOptions() config.PortForwardOptions { return m.opts }
 
+func (m mockAccessConfig) NativePortForward() bool { return false }
+
+func (m mockAccessConfig) GlobalConfig() string { return "" }
+
+func (m mockAccessConfig) ConfigurationFile() string { return "skaffold.yaml" }
+
 func (m mockAccessConfig) PortForwardResources() []*v1.PortForwardResource { return nil }
 
 func TestGetAccessor(t *testing.T) {
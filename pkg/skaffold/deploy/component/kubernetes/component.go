@@ -62,7 +62,8 @@ func newAccessor(cfg portforward.Config, kubeContext string, cli *kubectl.CLI, p
 		if !cfg.PortForwardOptions().Enabled() {
 			k8sAccessor[kubeContext] = &access.NoopAccessor{}
 		}
-		m := portforward.NewForwarderManager(cli, podSelector, labeller.RunIDSelector(), cfg.Mode(), namespaces, cfg.PortForwardOptions(), cfg.PortForwardResources())
+		m := portforward.NewForwarderManager(cli, podSelector, labeller.RunIDSelector(), cfg.Mode(), namespaces, cfg.PortForwardOptions(), cfg.NativePortForward(),
+			portforward.NewPortReservations(cfg.GlobalConfig(), cfg.ConfigurationFile()), cfg.PortForwardResources())
 		if m == nil {
 			k8sAccessor[kubeContext] = &access.NoopAccessor{}
 		} else {
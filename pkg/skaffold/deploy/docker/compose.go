@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/sirupsen/logrus"
+
+	dockerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	// labels set by docker-compose on the containers of a compose project
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+// deployCompose brings the compose stack up on the skaffold network,
+// with the services running the freshly built images.
+func (d *Deployer) deployCompose(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	buf, err := ioutil.ReadFile(d.cfg.ComposeFile)
+	if err != nil {
+		return fmt.Errorf("reading compose file %q: %w", d.cfg.ComposeFile, err)
+	}
+
+	var builtImages []graph.Artifact
+	for _, b := range builds {
+		if util.StrSliceContains(d.cfg.Images, b.ImageName) {
+			builtImages = append(builtImages, b)
+		}
+	}
+
+	composeFile, err := substituteComposeFile(buf, builtImages, d.network)
+	if err != nil {
+		return fmt.Errorf("updating compose file %q: %w", d.cfg.ComposeFile, err)
+	}
+
+	if d.composeFile == "" {
+		f, err := ioutil.TempFile("", "skaffold-compose-*.yaml")
+		if err != nil {
+			return fmt.Errorf("creating temporary compose file: %w", err)
+		}
+		f.Close()
+		d.composeFile = f.Name()
+	}
+	if err := ioutil.WriteFile(d.composeFile, composeFile, 0644); err != nil {
+		return fmt.Errorf("writing temporary compose file: %w", err)
+	}
+
+	if err := d.runCompose(ctx, out, "up", "--detach", "--remove-orphans"); err != nil {
+		return fmt.Errorf("running docker-compose up: %w", err)
+	}
+	return d.trackComposeContainers(ctx)
+}
+
+// trackComposeContainers tracks the containers of the compose services, so that their
+// logs are streamed and their status is checked like for containers run by skaffold.
+func (d *Deployer) trackComposeContainers(ctx context.Context) error {
+	containers, err := d.client.RawClient().ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", composeProjectLabel, d.project))),
+	})
+	if err != nil {
+		return fmt.Errorf("listing compose containers: %w", err)
+	}
+
+	for _, c := range containers {
+		service := c.Labels[composeServiceLabel]
+		name := service
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		if existing, found := d.tracker.ContainerForImage(service); found {
+			if existing.ID == c.ID {
+				continue
+			}
+			// the container was recreated
+			if d.debugManager != nil {
+				d.debugManager.containerRemoved(existing)
+			}
+		}
+		d.tracker.Add(service, tracker.Container{Name: name, ID: c.ID, Image: c.Image})
+	}
+	return nil
+}
+
+// cleanupCompose tears down the compose stack.
+func (d *Deployer) cleanupCompose(ctx context.Context, out io.Writer) error {
+	if d.composeFile == "" {
+		return nil
+	}
+
+	if err := d.runCompose(ctx, out, "down", "--remove-orphans"); err != nil {
+		return fmt.Errorf("running docker-compose down: %w", err)
+	}
+	if err := os.Remove(d.composeFile); err != nil {
+		logrus.Debugf("unable to remove temporary compose file %s: %v", d.composeFile, err)
+	}
+	d.composeFile = ""
+	return nil
+}
+
+func (d *Deployer) runCompose(ctx context.Context, out io.Writer, args ...string) error {
+	cmd := exec.CommandContext(ctx, "docker-compose", append(d.composeArgs(), args...)...)
+	cmd.Env = append(util.OSEnviron(), d.client.ExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	return util.RunCmd(cmd)
+}
+
+// composeArgs returns the global flags of all docker-compose commands. The project directory is
+// the one of the original compose file, so that relative paths in the compose file are still valid.
+func (d *Deployer) composeArgs() []string {
+	return []string{
+		"--project-name", d.project,
+		"--project-directory", filepath.Dir(d.cfg.ComposeFile),
+		"--file", d.composeFile,
+	}
+}
+
+// substituteComposeFile replaces the images of the compose services with the tags of the built artifacts,
+// and makes the skaffold network, if any, the default network of the services.
+func substituteComposeFile(composeFile []byte, builds []graph.Artifact, network string) ([]byte, error) {
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(composeFile, &config); err != nil {
+		return nil, fmt.Errorf("parsing compose file: %w", err)
+	}
+	if config == nil {
+		return nil, fmt.Errorf("compose file is empty")
+	}
+
+	services, ok := config["services"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("compose file has no services")
+	}
+
+	for name, s := range services {
+		service, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		image, ok := service["image"].(string)
+		if !ok {
+			continue
+		}
+		if tag, found := builtTag(image, builds); found {
+			logrus.Debugf("using image %s for compose service %s", tag, name)
+			service["image"] = tag
+			// The image is already built by skaffold.
+			delete(service, "build")
+		}
+	}
+
+	if network == "" {
+		return yaml.Marshal(config)
+	}
+	networks, ok := config["networks"].(map[string]interface{})
+	if !ok {
+		networks = map[string]interface{}{}
+		config["networks"] = networks
+	}
+	networks["default"] = map[string]interface{}{
+		"name":     network,
+		"external": true,
+	}
+
+	return yaml.Marshal(config)
+}
+
+// builtTag returns the tag of the built artifact matching the given image, with or without its tag or digest.
+func builtTag(image string, builds []graph.Artifact) (string, bool) {
+	for _, b := range builds {
+		if image == b.ImageName {
+			return b.Tag, true
+		}
+	}
+
+	ref, err := dockerutil.ParseReference(image)
+	if err != nil {
+		logrus.Debugf("unable to parse image %q: %v", image, err)
+		return "", false
+	}
+	for _, b := range builds {
+		if ref.BaseName == b.ImageName {
+			return b.Tag, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	dockerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSubstituteComposeFile(t *testing.T) {
+	tests := []struct {
+		description string
+		composeFile string
+		builds      []graph.Artifact
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "replace built images",
+			composeFile: `services:
+  web:
+    image: gcr.io/project/web
+    build: ./web
+    ports: ["8080:8080"]
+  api:
+    image: gcr.io/project/api:latest
+  db:
+    image: postgres:13
+`,
+			builds: []graph.Artifact{
+				{ImageName: "gcr.io/project/web", Tag: "gcr.io/project/web:v1"},
+				{ImageName: "gcr.io/project/api", Tag: "gcr.io/project/api:v2"},
+			},
+			expected: `networks:
+  default:
+    external: true
+    name: skaffold-network
+services:
+  api:
+    image: gcr.io/project/api:v2
+  db:
+    image: postgres:13
+  web:
+    image: gcr.io/project/web:v1
+    ports: ["8080:8080"]
+`,
+		},
+		{
+			description: "keep existing networks",
+			composeFile: `services:
+  web:
+    image: web
+networks:
+  backend: {}
+`,
+			builds: []graph.Artifact{{ImageName: "web", Tag: "web:tag"}},
+			expected: `networks:
+  backend: {}
+  default:
+    external: true
+    name: skaffold-network
+services:
+  web:
+    image: web:tag
+`,
+		},
+		{
+			description: "empty compose file",
+			composeFile: "",
+			shouldErr:   true,
+		},
+		{
+			description: "no services",
+			composeFile: "version: '3.8'\n",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actual, err := substituteComposeFile([]byte(test.composeFile), test.builds, "skaffold-network")
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(unmarshal(t, test.expected), unmarshal(t, string(actual)))
+			}
+		})
+	}
+}
+
+func TestDeployCompose(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("docker-compose.yml", `services:
+  web:
+    image: web
+  db:
+    image: postgres
+`)
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun(
+			"docker-compose --project-name skaffold-test --project-directory "+tmpDir.Root()+" --file composeFile up --detach --remove-orphans",
+		).AndRun(
+			"docker-compose --project-name skaffold-test --project-directory "+tmpDir.Root()+" --file composeFile down --remove-orphans",
+		))
+
+		tmpDir.Chdir()
+
+		d := &Deployer{
+			cfg: &v1.DockerDeploy{
+				UseCompose:  true,
+				ComposeFile: tmpDir.Path("docker-compose.yml"),
+				Images:      []string{"web"},
+			},
+			client:      dockerutil.NewLocalDaemon(&testutil.FakeAPIClient{}, nil, false, nil),
+			network:     "skaffold-network-test",
+			project:     "skaffold-test",
+			composeFile: "composeFile",
+		}
+
+		err := d.deployCompose(context.Background(), ioutil.Discard, []graph.Artifact{
+			{ImageName: "web", Tag: "web:123"},
+			{ImageName: "other", Tag: "other:456"},
+		})
+		t.CheckNoError(err)
+
+		written, err := ioutil.ReadFile(tmpDir.Path("composeFile"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(unmarshal(t, `services:
+  web:
+    image: web:123
+  db:
+    image: postgres
+networks:
+  default:
+    name: skaffold-network-test
+    external: true
+`), unmarshal(t, string(written)))
+
+		deps, err := d.Dependencies()
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{tmpDir.Path("docker-compose.yml")}, deps)
+
+		err = d.cleanupCompose(context.Background(), ioutil.Discard)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("", d.composeFile)
+		t.CheckFileExistAndContent(tmpDir.Path("docker-compose.yml"), []byte(`services:
+  web:
+    image: web
+  db:
+    image: postgres
+`))
+	})
+}
+
+func unmarshal(t *testutil.T, in string) map[string]interface{} {
+	var out map[string]interface{}
+	t.CheckNoError(yaml.Unmarshal([]byte(in), &out))
+	return out
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/annotations"
+	dockerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+)
+
+var (
+	// For testing
+	notifyDebuggingContainerStarted    = event.DebuggingContainerStarted
+	notifyDebuggingContainerTerminated = event.DebuggingContainerTerminated
+)
+
+// DebugManager configures the containers run by the docker deployer for debugging,
+// and notifies when the debuggable containers are started and removed.
+type DebugManager struct {
+	client               dockerutil.LocalDaemon
+	tracker              *tracker.ContainerTracker
+	debugHelpersRegistry string
+	id                   string // suffix of the volumes holding the debugging support files
+
+	lock           sync.Mutex
+	configurations map[string]annotations.ContainerDebugConfiguration // container name -> debugging configuration
+	supportVolumes map[string]string                                  // support image ID -> volume name
+	notified       map[string]debuggingContainer                      // container ID -> container notified as started
+	events         chan tracker.Container
+	cancel         context.CancelFunc
+}
+
+// debuggingContainer is a debuggable container that was notified as started.
+type debuggingContainer struct {
+	name   string
+	config annotations.ContainerDebugConfiguration
+}
+
+func NewDebugManager(client dockerutil.LocalDaemon, tracker *tracker.ContainerTracker, debugHelpersRegistry string, id string) *DebugManager {
+	return &DebugManager{
+		client:               client,
+		tracker:              tracker,
+		debugHelpersRegistry: debugHelpersRegistry,
+		id:                   id,
+		configurations:       make(map[string]annotations.ContainerDebugConfiguration),
+		supportVolumes:       make(map[string]string),
+		notified:             make(map[string]debuggingContainer),
+		cancel:               func() {},
+	}
+}
+
+// TransformImage configures the container of the given artifact for debugging, and returns
+// the volume mounts providing the debugging support files, if any.
+func (d *DebugManager) TransformImage(ctx context.Context, out io.Writer, artifact graph.Artifact, cfg *container.Config) ([]mount.Mount, error) {
+	configuration, supportImage, err := d.transform(ctx, artifact, cfg)
+
+	d.lock.Lock()
+	if err != nil {
+		delete(d.configurations, artifact.ImageName)
+	} else {
+		d.configurations[artifact.ImageName] = configuration
+	}
+	d.lock.Unlock()
+
+	if err != nil {
+		logrus.Warnf("Image %q not configured for debugging: %v", artifact.ImageName, err)
+		return nil, nil
+	}
+
+	if supportImage == "" {
+		return nil, nil
+	}
+	logrus.Infof("%q requires debugging support image %q", artifact.ImageName, supportImage)
+	volume, err := d.supportVolume(ctx, out, supportImage)
+	if err != nil {
+		return nil, fmt.Errorf("installing debugging support files for %q: %w", artifact.ImageName, err)
+	}
+	return []mount.Mount{{
+		Type:   mount.TypeVolume,
+		Source: volume,
+		Target: debug.SupportFilesMountPath,
+	}}, nil
+}
+
+// transform applies the debugging transforms to the container configuration,
+// based on the configuration of the image.
+func (d *DebugManager) transform(ctx context.Context, artifact graph.Artifact, cfg *container.Config) (annotations.ContainerDebugConfiguration, string, error) {
+	imageConfig, err := d.client.ConfigFile(ctx, artifact.Tag)
+	if err != nil {
+		return annotations.ContainerDebugConfiguration{}, "", fmt.Errorf("retrieving image config for %q: %w", artifact.Tag, err)
+	}
+	return debug.TransformContainer(artifact, cfg, imageConfig.Config)
+}
+
+// supportVolume returns a volume populated with the debugging support files of the given support image.
+// The support image copies its files into the volume, mounted at `/dbg`, and then exits.
+func (d *DebugManager) supportVolume(ctx context.Context, out io.Writer, imageID string) (string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if volume, found := d.supportVolumes[imageID]; found {
+		return volume, nil
+	}
+
+	image := fmt.Sprintf("%s/%s", d.debugHelpersRegistry, imageID)
+	if !d.client.ImageExists(ctx, image) {
+		if err := d.client.Pull(ctx, out, image); err != nil {
+			return "", fmt.Errorf("pulling debugging support image %q: %w", image, err)
+		}
+	}
+
+	volume := fmt.Sprintf("skaffold-debug-%s-%s", imageID, d.id)
+	id, err := d.client.Run(ctx, out, dockerutil.ContainerCreateOpts{
+		Image: image,
+		Mounts: []mount.Mount{{
+			Type:   mount.TypeVolume,
+			Source: volume,
+			Target: debug.SupportFilesMountPath,
+		}},
+		Wait: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("running debugging support image %q: %w", image, err)
+	}
+	if err := d.client.Delete(ctx, out, id); err != nil {
+		logrus.Debugf("unable to remove debugging support container %s: %v", id, err)
+	}
+
+	d.supportVolumes[imageID] = volume
+	return volume, nil
+}
+
+// Start notifies of the debuggable containers as they're started.
+func (d *DebugManager) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+	d.events = make(chan tracker.Container)
+	d.tracker.Register(d.events)
+
+	for _, c := range d.tracker.Containers() {
+		d.checkContainer(c)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case c := <-d.events:
+				d.checkContainer(c)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (d *DebugManager) checkContainer(c tracker.Container) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	config, found := d.configurations[c.Name]
+	if !found {
+		return
+	}
+	if _, notified := d.notified[c.ID]; notified {
+		return
+	}
+	d.notified[c.ID] = debuggingContainer{name: c.Name, config: config}
+	notifyDebuggingContainerStarted("", c.Name, "", config.Artifact, config.Runtime, config.WorkingDir, config.Ports)
+}
+
+// containerRemoved notifies that a debuggable container was removed, e.g. when it's redeployed.
+func (d *DebugManager) containerRemoved(c tracker.Container) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.notifyTerminated(c.ID)
+}
+
+// notifyTerminated notifies that a container notified as started is gone. d.lock must be held.
+func (d *DebugManager) notifyTerminated(id string) {
+	dc, found := d.notified[id]
+	if !found {
+		return
+	}
+	delete(d.notified, id)
+	notifyDebuggingContainerTerminated("", dc.name, "", dc.config.Artifact, dc.config.Runtime, dc.config.WorkingDir, dc.config.Ports)
+}
+
+// Stop stops notifying of the debuggable containers.
+func (d *DebugManager) Stop() {
+	if d.events != nil {
+		d.tracker.Deregister(d.events)
+	}
+	d.cancel()
+}
+
+func (d *DebugManager) Name() string {
+	return "Docker Debug Manager"
+}
+
+// DebugPorts returns the debugging ports of the given container.
+func (d *DebugManager) DebugPorts(containerName string) map[string]uint32 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.configurations[containerName].Ports
+}
+
+// Cleanup notifies that the debuggable containers were removed,
+// and removes the volumes holding the debugging support files.
+func (d *DebugManager) Cleanup(ctx context.Context) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for id := range d.notified {
+		d.notifyTerminated(id)
+	}
+
+	for imageID, volume := range d.supportVolumes {
+		if err := d.client.RawClient().VolumeRemove(ctx, volume, true); err != nil {
+			logrus.Debugf("unable to remove debugging support volume %s: %v", volume, err)
+		}
+		delete(d.supportVolumes, imageID)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/annotations"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDebugManagerCheckContainer(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var notified []string
+		t.Override(&notifyDebuggingContainerStarted, func(podName, containerName, namespace, artifact, runtime, workingDir string, debugPorts map[string]uint32) {
+			t.CheckDeepEqual("", podName)
+			t.CheckDeepEqual("", namespace)
+			t.CheckDeepEqual("go", runtime)
+			t.CheckDeepEqual(map[string]uint32{"dlv": 56268}, debugPorts)
+			notified = append(notified, containerName+"/"+artifact)
+		})
+
+		d := NewDebugManager(nil, tracker.NewContainerTracker(), "gcr.io/k8s-skaffold/skaffold-debug-support", "test")
+		d.configurations["web"] = annotations.ContainerDebugConfiguration{Artifact: "web", Runtime: "go", Ports: map[string]uint32{"dlv": 56268}}
+
+		d.checkContainer(tracker.Container{Name: "web", ID: "id1"})
+		d.checkContainer(tracker.Container{Name: "web", ID: "id1"})
+		d.checkContainer(tracker.Container{Name: "db", ID: "id2"})
+		d.checkContainer(tracker.Container{Name: "web", ID: "id3"})
+
+		t.CheckDeepEqual([]string{"web/web", "web/web"}, notified)
+		t.CheckDeepEqual(map[string]uint32{"dlv": 56268}, d.DebugPorts("web"))
+		t.CheckDeepEqual(map[string]uint32(nil), d.DebugPorts("db"))
+	})
+}
+
+func TestDebugManagerNotifiesTerminated(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var events []string
+		t.Override(&notifyDebuggingContainerStarted, func(_, containerName, _, _, _, _ string, _ map[string]uint32) {
+			events = append(events, "started "+containerName)
+		})
+		t.Override(&notifyDebuggingContainerTerminated, func(_, containerName, _, artifact, runtime, _ string, debugPorts map[string]uint32) {
+			t.CheckDeepEqual("web", artifact)
+			t.CheckDeepEqual("go", runtime)
+			t.CheckDeepEqual(map[string]uint32{"dlv": 56268}, debugPorts)
+			events = append(events, "terminated "+containerName)
+		})
+
+		d := NewDebugManager(nil, tracker.NewContainerTracker(), "gcr.io/k8s-skaffold/skaffold-debug-support", "test")
+		d.configurations["web"] = annotations.ContainerDebugConfiguration{Artifact: "web", Runtime: "go", Ports: map[string]uint32{"dlv": 56268}}
+
+		// redeploy
+		d.checkContainer(tracker.Container{Name: "web", ID: "id1"})
+		d.containerRemoved(tracker.Container{Name: "web", ID: "id1"})
+		d.checkContainer(tracker.Container{Name: "web", ID: "id2"})
+		// not debuggable
+		d.containerRemoved(tracker.Container{Name: "db", ID: "id3"})
+		// cleanup
+		d.Cleanup(context.Background())
+		d.Cleanup(context.Background())
+
+		t.CheckDeepEqual([]string{"started web", "terminated web", "started web", "terminated web"}, events)
+	})
+}
@@ -21,17 +21,26 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/access"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	dockerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	dockerlogger "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/logger"
+	dockerstatus "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/status"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/status"
 	pkgsync "github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
@@ -45,35 +54,86 @@ type Deployer struct {
 	monitor  status.Monitor
 	syncer   pkgsync.Syncer
 
-	cfg                *v1.DockerDeploy
-	client             dockerutil.LocalDaemon
-	deployedContainers map[string]string // imageName -> containerID
-	network            string
-	once               sync.Once
+	cfg          *v1.DockerDeploy
+	client       dockerutil.LocalDaemon
+	tracker      *tracker.ContainerTracker
+	portManager  *PortManager
+	debugManager *DebugManager // nil unless running in debug mode
+	forwardDebug bool
+	// readinessDeadline is how long to wait for the containers that others depend on to be ready
+	readinessDeadline time.Duration
+	network           string
+	once              sync.Once
+
+	project     string // docker-compose project name
+	composeFile string // compose file with the built images, used by docker-compose
+}
+
+// Config contains the configuration needed by the docker deployer.
+type Config interface {
+	dockerutil.Config
+	dockerlogger.Config
+
+	GlobalConfig() string
+	PortForwardOptions() config.PortForwardOptions
+	StatusCheck() *bool
+	StatusCheckDeadlineSeconds() int
 }
 
-func NewDeployer(cfg dockerutil.Config, labeller *label.DefaultLabeller, d *v1.DockerDeploy, resources []*v1.PortForwardResource) (*Deployer, error) {
+func NewDeployer(cfg Config, labeller *label.DefaultLabeller, d *v1.DockerDeploy, resources []*v1.PortForwardResource) (*Deployer, error) {
 	client, err := dockerutil.NewAPIClient(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	if !cfg.PortForwardOptions().ForwardUser(cfg.Mode()) {
+		resources = nil
+	}
+	portManager := NewPortManager(resources)
+	containerTracker := tracker.NewContainerTracker()
+
+	var monitor status.Monitor = &status.NoopMonitor{}
+	if enabled := cfg.StatusCheck(); enabled == nil || *enabled { // assume disabled only if explicitly set to false
+		monitor = dockerstatus.NewMonitor(client, containerTracker, cfg.StatusCheckDeadlineSeconds())
+	}
+
+	id := uuid.New().String()
+
+	var debugManager *DebugManager
+	var debugger debug.Debugger = &debug.NoopDebugger{}
+	if cfg.Mode() == config.RunModes.Debug {
+		if d.UseCompose {
+			logrus.Warnln("Debugging is not supported for docker-compose services")
+		} else {
+			debugHelpersRegistry, err := config.GetDebugHelpersRegistry(cfg.GlobalConfig())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving debug helpers registry: %w", err)
+			}
+			debugManager = NewDebugManager(client, containerTracker, debugHelpersRegistry, id)
+			debugger = debugManager
+		}
+	}
+
 	return &Deployer{
-		cfg:                d,
-		client:             client,
-		deployedContainers: make(map[string]string),
-		network:            fmt.Sprintf("skaffold-network-%s", uuid.New().String()),
-		// TODO(nkubala): implement components
-		accessor: &access.NoopAccessor{},
-		debugger: &debug.NoopDebugger{},
-		logger:   &log.NoopLogger{},
-		monitor:  &status.NoopMonitor{},
-		syncer:   &pkgsync.NoopSyncer{},
+		cfg:               d,
+		client:            client,
+		tracker:           containerTracker,
+		portManager:       portManager,
+		debugManager:      debugManager,
+		forwardDebug:      cfg.PortForwardOptions().ForwardDebug(cfg.Mode()),
+		readinessDeadline: time.Duration(cfg.StatusCheckDeadlineSeconds()) * time.Second,
+		network:           fmt.Sprintf("skaffold-network-%s", id),
+		project:           fmt.Sprintf("skaffold-%s", id),
+		accessor:          portManager,
+		debugger:          debugger,
+		logger:            dockerlogger.NewLogger(client, containerTracker, cfg),
+		monitor:           monitor,
+		syncer:            pkgsync.NewContainerSyncer(client, containerTracker),
 	}, nil
 }
 
 func (d *Deployer) TrackBuildArtifacts(artifacts []graph.Artifact) {
-	// TODO(nkubala): implement with components
+	d.logger.RegisterArtifacts(artifacts)
 }
 
 func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
@@ -84,57 +144,158 @@ func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 	if err != nil {
 		return fmt.Errorf("creating skaffold network %s: %w", d.network, err)
 	}
+	if d.cfg.UseCompose {
+		return d.deployCompose(ctx, out, builds)
+	}
+
+	var images []graph.Artifact
 	for _, b := range builds {
-		// TODO(nkubala): parallelize this
-		if !util.StrSliceContains(d.cfg.Images, b.ImageName) {
-			continue
+		if util.StrSliceContains(d.cfg.Images, b.ImageName) {
+			images = append(images, b)
 		}
-		if containerID, found := d.deployedContainers[b.ImageName]; found {
-			logrus.Debugf("removing old container %s for image %s", containerID, b.ImageName)
-			if err := d.client.Delete(ctx, out, containerID); err != nil {
-				return fmt.Errorf("failed to remove old container %s for image %s: %w", containerID, b.ImageName, err)
-			}
+	}
+	return d.deployInOrder(ctx, out, images)
+}
+
+// deployInOrder starts the containers of the given builds concurrently, up to the configured concurrency.
+// A container is only started once the containers it depends on are ready.
+func (d *Deployer) deployInOrder(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	concurrency := d.cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = len(builds)
+	}
+	sem := make(chan struct{}, concurrency)
+
+	// ready is closed once the container of an image is ready to be depended on
+	ready := make(map[string]chan struct{}, len(builds))
+	dependents := make(map[string]bool)
+	for _, b := range builds {
+		ready[b.ImageName] = make(chan struct{})
+		for _, dep := range d.cfg.DependsOn[b.ImageName] {
+			dependents[dep] = true
 		}
-		if d.cfg.UseCompose {
-			// TODO(nkubala): implement
-			return fmt.Errorf("docker compose not yet supported by skaffold")
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	for _, b := range builds {
+		b := b
+		g.Go(func() error {
+			// Dependencies that aren't redeployed are already running.
+			for _, dep := range d.cfg.DependsOn[b.ImageName] {
+				if depReady, found := ready[dep]; found {
+					select {
+					case <-depReady:
+					case <-gCtx.Done():
+						return gCtx.Err()
+					}
+				}
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-gCtx.Done():
+				return gCtx.Err()
+			}
+			c, err := d.deploy(gCtx, out, b)
+			<-sem
+			if err != nil {
+				return err
+			}
+
+			if dependents[b.ImageName] {
+				output.Default.Fprintf(out, "Waiting for container %s to be ready...\n", c.Name)
+				if err := dockerstatus.WaitForReady(gCtx, d.client, c, d.readinessDeadline); err != nil {
+					return err
+				}
+			}
+			close(ready[b.ImageName])
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// deploy runs a container for the given artifact, replacing any container previously deployed for it.
+func (d *Deployer) deploy(ctx context.Context, out io.Writer, b graph.Artifact) (tracker.Container, error) {
+	if c, found := d.tracker.ContainerForImage(b.ImageName); found {
+		logrus.Debugf("removing old container %s for image %s", c.ID, b.ImageName)
+		if err := d.client.Delete(ctx, out, c.ID); err != nil {
+			return tracker.Container{}, fmt.Errorf("failed to remove old container %s for image %s: %w", c.ID, b.ImageName, err)
 		}
-		opts := dockerutil.ContainerCreateOpts{
-			Name:    b.ImageName,
-			Image:   b.Tag,
-			Network: d.network,
+		d.tracker.Remove(b.ImageName)
+		d.portManager.RelinquishPorts(c.Name)
+		if d.debugManager != nil {
+			d.debugManager.containerRemoved(c)
 		}
-		id, err := d.client.Run(ctx, out, opts)
-		if err != nil {
-			return errors.Wrap(err, "creating container in local docker")
+	}
+
+	containerCfg := &container.Config{}
+	var mounts []mount.Mount
+	if d.debugManager != nil {
+		var err error
+		if mounts, err = d.debugManager.TransformImage(ctx, out, b, containerCfg); err != nil {
+			return tracker.Container{}, err
 		}
-		d.deployedContainers[b.ImageName] = id
 	}
 
-	return nil
+	bindings, err := d.portManager.AllocatePorts(b.ImageName)
+	if err != nil {
+		return tracker.Container{}, fmt.Errorf("publishing ports of container %s: %w", b.ImageName, err)
+	}
+	if d.debugManager != nil && d.forwardDebug {
+		if err := d.portManager.AllocateDebugPorts(b.ImageName, d.debugManager.DebugPorts(b.ImageName), bindings); err != nil {
+			d.portManager.RelinquishPorts(b.ImageName)
+			return tracker.Container{}, fmt.Errorf("publishing debug ports of container %s: %w", b.ImageName, err)
+		}
+	}
+	opts := dockerutil.ContainerCreateOpts{
+		Name:            b.ImageName,
+		Image:           b.Tag,
+		Network:         d.network,
+		Bindings:        bindings,
+		Mounts:          mounts,
+		ContainerConfig: containerCfg,
+	}
+	id, err := d.client.Run(ctx, out, opts)
+	if err != nil {
+		d.portManager.RelinquishPorts(b.ImageName)
+		return tracker.Container{}, errors.Wrap(err, "creating container in local docker")
+	}
+	c := tracker.Container{Name: b.ImageName, ID: id, Image: b.Tag}
+	d.tracker.Add(b.ImageName, c)
+	return c, nil
 }
 
 func (d *Deployer) Dependencies() ([]string, error) {
+	if d.cfg.UseCompose {
+		return []string{d.cfg.ComposeFile}, nil
+	}
 	// noop since there is no deploy config
 	return nil, nil
 }
 
 func (d *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
-	for _, id := range d.deployedContainers {
-		if err := d.client.Delete(ctx, out, id); err != nil {
-			// TODO(nkubala): replace with actionable error
-			return errors.Wrap(err, "cleaning up deployed container")
+	if d.cfg.UseCompose {
+		if err := d.cleanupCompose(ctx, out); err != nil {
+			return errors.Wrap(err, "cleaning up compose stack")
+		}
+	} else {
+		for _, c := range d.tracker.Containers() {
+			if err := d.client.Delete(ctx, out, c.ID); err != nil {
+				// TODO(nkubala): replace with actionable error
+				return errors.Wrap(err, "cleaning up deployed container")
+			}
+			d.portManager.RelinquishPorts(c.Name)
 		}
 	}
+	if d.debugManager != nil {
+		d.debugManager.Cleanup(ctx)
+	}
 
 	err := d.client.NetworkRemove(ctx, d.network)
 	return errors.Wrap(err, "cleaning up skaffold created network")
 }
 
-func (d *Deployer) Render(context.Context, io.Writer, []graph.Artifact, bool, string) error {
-	return errors.New("render not implemented for docker deployer")
-}
-
 func (d *Deployer) GetAccessor() access.Accessor {
 	return d.accessor
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"io/ioutil"
+	"sort"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+
+	dockerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeployInOrder(t *testing.T) {
+	tests := []struct {
+		description string
+		concurrency int
+		dependsOn   map[string][]string
+		states      map[string]*types.ContainerState
+		expected    []string
+		unordered   bool
+		shouldErr   bool
+	}{
+		{
+			description: "no dependencies",
+			concurrency: 1,
+			expected:    []string{"api", "db", "web"},
+			unordered:   true,
+		},
+		{
+			description: "dependencies",
+			dependsOn:   map[string][]string{"web": {"api"}, "api": {"db"}},
+			states: map[string]*types.ContainerState{
+				"id-db":  {Status: "running"},
+				"id-api": {Status: "running"},
+			},
+			expected: []string{"db", "api", "web"},
+		},
+		{
+			description: "failed dependency",
+			dependsOn:   map[string][]string{"web": {"db"}, "api": {"db"}},
+			states: map[string]*types.ContainerState{
+				"id-db": {Status: "exited", ExitCode: 1},
+			},
+			expected:  []string{"db"},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fakeClient := &testutil.FakeAPIClient{ContainerStates: test.states}
+			d := &Deployer{
+				cfg: &v1.DockerDeploy{
+					Images:      []string{"web", "api", "db"},
+					Concurrency: test.concurrency,
+					DependsOn:   test.dependsOn,
+				},
+				client:      dockerutil.NewLocalDaemon(fakeClient, nil, false, nil),
+				tracker:     tracker.NewContainerTracker(),
+				portManager: NewPortManager(nil),
+			}
+
+			err := d.deployInOrder(context.Background(), ioutil.Discard, []graph.Artifact{
+				{ImageName: "web", Tag: "web:v1"},
+				{ImageName: "api", Tag: "api:v1"},
+				{ImageName: "db", Tag: "db:v1"},
+			})
+
+			t.CheckError(test.shouldErr, err)
+			if test.unordered {
+				sort.Strings(fakeClient.CreatedContainers)
+			}
+			t.CheckDeepEqual(test.expected, fakeClient.CreatedContainers)
+		})
+	}
+}
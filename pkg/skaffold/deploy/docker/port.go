@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// containerResourceType is the port forward resource type matching containers deployed to Docker.
+	containerResourceType = "container"
+
+	defaultPortForwardAddress = "127.0.0.1"
+)
+
+// publishedPort is a container port published on the host.
+type publishedPort struct {
+	resource      *v1.PortForwardResource
+	containerName string
+	containerPort int
+	address       string
+	localPort     int
+}
+
+// PortManager publishes container ports on the host, following the user defined
+// port forward resources of type `container`. Docker can only publish the ports of
+// a container when it is created, so the ports are allocated before creating the
+// containers, and reported as port forwards when the manager is started.
+type PortManager struct {
+	resources []*v1.PortForwardResource
+
+	lock           sync.Mutex
+	containerPorts map[string][]publishedPort // container name -> published ports
+	portSet        util.PortSet
+}
+
+// NewPortManager creates a PortManager for the given port forward resources.
+func NewPortManager(resources []*v1.PortForwardResource) *PortManager {
+	return &PortManager{
+		resources:      resources,
+		containerPorts: make(map[string][]publishedPort),
+	}
+}
+
+// AllocatePorts allocates local ports for the port forward resources matching the given container,
+// and returns the corresponding port bindings.
+func (pm *PortManager) AllocatePorts(containerName string) (nat.PortMap, error) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	var ports []publishedPort
+	bindings := nat.PortMap{}
+	for _, r := range pm.resources {
+		if !strings.EqualFold(string(r.Type), containerResourceType) || r.Name != containerName {
+			continue
+		}
+		if r.Port.Type != schemautil.Int {
+			return nil, fmt.Errorf("port %q of container %s must be a number", r.Port.String(), containerName)
+		}
+
+		address := r.Address
+		if address == "" {
+			address = defaultPortForwardAddress
+		}
+		localPort := util.GetAvailablePort(address, r.LocalPort, &pm.portSet)
+		if r.LocalPort != 0 && localPort != r.LocalPort {
+			logrus.Warnf("local port %d is unavailable, publishing port %d of container %s on port %d", r.LocalPort, r.Port.IntVal, containerName, localPort)
+		}
+
+		containerPort, err := nat.NewPort("tcp", strconv.Itoa(r.Port.IntVal))
+		if err != nil {
+			return nil, err
+		}
+		bindings[containerPort] = append(bindings[containerPort], nat.PortBinding{
+			HostIP:   address,
+			HostPort: strconv.Itoa(localPort),
+		})
+		ports = append(ports, publishedPort{
+			resource:      r,
+			containerName: containerName,
+			containerPort: r.Port.IntVal,
+			address:       address,
+			localPort:     localPort,
+		})
+	}
+
+	pm.containerPorts[containerName] = ports
+	return bindings, nil
+}
+
+// AllocateDebugPorts allocates local ports for the debugging ports of the given container,
+// and adds them to the given port bindings. The local ports match the container ports when available.
+func (pm *PortManager) AllocateDebugPorts(containerName string, debugPorts map[string]uint32, bindings nat.PortMap) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	var names []string
+	for name := range debugPorts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		port := int(debugPorts[name])
+		localPort := util.GetAvailablePort(defaultPortForwardAddress, port, &pm.portSet)
+
+		containerPort, err := nat.NewPort("tcp", strconv.Itoa(port))
+		if err != nil {
+			return err
+		}
+		bindings[containerPort] = append(bindings[containerPort], nat.PortBinding{
+			HostIP:   defaultPortForwardAddress,
+			HostPort: strconv.Itoa(localPort),
+		})
+		pm.containerPorts[containerName] = append(pm.containerPorts[containerName], publishedPort{
+			resource: &v1.PortForwardResource{
+				Type: containerResourceType,
+				Name: containerName,
+				Port: schemautil.FromInt(port),
+			},
+			containerName: containerName,
+			containerPort: port,
+			address:       defaultPortForwardAddress,
+			localPort:     localPort,
+		})
+	}
+	return nil
+}
+
+// RelinquishPorts frees the local ports allocated for the given container.
+func (pm *PortManager) RelinquishPorts(containerName string) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	for _, p := range pm.containerPorts[containerName] {
+		pm.portSet.Delete(p.localPort)
+	}
+	delete(pm.containerPorts, containerName)
+}
+
+// Start reports the published container ports.
+func (pm *PortManager) Start(_ context.Context, out io.Writer) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	var containers []string
+	for name := range pm.containerPorts {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+
+	for _, name := range containers {
+		for _, p := range pm.containerPorts[name] {
+			out := output.WithEventContext(out, constants.PortForward, fmt.Sprintf("%s/%s", containerResourceType, p.containerName))
+			output.Green.Fprintln(out, fmt.Sprintf("Port forwarding container %s, container port %d -> %s:%d", p.containerName, p.containerPort, p.address, p.localPort))
+
+			event.PortForwarded(int32(p.localPort), p.resource.Port, "", p.containerName, "", "", containerResourceType, p.containerName, p.address)
+			eventV2.PortForwarded(int32(p.localPort), 0, p.resource.Port, "", p.containerName, "", "", containerResourceType, p.containerName, p.address)
+		}
+	}
+	return nil
+}
+
+// Stop is a noop: the published ports are only freed when the containers are removed.
+func (pm *PortManager) Stop() {}
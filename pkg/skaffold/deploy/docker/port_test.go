@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAllocatePorts(t *testing.T) {
+	tests := []struct {
+		description   string
+		resources     []*latestV1.PortForwardResource
+		expectedPorts []nat.Port
+		shouldErr     bool
+	}{
+		{
+			description: "no resources",
+		},
+		{
+			description: "matching container",
+			resources: []*latestV1.PortForwardResource{
+				{Type: "container", Name: "web", Port: schemautil.FromInt(8080)},
+				{Type: "Container", Name: "web", Port: schemautil.FromInt(9090)},
+			},
+			expectedPorts: []nat.Port{"8080/tcp", "9090/tcp"},
+		},
+		{
+			description: "ignore other containers and resource types",
+			resources: []*latestV1.PortForwardResource{
+				{Type: "container", Name: "db", Port: schemautil.FromInt(5432)},
+				{Type: "service", Name: "web", Port: schemautil.FromInt(8080)},
+			},
+		},
+		{
+			description: "named port",
+			resources: []*latestV1.PortForwardResource{
+				{Type: "container", Name: "web", Port: schemautil.FromString("http")},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pm := NewPortManager(test.resources)
+
+			bindings, err := pm.AllocatePorts("web")
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			t.CheckDeepEqual(len(test.expectedPorts), len(bindings))
+			t.CheckDeepEqual(len(test.expectedPorts), pm.portSet.Length())
+			for _, port := range test.expectedPorts {
+				t.CheckDeepEqual(1, len(bindings[port]))
+				t.CheckDeepEqual(defaultPortForwardAddress, bindings[port][0].HostIP)
+			}
+
+			pm.RelinquishPorts("web")
+			t.CheckDeepEqual(0, pm.portSet.Length())
+		})
+	}
+}
+
+func TestAllocateDebugPorts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		pm := NewPortManager(nil)
+
+		bindings, err := pm.AllocatePorts("web")
+		t.CheckNoError(err)
+		err = pm.AllocateDebugPorts("web", map[string]uint32{"dlv": 56268, "devtools": 9229}, bindings)
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual(2, len(bindings))
+		t.CheckDeepEqual(1, len(bindings["56268/tcp"]))
+		t.CheckDeepEqual(1, len(bindings["9229/tcp"]))
+		t.CheckDeepEqual(2, pm.portSet.Length())
+
+		pm.RelinquishPorts("web")
+		t.CheckDeepEqual(0, pm.portSet.Length())
+	})
+}
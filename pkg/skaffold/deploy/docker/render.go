@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// composeConfig is the subset of the compose file format used to render the containers run by the docker deployer.
+type composeConfig struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image         string   `yaml:"image"`
+	ContainerName string   `yaml:"container_name,omitempty"`
+	Entrypoint    []string `yaml:"entrypoint,omitempty"`
+	Command       []string `yaml:"command,omitempty"`
+	Environment   []string `yaml:"environment,omitempty"`
+	Expose        []string `yaml:"expose,omitempty"`
+}
+
+// Render writes a compose file describing the containers that would be run for the given builds.
+func (d *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Artifact, _ bool, filepath string) error {
+	rendered, err := d.render(ctx, builds)
+	if err != nil {
+		return err
+	}
+	return manifest.Write(string(rendered), filepath, out)
+}
+
+func (d *Deployer) render(ctx context.Context, builds []graph.Artifact) ([]byte, error) {
+	var builtImages []graph.Artifact
+	for _, b := range builds {
+		if util.StrSliceContains(d.cfg.Images, b.ImageName) {
+			builtImages = append(builtImages, b)
+		}
+	}
+
+	if d.cfg.UseCompose {
+		buf, err := ioutil.ReadFile(d.cfg.ComposeFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading compose file %q: %w", d.cfg.ComposeFile, err)
+		}
+		return substituteComposeFile(buf, builtImages, "")
+	}
+
+	config := composeConfig{Services: map[string]composeService{}}
+	for _, b := range builtImages {
+		cfg := &container.Config{}
+		if d.debugManager != nil {
+			if _, _, err := d.debugManager.transform(ctx, b, cfg); err != nil {
+				return nil, fmt.Errorf("configuring %q for debugging: %w", b.ImageName, err)
+			}
+		}
+
+		var expose []string
+		for port := range cfg.ExposedPorts {
+			expose = append(expose, port.Port())
+		}
+		sort.Strings(expose)
+
+		config.Services[b.ImageName] = composeService{
+			Image:         b.Tag,
+			ContainerName: b.ImageName,
+			Entrypoint:    cfg.Entrypoint,
+			Command:       cfg.Cmd,
+			Environment:   cfg.Env,
+			Expose:        expose,
+		}
+	}
+	return yaml.Marshal(config)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         v1.DockerDeploy
+		composeFile string
+		expected    string
+	}{
+		{
+			description: "containers",
+			cfg:         v1.DockerDeploy{Images: []string{"web", "api"}},
+			expected: `services:
+  api:
+    image: api:v2
+    container_name: api
+  web:
+    image: web:v1
+    container_name: web
+`,
+		},
+		{
+			description: "compose file",
+			cfg:         v1.DockerDeploy{Images: []string{"web"}, UseCompose: true, ComposeFile: "docker-compose.yml"},
+			composeFile: `services:
+  web:
+    image: web
+    build: .
+`,
+			expected: `services:
+  web:
+    image: web:v1
+`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Write("docker-compose.yml", test.composeFile).Chdir()
+			d := &Deployer{cfg: &test.cfg}
+
+			var out bytes.Buffer
+			err := d.Render(context.Background(), &out, []graph.Artifact{
+				{ImageName: "web", Tag: "web:v1"},
+				{ImageName: "api", Tag: "api:v2"},
+				{ImageName: "other", Tag: "other:v3"},
+			}, false, "")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected+"\n", out.String())
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+)
+
+// WithCleanupHooks returns a Deployer that runs the given lifecycle hooks before and after cleaning up.
+func WithCleanupHooks(d Deployer, runner hooks.PhaseRunner) Deployer {
+	return withCleanupHooks{Deployer: d, runner: runner}
+}
+
+type withCleanupHooks struct {
+	Deployer
+	runner hooks.PhaseRunner
+}
+
+// Cleanup runs the pre-cleanup hooks, cleans up, and runs the post-cleanup hooks even if the cleanup failed.
+func (w withCleanupHooks) Cleanup(ctx context.Context, out io.Writer) error {
+	if err := w.runner.RunPreHooks(ctx, out); err != nil {
+		return err
+	}
+
+	cleanupErr := w.Deployer.Cleanup(ctx, out)
+	if err := w.runner.RunPostHooks(ctx, out, cleanupErr); err != nil && cleanupErr == nil {
+		return err
+	}
+	return cleanupErr
+}
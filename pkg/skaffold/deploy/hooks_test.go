@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockPhaseRunner struct {
+	preErr    error
+	postErr   error
+	calls     []string
+	phaseErrs []error
+}
+
+func (m *mockPhaseRunner) RunPreHooks(context.Context, io.Writer) error {
+	m.calls = append(m.calls, "pre")
+	return m.preErr
+}
+
+func (m *mockPhaseRunner) RunPostHooks(_ context.Context, _ io.Writer, phaseErr error) error {
+	m.calls = append(m.calls, "post")
+	m.phaseErrs = append(m.phaseErrs, phaseErr)
+	return m.postErr
+}
+
+func TestWithCleanupHooks(t *testing.T) {
+	cleanupErr := errors.New("cleanup failed")
+	postErr := errors.New("post-cleanup hook failed")
+
+	tests := []struct {
+		description   string
+		runner        *mockPhaseRunner
+		cleanupErr    error
+		expectedCalls []string
+		expectedErr   error
+	}{
+		{
+			description:   "cleanup succeeds",
+			runner:        &mockPhaseRunner{},
+			expectedCalls: []string{"pre", "post"},
+		},
+		{
+			description:   "post-cleanup hooks run when cleanup fails",
+			runner:        &mockPhaseRunner{postErr: postErr},
+			cleanupErr:    cleanupErr,
+			expectedCalls: []string{"pre", "post"},
+			expectedErr:   cleanupErr,
+		},
+		{
+			description:   "post-cleanup hooks fail",
+			runner:        &mockPhaseRunner{postErr: postErr},
+			expectedCalls: []string{"pre", "post"},
+			expectedErr:   postErr,
+		},
+		{
+			description:   "pre-cleanup hooks fail",
+			runner:        &mockPhaseRunner{preErr: errors.New("pre-cleanup hook failed")},
+			expectedCalls: []string{"pre"},
+			expectedErr:   errors.New("pre-cleanup hook failed"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			deployer := NewMockDeployer().WithCleanupErr(test.cleanupErr)
+
+			err := WithCleanupHooks(deployer, test.runner).Cleanup(context.Background(), &bytes.Buffer{})
+
+			t.CheckDeepEqual(test.expectedCalls, test.runner.calls)
+			if test.expectedErr == nil {
+				t.CheckNoError(err)
+			} else {
+				t.CheckErrorContains(test.expectedErr.Error(), err)
+			}
+			if len(test.runner.phaseErrs) > 0 && test.runner.phaseErrs[0] != test.cleanupErr {
+				t.Errorf("expected post-cleanup hooks to receive %v, got %v", test.cleanupErr, test.runner.phaseErrs[0])
+			}
+		})
+	}
+}
@@ -60,6 +60,9 @@ type Config interface {
 	HydratedManifests() []string
 	DefaultPipeline() latestV1.Pipeline
 	Tail() bool
+	JSONLogs() bool
+	LogFilters() config.LogFilterOptions
+	LogFiles() config.LogFileOptions
 	PipelineForImage(imageName string) (latestV1.Pipeline, bool)
 }
 
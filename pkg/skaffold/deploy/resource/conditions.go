@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+const (
+	conditionTrue  = "True"
+	conditionFalse = "False"
+)
+
+type condition struct {
+	status  string
+	reason  string
+	message string
+}
+
+// jobStatus reports a Job as successful once it is `Complete` and as failed once it is `Failed`.
+func jobStatus(obj map[string]interface{}) proto.ActionableErr {
+	if c, found := getCondition(obj, "Complete"); found && c.status == conditionTrue {
+		return success("job completed\n")
+	}
+	if c, found := getCondition(obj, "Failed"); found && c.status == conditionTrue {
+		switch c.reason {
+		case "BackoffLimitExceeded":
+			return failure(proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED, "job failed", c)
+		case "DeadlineExceeded":
+			return failure(proto.StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED, "job failed", c)
+		default:
+			return failure(proto.StatusCode_STATUSCHECK_UNKNOWN, "job failed", c)
+		}
+	}
+	active, _, _ := unstructured.NestedInt64(obj, "status", "active")
+	succeeded, _, _ := unstructured.NestedInt64(obj, "status", "succeeded")
+	failed, _, _ := unstructured.NestedInt64(obj, "status", "failed")
+	return pending(fmt.Sprintf("waiting for job to complete: %d active, %d succeeded, %d failed\n", active, succeeded, failed))
+}
+
+// podStatus reports a standalone Pod as successful once it is `Ready` or has run to completion.
+func podStatus(obj map[string]interface{}) proto.ActionableErr {
+	phase, _, _ := unstructured.NestedString(obj, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return success("pod completed\n")
+	case "Failed":
+		if msg, terminated := terminatedContainer(obj); terminated {
+			return failure(proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED, "pod failed", condition{message: msg})
+		}
+		reason, _, _ := unstructured.NestedString(obj, "status", "reason")
+		message, _, _ := unstructured.NestedString(obj, "status", "message")
+		return failure(proto.StatusCode_STATUSCHECK_UNKNOWN, "pod failed", condition{reason: reason, message: message})
+	}
+	if c, found := getCondition(obj, "Ready"); found && c.status == conditionTrue {
+		return success("pod is ready\n")
+	}
+	return pending(fmt.Sprintf("waiting for pod to be ready: phase %s\n", strings.ToLower(phase)))
+}
+
+// terminatedContainer describes the first container of a pod that terminated with a non-zero exit code.
+func terminatedContainer(obj map[string]interface{}) (string, bool) {
+	for _, field := range []string{"initContainerStatuses", "containerStatuses"} {
+		statuses, _, _ := unstructured.NestedSlice(obj, "status", field)
+		for _, s := range statuses {
+			m, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			exitCode, found, _ := unstructured.NestedInt64(m, "state", "terminated", "exitCode")
+			if !found || exitCode == 0 {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(m, "name")
+			return fmt.Sprintf("container %s terminated with exit code %d", name, exitCode), true
+		}
+	}
+	return "", false
+}
+
+// readyConditionStatus evaluates resources that follow the Config Connector convention
+// of reporting their health with a `Ready` condition.
+func readyConditionStatus(obj map[string]interface{}) proto.ActionableErr {
+	if isStale(obj) {
+		return pending("waiting for the controller to observe the latest generation\n")
+	}
+	c, found := getCondition(obj, "Ready")
+	switch {
+	case !found:
+		return pending("waiting for Ready condition\n")
+	case c.status == conditionTrue:
+		return success("resource is ready\n")
+	case c.status == conditionFalse && strings.HasSuffix(c.reason, "Failed"):
+		return failure(proto.StatusCode_STATUSCHECK_CONDITION_FAILED, "resource is not ready", c)
+	default:
+		return pending(fmt.Sprintf("waiting for resource to be ready: %s\n", describe(c)))
+	}
+}
+
+// ruleStatus evaluates a resource with a user defined status check rule.
+// The failed condition is checked first so that a resource can't be both ready and failed.
+func ruleStatus(obj map[string]interface{}, rule latestV1.StatusCheckRule) proto.ActionableErr {
+	if isStale(obj) {
+		return pending("waiting for the controller to observe the latest generation\n")
+	}
+	if rule.Failed != nil {
+		if c, matched := matchCondition(obj, *rule.Failed, ""); matched {
+			return failure(proto.StatusCode_STATUSCHECK_CONDITION_FAILED, "resource failed", c)
+		}
+	}
+	ready := latestV1.StatusCondition{}
+	if rule.Ready != nil {
+		ready = *rule.Ready
+	}
+	c, matched := matchCondition(obj, ready, conditionTrue)
+	switch {
+	case matched:
+		return success("resource is ready\n")
+	case c.status == "":
+		return pending(fmt.Sprintf("waiting for %s condition\n", conditionType(ready)))
+	default:
+		return pending(fmt.Sprintf("waiting for resource to be ready: %s\n", describe(c)))
+	}
+}
+
+// matchCondition returns the condition of the expected type and whether it matches
+// the expected status and reason. An empty expected status defaults to defaultStatus.
+func matchCondition(obj map[string]interface{}, expected latestV1.StatusCondition, defaultStatus string) (condition, bool) {
+	c, found := getCondition(obj, conditionType(expected))
+	if !found {
+		return c, false
+	}
+	status := expected.Status
+	if status == "" {
+		status = defaultStatus
+	}
+	if status != "" && c.status != status {
+		return c, false
+	}
+	if expected.Reason != "" && c.reason != expected.Reason {
+		return c, false
+	}
+	return c, true
+}
+
+func conditionType(c latestV1.StatusCondition) string {
+	if c.Type == "" {
+		return "Ready"
+	}
+	return c.Type
+}
+
+// isStale returns true when the controller didn't report the status of the latest generation yet.
+func isStale(obj map[string]interface{}) bool {
+	generation, _, _ := unstructured.NestedInt64(obj, "metadata", "generation")
+	observed, found, _ := unstructured.NestedInt64(obj, "status", "observedGeneration")
+	return found && observed < generation
+}
+
+func getCondition(obj map[string]interface{}, conditionType string) (condition, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != conditionType {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(m, "status")
+		reason, _, _ := unstructured.NestedString(m, "reason")
+		message, _, _ := unstructured.NestedString(m, "message")
+		return condition{status: status, reason: reason, message: message}, true
+	}
+	return condition{}, false
+}
+
+func describe(c condition) string {
+	switch {
+	case c.reason != "" && c.message != "":
+		return fmt.Sprintf("%s: %s", c.reason, c.message)
+	case c.reason != "":
+		return c.reason
+	case c.message != "":
+		return c.message
+	default:
+		return fmt.Sprintf("status %s", c.status)
+	}
+}
+
+func success(msg string) proto.ActionableErr {
+	return proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: msg}
+}
+
+func pending(msg string) proto.ActionableErr {
+	return proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: msg}
+}
+
+func failure(code proto.StatusCode, prefix string, c condition) proto.ActionableErr {
+	msg := prefix
+	if c.reason != "" || c.message != "" {
+		msg = fmt.Sprintf("%s: %s", prefix, describe(c))
+	}
+	return proto.ActionableErr{ErrCode: code, Message: msg + "\n"}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/json"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestConditionStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		rType       Type
+		obj         string
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "job complete",
+			rType:       ResourceTypes.Job,
+			obj:         `{"status": {"succeeded": 1, "conditions": [{"type": "Complete", "status": "True"}]}}`,
+			expected:    success("job completed\n"),
+		},
+		{
+			description: "job reached backoff limit",
+			rType:       ResourceTypes.Job,
+			obj:         `{"status": {"failed": 7, "conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded", "message": "Job has reached the specified backoff limit"}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED,
+				Message: "job failed: BackoffLimitExceeded: Job has reached the specified backoff limit\n",
+			},
+		},
+		{
+			description: "job reached active deadline",
+			rType:       ResourceTypes.Job,
+			obj:         `{"status": {"conditions": [{"type": "Failed", "status": "True", "reason": "DeadlineExceeded", "message": "Job was active longer than specified deadline"}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED,
+				Message: "job failed: DeadlineExceeded: Job was active longer than specified deadline\n",
+			},
+		},
+		{
+			description: "job failed for another reason",
+			rType:       ResourceTypes.Job,
+			obj:         `{"status": {"conditions": [{"type": "Failed", "status": "True", "reason": "PodFailurePolicy"}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: "job failed: PodFailurePolicy\n",
+			},
+		},
+		{
+			description: "job without status",
+			rType:       ResourceTypes.Job,
+			obj:         `{}`,
+			expected:    pending("waiting for job to complete: 0 active, 0 succeeded, 0 failed\n"),
+		},
+		{
+			description: "pod ready",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}`,
+			expected:    success("pod is ready\n"),
+		},
+		{
+			description: "pod ran to completion",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Succeeded"}}`,
+			expected:    success("pod completed\n"),
+		},
+		{
+			description: "pod failed",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Failed", "reason": "Evicted", "message": "The node was low on resource: memory."}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: "pod failed: Evicted: The node was low on resource: memory.\n",
+			},
+		},
+		{
+			description: "pod container terminated",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Failed", "containerStatuses": [{"name": "sidecar", "state": {"terminated": {"exitCode": 0}}}, {"name": "app", "state": {"terminated": {"exitCode": 2, "reason": "Error"}}}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+				Message: "pod failed: container app terminated with exit code 2\n",
+			},
+		},
+		{
+			description: "pod init container terminated",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Failed", "initContainerStatuses": [{"name": "migrate", "state": {"terminated": {"exitCode": 1}}}], "containerStatuses": [{"name": "app", "state": {"waiting": {"reason": "PodInitializing"}}}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+				Message: "pod failed: container migrate terminated with exit code 1\n",
+			},
+		},
+		{
+			description: "pod pending",
+			rType:       ResourceTypes.StandalonePod,
+			obj:         `{"status": {"phase": "Pending"}}`,
+			expected:    pending("waiting for pod to be ready: phase pending\n"),
+		},
+		{
+			description: "custom resource without conditions",
+			rType:       ResourceTypes.CustomResource,
+			obj:         `{"metadata": {"generation": 1}}`,
+			expected:    pending("waiting for Ready condition\n"),
+		},
+		{
+			description: "custom resource with stale status",
+			rType:       ResourceTypes.CustomResource,
+			obj:         `{"metadata": {"generation": 2}, "status": {"observedGeneration": 1, "conditions": [{"type": "Ready", "status": "True"}]}}`,
+			expected:    pending("waiting for the controller to observe the latest generation\n"),
+		},
+		{
+			description: "custom resource waiting on dependency",
+			rType:       ResourceTypes.CustomResource,
+			obj:         `{"status": {"conditions": [{"type": "Ready", "status": "False", "reason": "DependencyNotReady", "message": "reference SQLInstance test/db is not ready"}]}}`,
+			expected:    pending("waiting for resource to be ready: DependencyNotReady: reference SQLInstance test/db is not ready\n"),
+		},
+		{
+			description: "custom resource update failed",
+			rType:       ResourceTypes.CustomResource,
+			obj:         `{"status": {"conditions": [{"type": "Ready", "status": "False", "reason": "UpdateFailed", "message": "googleapi: Error 403"}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CONDITION_FAILED,
+				Message: "resource is not ready: UpdateFailed: googleapi: Error 403\n",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			obj := map[string]interface{}{}
+			t.CheckNoError(json.Unmarshal([]byte(test.obj), &obj))
+
+			var actual proto.ActionableErr
+			switch test.rType {
+			case ResourceTypes.Job:
+				actual = jobStatus(obj)
+			case ResourceTypes.StandalonePod:
+				actual = podStatus(obj)
+			default:
+				actual = readyConditionStatus(obj)
+			}
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestRuleStatus(t *testing.T) {
+	rule := latestV1.StatusCheckRule{
+		Group:  "kafka.strimzi.io",
+		Kind:   "KafkaTopic",
+		Failed: &latestV1.StatusCondition{Type: "Ready", Status: "False", Reason: "Failed"},
+	}
+	tests := []struct {
+		description string
+		rule        latestV1.StatusCheckRule
+		obj         string
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "ready by default condition",
+			rule:        rule,
+			obj:         `{"status": {"conditions": [{"type": "Ready", "status": "True"}]}}`,
+			expected:    success("resource is ready\n"),
+		},
+		{
+			description: "no conditions yet",
+			rule:        rule,
+			obj:         `{"status": {}}`,
+			expected:    pending("waiting for Ready condition\n"),
+		},
+		{
+			description: "not ready yet",
+			rule:        rule,
+			obj:         `{"status": {"conditions": [{"type": "Ready", "status": "False", "reason": "Creating"}]}}`,
+			expected:    pending("waiting for resource to be ready: Creating\n"),
+		},
+		{
+			description: "failed",
+			rule:        rule,
+			obj:         `{"status": {"conditions": [{"type": "Ready", "status": "False", "reason": "Failed", "message": "topic already exists"}]}}`,
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CONDITION_FAILED,
+				Message: "resource failed: Failed: topic already exists\n",
+			},
+		},
+		{
+			description: "custom ready condition",
+			rule: latestV1.StatusCheckRule{
+				Kind:  "DatabaseClaim",
+				Ready: &latestV1.StatusCondition{Type: "Synced", Reason: "Available"},
+			},
+			obj:      `{"status": {"conditions": [{"type": "Synced", "status": "True", "reason": "Available"}]}}`,
+			expected: success("resource is ready\n"),
+		},
+		{
+			description: "custom ready condition with another reason",
+			rule: latestV1.StatusCheckRule{
+				Kind:  "DatabaseClaim",
+				Ready: &latestV1.StatusCondition{Type: "Synced", Reason: "Available"},
+			},
+			obj:      `{"status": {"conditions": [{"type": "Synced", "status": "True", "reason": "Provisioning"}]}}`,
+			expected: pending("waiting for resource to be ready: Provisioning\n"),
+		},
+		{
+			description: "stale status",
+			rule:        rule,
+			obj:         `{"metadata": {"generation": 3}, "status": {"observedGeneration": 2, "conditions": [{"type": "Ready", "status": "False", "reason": "Failed"}]}}`,
+			expected:    pending("waiting for the controller to observe the latest generation\n"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			obj := map[string]interface{}{}
+			t.CheckNoError(json.Unmarshal([]byte(test.obj), &obj))
+
+			t.CheckDeepEqual(test.expected, ruleStatus(obj, test.rule))
+		})
+	}
+}
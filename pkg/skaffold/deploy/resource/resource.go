@@ -0,0 +1,444 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/diag"
+	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+const (
+	rollOutSuccess          = "successfully rolled out"
+	rollingUpdateComplete   = "rolling update complete"
+	partitionedRollOut      = "partitioned roll out complete"
+	rollOutNotAvailable     = "rollout status is only available for"
+	connectionErrMsg        = "Unable to connect to the server"
+	killedErrMsg            = "signal: killed"
+	defaultPodCheckDeadline = 30 * time.Second
+	tabHeader               = " -"
+	tab                     = "  "
+	maxLogLines             = 3
+)
+
+var (
+	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
+	MsgKubectlConnection = "kubectl connection error\n"
+
+	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
+		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
+		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
+	}
+)
+
+// Type is the kind of a Kubernetes resource and decides how its status is checked.
+type Type string
+
+// ResourceTypes lists the kinds of resources that participate in the status check.
+// Deployments, StatefulSets and DaemonSets are checked with `kubectl rollout status`.
+// Jobs, standalone Pods and custom resources are checked with their status conditions.
+var ResourceTypes = struct {
+	Deployment     Type
+	StatefulSet    Type
+	DaemonSet      Type
+	Job            Type
+	StandalonePod  Type
+	CustomResource Type
+}{
+	Deployment:     "deployment",
+	StatefulSet:    "statefulset",
+	DaemonSet:      "daemonset",
+	Job:            "job",
+	StandalonePod:  "pod",
+	CustomResource: "customresource",
+}
+
+type Group map[string]*Resource
+
+func (g Group) Add(r *Resource) {
+	g[r.ID()] = r
+}
+
+func (g Group) Contains(r *Resource) bool {
+	_, found := g[r.ID()]
+	return found
+}
+
+func (g Group) Reset() {
+	for k := range g {
+		delete(g, k)
+	}
+}
+
+type Resource struct {
+	name         string
+	namespace    string
+	rType        Type
+	kind         string
+	apiResource  string
+	rule         *latestV1.StatusCheckRule
+	status       Status
+	statusCode   proto.StatusCode
+	done         bool
+	deadline     time.Duration
+	pods         map[string]validator.Resource
+	podValidator diag.Diagnose
+}
+
+func (r *Resource) ID() string {
+	return fmt.Sprintf("%s:%s:%s", r.name, r.namespace, r.kind)
+}
+
+func (r *Resource) Deadline() time.Duration {
+	return r.deadline
+}
+
+func (r *Resource) UpdateStatus(ae proto.ActionableErr) {
+	updated := newStatus(ae)
+	if r.status.Equal(updated) {
+		r.status.changed = false
+		return
+	}
+	r.status = updated
+	r.statusCode = updated.ActionableError().ErrCode
+	r.status.changed = true
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
+		r.done = true
+	}
+}
+
+func NewResource(name string, rType Type, ns string, deadline time.Duration) *Resource {
+	return &Resource{
+		name:         name,
+		namespace:    ns,
+		rType:        rType,
+		kind:         string(rType),
+		apiResource:  string(rType),
+		status:       newStatus(proto.ActionableErr{}),
+		deadline:     deadline,
+		podValidator: diag.New(nil),
+	}
+}
+
+// NewCustomResource returns a resource whose status is read from its `Ready` condition.
+// kind is the lowercase kind used when reporting the resource and apiResource is the
+// fully qualified resource name passed to kubectl, e.g. `sqlinstances.sql.cnrm.cloud.google.com`.
+func NewCustomResource(name string, kind string, apiResource string, ns string, deadline time.Duration) *Resource {
+	r := NewResource(name, ResourceTypes.CustomResource, ns, deadline)
+	r.kind = kind
+	r.apiResource = apiResource
+	return r
+}
+
+// WithStatusCheckRule evaluates the resource's conditions with the given rule
+// instead of the default `Ready` condition.
+func (r *Resource) WithStatusCheckRule(rule latestV1.StatusCheckRule) *Resource {
+	r.rule = &rule
+	return r
+}
+
+func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
+	r.podValidator = pd
+	return r
+}
+
+func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
+	kubeCtl := kubectl.NewCLI(cfg, "")
+
+	var ae proto.ActionableErr
+	var err error
+	switch r.rType {
+	case ResourceTypes.Deployment, ResourceTypes.StatefulSet, ResourceTypes.DaemonSet:
+		ae, err = r.checkRolloutStatus(ctx, kubeCtl)
+	default:
+		ae, err = r.checkConditions(ctx, kubeCtl)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
+		ae.Message = fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %v", r.deadline, err)
+	}
+
+	r.UpdateStatus(ae)
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+}
+
+func (r *Resource) checkRolloutStatus(ctx context.Context, kubeCtl *kubectl.CLI) (proto.ActionableErr, error) {
+	b, err := kubeCtl.RunOut(ctx, "rollout", "status", r.apiResource, r.name, "--namespace", r.namespace, "--watch=false")
+	details := r.cleanupStatus(string(b))
+	return parseKubectlRolloutError(details, err), err
+}
+
+// checkConditions reads the resource with `kubectl get` and evaluates its status conditions.
+func (r *Resource) checkConditions(ctx context.Context, kubeCtl *kubectl.CLI) (proto.ActionableErr, error) {
+	b, err := kubeCtl.RunOut(ctx, "get", r.apiResource, r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return parseKubectlRolloutError("", err), err
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("could not parse status of %s: %v", r, err),
+		}, err
+	}
+	switch r.rType {
+	case ResourceTypes.Job:
+		return jobStatus(obj), nil
+	case ResourceTypes.StandalonePod:
+		return podStatus(obj), nil
+	case ResourceTypes.CustomResource:
+		if r.rule != nil {
+			return ruleStatus(obj, *r.rule), nil
+		}
+		return readyConditionStatus(obj), nil
+	default:
+		return readyConditionStatus(obj), nil
+	}
+}
+
+func (r *Resource) String() string {
+	if r.namespace == "default" {
+		return fmt.Sprintf("%s/%s", r.kind, r.name)
+	}
+
+	return fmt.Sprintf("%s:%s/%s", r.namespace, r.kind, r.name)
+}
+
+func (r *Resource) Type() Type {
+	return r.rType
+}
+
+func (r *Resource) Name() string {
+	return r.name
+}
+
+func (r *Resource) Status() Status {
+	return r.status
+}
+
+func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
+	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
+}
+
+func (r *Resource) StatusMessage() string {
+	for _, p := range r.pods {
+		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return fmt.Sprintf("%s\n", s.Message)
+		}
+	}
+	return r.status.String()
+}
+
+func (r *Resource) MarkComplete() {
+	r.done = true
+}
+
+// ReportSinceLastUpdated returns a string representing deployment status along with tab header
+// e.g.
+//   - testNs:deployment/leeroy-app: waiting for rollout to complete. (1/2) pending
+//   - testNs:pod/leeroy-app-xvbg : error pulling container image
+func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
+	if r.status.reported && !r.status.changed {
+		return ""
+	}
+	r.status.reported = true
+	if r.status.String() == "" {
+		return ""
+	}
+	var result strings.Builder
+	// Pod container statuses can be empty.
+	// This can happen when
+	// 1. No pods have been scheduled for the deployment
+	// 2. All containers are in running phase with no errors.
+	// In such case, avoid printing any status update for the deployment.
+	for _, p := range r.pods {
+		if s := p.ActionableError().Message; s != "" {
+			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
+			// if logs are muted, write container logs to file and last 3 lines to
+			// result.
+			out, writeTrimLines, err := withLogFile(p.Name(), &result, p.Logs(), isMuted)
+			if err != nil {
+				logrus.Debugf("could not create log file %v", err)
+			}
+			trimLines := []string{}
+			for i, l := range p.Logs() {
+				formattedLine := fmt.Sprintf("%s %s > %s\n", tab, tab, strings.TrimSuffix(l, "\n"))
+				if isMuted && i >= len(p.Logs())-maxLogLines {
+					trimLines = append(trimLines, formattedLine)
+				}
+				out.Write([]byte(formattedLine))
+			}
+			writeTrimLines(trimLines)
+		}
+	}
+	return fmt.Sprintf("%s %s: %s%s", tabHeader, r, r.StatusMessage(), result.String())
+}
+
+func (r *Resource) cleanupStatus(msg string) string {
+	clean := msg
+	for _, prefix := range []string{"deployment", "daemon set", "statefulset"} {
+		clean = strings.ReplaceAll(clean, prefix+` "`+r.Name()+`" `, "")
+	}
+	if len(clean) > 0 {
+		clean = strings.ToLower(clean[0:1]) + clean[1:]
+	}
+	return clean
+}
+
+// parses out connection error
+// $kubectl logs somePod -f
+// Unable to connect to the server: dial tcp x.x.x.x:443: connect: network is unreachable
+
+// Parses out errors when kubectl was killed on client side
+// $kubectl logs testPod  -f
+// 2020/06/18 17:28:31 service is running
+// Killed: 9
+func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
+	switch {
+	case err == nil && isRolledOut(details):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: details,
+		}
+	case err != nil && strings.Contains(err.Error(), rollOutNotAvailable):
+		// StatefulSets and DaemonSets with an OnDelete update strategy can't report
+		// a rollout status, so there is nothing to wait for.
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "rollout status not available for OnDelete update strategy\n",
+		}
+	case err == nil:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: details,
+		}
+	case strings.Contains(err.Error(), connectionErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			Message: MsgKubectlConnection,
+		}
+	case strings.Contains(err.Error(), killedErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
+			Message: msgKubectlKilled,
+		}
+	default:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: err.Error(),
+		}
+	}
+}
+
+func isRolledOut(details string) bool {
+	return strings.Contains(details, rollOutSuccess) ||
+		strings.Contains(details, rollingUpdateComplete) ||
+		strings.Contains(details, partitionedRollOut)
+}
+
+func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
+	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
+		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING
+}
+
+// HasEncounteredUnrecoverableError goes through all pod statuses and return true
+// if any cannot be recovered
+func (r *Resource) HasEncounteredUnrecoverableError() bool {
+	for _, p := range r.pods {
+		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resource) fetchPods(ctx context.Context) error {
+	timeoutContext, cancel := context.WithTimeout(ctx, defaultPodCheckDeadline)
+	defer cancel()
+	pods, err := r.podValidator.Run(timeoutContext)
+	if err != nil {
+		return err
+	}
+
+	newPods := map[string]validator.Resource{}
+	r.status.changed = false
+	for _, p := range pods {
+		originalPod, found := r.pods[p.String()]
+		if !found || originalPod.StatusUpdated(p) {
+			r.status.changed = true
+			switch p.ActionableError().ErrCode {
+			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
+				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
+				event.ResourceStatusCheckEventUpdated(p.String(), p.ActionableError())
+				eventV2.ResourceStatusCheckEventUpdated(p.String(), sErrors.V2fromV1(p.ActionableError()))
+			default:
+				event.ResourceStatusCheckEventCompleted(p.String(), p.ActionableError())
+				eventV2.ResourceStatusCheckEventCompleted(p.String(), sErrors.V2fromV1(p.ActionableError()))
+			}
+		}
+		newPods[p.String()] = p
+	}
+	r.pods = newPods
+	return nil
+}
+
+// StatusCode() returns the deployment status code if the status check is cancelled
+// or if no pod data exists for this deployment.
+// If pods are fetched, this function returns the error code a pod container encountered.
+func (r *Resource) StatusCode() proto.StatusCode {
+	// do not process pod status codes if another deployment failed
+	// or the user aborted the run.
+	if r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return r.statusCode
+	}
+	for _, p := range r.pods {
+		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return s
+		}
+	}
+	return r.statusCode
+}
+
+func (r *Resource) WithPodStatuses(scs []proto.StatusCode) *Resource {
+	r.pods = map[string]validator.Resource{}
+	for i, s := range scs {
+		name := fmt.Sprintf("%s-%d", r.name, i)
+		r.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
+			proto.ActionableErr{Message: "pod failed", ErrCode: s}, nil)
+	}
+	return r
+}
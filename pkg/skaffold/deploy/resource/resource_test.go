@@ -101,7 +101,7 @@ func TestDeploymentCheckStatus(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
 
-			r := NewDeployment("graph", "test", 0)
+			r := NewResource("graph", ResourceTypes.Deployment, "test", 0)
 			r.CheckStatus(context.Background(), &statusConfig{})
 
 			if test.cancelled {
@@ -120,6 +120,104 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}
 }
 
+func TestResourceCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		resource        *Resource
+		commands        util.Command
+		expectedErr     string
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "statefulset rolling update complete",
+			resource:    NewResource("db", ResourceTypes.StatefulSet, "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset db --namespace test --watch=false",
+				"statefulset rolling update complete 3 pods at revision db-7b5c8f...",
+			),
+			expectedDetails: "statefulset rolling update complete 3 pods at revision db-7b5c8f...",
+			complete:        true,
+		},
+		{
+			description: "statefulset with OnDelete strategy",
+			resource:    NewResource("db", ResourceTypes.StatefulSet, "test", 0),
+			commands: testutil.CmdRunOutErr(
+				"kubectl --context kubecontext rollout status statefulset db --namespace test --watch=false",
+				"",
+				errors.New("rollout status is only available for RollingUpdate strategy type"),
+			),
+			expectedDetails: "rollout status not available for OnDelete update strategy\n",
+			complete:        true,
+		},
+		{
+			description: "daemonset rolled out",
+			resource:    NewResource("agent", ResourceTypes.DaemonSet, "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset agent --namespace test --watch=false",
+				"daemon set \"agent\" successfully rolled out",
+			),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+		{
+			description: "job still running",
+			resource:    NewResource("migrate", ResourceTypes.Job, "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job migrate --namespace test -o json",
+				`{"status": {"active": 1}}`,
+			),
+			expectedDetails: "waiting for job to complete: 1 active, 0 succeeded, 0 failed\n",
+		},
+		{
+			description: "job failed",
+			resource:    NewResource("migrate", ResourceTypes.Job, "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job migrate --namespace test -o json",
+				`{"status": {"failed": 2, "conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded", "message": "Job has reached the specified backoff limit"}]}}`,
+			),
+			expectedErr: "job failed: BackoffLimitExceeded: Job has reached the specified backoff limit",
+			complete:    true,
+		},
+		{
+			description: "custom resource ready",
+			resource:    NewCustomResource("db", "sqlinstance", "sqlinstances.sql.cnrm.cloud.google.com", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get sqlinstances.sql.cnrm.cloud.google.com db --namespace test -o json",
+				`{"status": {"conditions": [{"type": "Ready", "status": "True", "reason": "UpToDate"}]}}`,
+			),
+			expectedDetails: "resource is ready\n",
+			complete:        true,
+		},
+		{
+			description: "kubectl get error",
+			resource:    NewResource("standalone", ResourceTypes.StandalonePod, "test", 0),
+			commands: testutil.CmdRunOutErr(
+				"kubectl --context kubecontext get pod standalone --namespace test -o json",
+				"",
+				errors.New("Unable to connect to the server"),
+			),
+			expectedErr: MsgKubectlConnection,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := test.resource
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, r.Status().Error())
+			} else {
+				t.CheckDeepEqual(test.expectedDetails, r.status.ae.Message)
+			}
+		})
+	}
+}
+
 func TestParseKubectlError(t *testing.T) {
 	tests := []struct {
 		description string
@@ -151,6 +249,14 @@ func TestParseKubectlError(t *testing.T) {
 				Message: "deployment test not found",
 			},
 		},
+		{
+			description: "statefulset partitioned roll out",
+			details:     "partitioned roll out complete: 2 new pods have been updated...",
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "partitioned roll out complete: 2 new pods have been updated...",
+			},
+		},
 		{
 			description: "rollout status nil error",
 			details:     "successfully rolled out",
@@ -281,7 +387,7 @@ func TestReportSinceLastUpdated(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			dep := NewDeployment("test", "test-ns", 1)
+			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1)
 			dep.pods = map[string]validator.Resource{
 				"foo": validator.NewResource(
 					"test",
@@ -336,7 +442,7 @@ func TestReportSinceLastUpdatedMultipleTimes(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			dep := NewDeployment("test", "test-ns", 1)
+			dep := NewResource("test", ResourceTypes.Deployment, "test-ns", 1)
 			var actual string
 			for i, status := range test.podStatuses {
 				dep.UpdateStatus(proto.ActionableErr{
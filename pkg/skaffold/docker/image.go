@@ -35,7 +35,9 @@ import (
 	"github.com/docker/docker/errdefs"
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/progress"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/docker/pkg/streamformatter"
+	"github.com/docker/go-connections/nat"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/sirupsen/logrus"
 
@@ -60,11 +62,14 @@ type ContainerRun struct {
 }
 
 type ContainerCreateOpts struct {
-	Name        string
-	Image       string
-	Network     string
-	VolumesFrom []string
-	Wait        bool
+	Name            string
+	Image           string
+	Network         string
+	VolumesFrom     []string
+	Mounts          []mount.Mount
+	Wait            bool
+	Bindings        nat.PortMap       // container ports published on the host
+	ContainerConfig *container.Config // base configuration of the container, the image is always set from Image
 }
 
 // LocalDaemon talks to a local Docker API.
@@ -74,6 +79,9 @@ type LocalDaemon interface {
 	ServerVersion(ctx context.Context) (types.Version, error)
 	ConfigFile(ctx context.Context, image string) (*v1.ConfigFile, error)
 	ContainerLogs(ctx context.Context, out io.Writer, id string, muter chan bool) (io.ReadCloser, error)
+	ContainerInspect(ctx context.Context, id string) (types.ContainerJSON, error)
+	ContainerExec(ctx context.Context, out io.Writer, id string, cmd []string) error
+	CopyToContainer(ctx context.Context, id string, dstPath string, content io.Reader) error
 	Build(ctx context.Context, out io.Writer, workspace string, artifact string, a *latestV1.DockerArtifact, opts BuildOptions) (string, error)
 	Push(ctx context.Context, out io.Writer, ref string) (string, error)
 	Pull(ctx context.Context, out io.Writer, ref string) error
@@ -150,6 +158,47 @@ func (l *localDaemon) ContainerLogs(ctx context.Context, out io.Writer, id strin
 	return l.apiClient.ContainerLogs(ctx, id, types.ContainerLogsOptions{ShowStdout: true, ShowStderr: true, Follow: true})
 }
 
+// ContainerInspect returns the low-level information of a container.
+func (l *localDaemon) ContainerInspect(ctx context.Context, id string) (types.ContainerJSON, error) {
+	return l.apiClient.ContainerInspect(ctx, id)
+}
+
+// ContainerExec runs a command in a running container, and waits for it to complete.
+func (l *localDaemon) ContainerExec(ctx context.Context, out io.Writer, id string, cmd []string) error {
+	exec, err := l.apiClient.ContainerExecCreate(ctx, id, types.ExecConfig{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("creating exec instance: %w", err)
+	}
+
+	resp, err := l.apiClient.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{})
+	if err != nil {
+		return fmt.Errorf("attaching to exec instance: %w", err)
+	}
+	defer resp.Close()
+
+	if _, err := stdcopy.StdCopy(out, out, resp.Reader); err != nil {
+		return fmt.Errorf("reading exec output: %w", err)
+	}
+
+	inspect, err := l.apiClient.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return fmt.Errorf("inspecting exec instance: %w", err)
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("command %q exited with code %d", strings.Join(cmd, " "), inspect.ExitCode)
+	}
+	return nil
+}
+
+// CopyToContainer extracts a tar archive into a directory of a container.
+func (l *localDaemon) CopyToContainer(ctx context.Context, id string, dstPath string, content io.Reader) error {
+	return l.apiClient.CopyToContainer(ctx, id, dstPath, content, types.CopyToContainerOptions{})
+}
+
 // Delete stops, removes, and prunes a running container
 func (l *localDaemon) Delete(ctx context.Context, out io.Writer, id string) error {
 	if err := l.apiClient.ContainerStop(ctx, id, nil); err != nil {
@@ -167,13 +216,27 @@ func (l *localDaemon) Delete(ctx context.Context, out io.Writer, id string) erro
 
 // Run creates a container from a given image reference, and returns then container ID.
 func (l *localDaemon) Run(ctx context.Context, out io.Writer, opts ContainerCreateOpts) (string, error) {
-	cfg := &container.Config{
-		Image: opts.Image,
+	cfg := &container.Config{}
+	if opts.ContainerConfig != nil {
+		*cfg = *opts.ContainerConfig
 	}
+	cfg.Image = opts.Image
 
 	hCfg := &container.HostConfig{
 		NetworkMode: container.NetworkMode(opts.Network),
 		VolumesFrom: opts.VolumesFrom,
+		Mounts:      opts.Mounts,
+	}
+	if len(opts.Bindings) > 0 {
+		exposedPorts := nat.PortSet{}
+		for port := range cfg.ExposedPorts {
+			exposedPorts[port] = struct{}{}
+		}
+		cfg.ExposedPorts = exposedPorts
+		for port := range opts.Bindings {
+			cfg.ExposedPorts[port] = struct{}{}
+		}
+		hCfg.PortBindings = opts.Bindings
 	}
 	c, err := l.apiClient.ContainerCreate(ctx, cfg, hCfg, nil, nil, opts.Name)
 	if err != nil {
@@ -183,7 +246,15 @@ func (l *localDaemon) Run(ctx context.Context, out io.Writer, opts ContainerCrea
 		return "", err
 	}
 	if opts.Wait {
-		l.apiClient.ContainerWait(ctx, c.ID, container.WaitConditionNotRunning)
+		statusCh, errCh := l.apiClient.ContainerWait(ctx, c.ID, container.WaitConditionNotRunning)
+		select {
+		case err := <-errCh:
+			return "", fmt.Errorf("waiting for container %s: %w", c.ID, err)
+		case status := <-statusCh:
+			if status.StatusCode != 0 {
+				return "", fmt.Errorf("container %s exited with code %d", c.ID, status.StatusCode)
+			}
+		}
 	}
 	return c.ID, nil
 }
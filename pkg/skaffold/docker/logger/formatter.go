@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	tagutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag/util"
+)
+
+type dockerLogFormatter struct {
+	colorPicker output.ColorPicker
+	prefix      string
+	jsonFormat  bool
+	container   tracker.Container
+
+	lock    sync.Mutex
+	isMuted func() bool
+}
+
+func NewDockerLogFormatter(config Config, colorPicker output.ColorPicker, isMuted func() bool, container tracker.Container) log.Formatter {
+	f := newDockerLogFormatter(config, colorPicker, isMuted, container)
+	filters, err := log.NewFilters(logsConfig(config, container), config.LogFilters(), !config.JSONLogs())
+	if err != nil {
+		// filters are validated with the config, so this isn't expected.
+		logrus.Warnf("ignoring log filters: %v", err)
+		return f
+	}
+	return log.WithFilters(f, filters, log.Source{Container: container.Name})
+}
+
+func newDockerLogFormatter(config Config, colorPicker output.ColorPicker, isMuted func() bool, container tracker.Container) *dockerLogFormatter {
+	return &dockerLogFormatter{
+		colorPicker: colorPicker,
+		container:   container,
+		prefix:      prefix(config, container),
+		jsonFormat:  config.JSONLogs(),
+		isMuted:     isMuted,
+	}
+}
+
+func (d *dockerLogFormatter) Name() string { return d.prefix }
+
+func (d *dockerLogFormatter) PrintLine(out io.Writer, line string) {
+	if d.jsonFormat {
+		d.printJSONLine(out, line)
+		return
+	}
+
+	formattedPrefix := d.prefix
+	if output.IsColorable(out) {
+		formattedPrefix = d.colorPicker.Pick(d.container.Image).Sprintf("%s", d.prefix)
+		// if our original prefix was empty, don't prepend a space to the line,
+		// but keep the color prefix we just added.
+		if d.prefix != "" {
+			formattedPrefix = fmt.Sprintf("%s ", formattedPrefix)
+		}
+	}
+	formattedLine := fmt.Sprintf("%s%s", formattedPrefix, line)
+	eventV2.ApplicationLog("", d.container.Name, formattedPrefix, line, formattedLine)
+
+	if !d.isMuted() {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		fmt.Fprint(out, formattedLine)
+	}
+}
+
+// printJSONLine prints the line as a JSON object with the container it comes from.
+func (d *dockerLogFormatter) printJSONLine(out io.Writer, line string) {
+	entry := log.NewJSONEntry(line)
+	entry.Container = d.container.Name
+	entry.Image = d.container.Image
+	formattedLine := entry.String()
+	eventV2.ApplicationLog("", d.container.Name, "", entry.Message, formattedLine)
+
+	if !d.isMuted() {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		fmt.Fprint(out, formattedLine)
+	}
+}
+
+// prefix returns the log prefix of a container. Containers aren't grouped in pods,
+// so every prefix other than `none` uses the container name.
+func prefix(config Config, container tracker.Container) string {
+	if logsConfig(config, container).Prefix == "none" {
+		return ""
+	}
+	return fmt.Sprintf("[%s]", container.Name)
+}
+
+// logsConfig returns the `deploy.logs` config of the pipeline that built the container's image.
+func logsConfig(config Config, container tracker.Container) latestV1.LogsConfig {
+	c, present := config.PipelineForImage(tagutil.StripTag(container.Image, false))
+	if !present {
+		c = config.DefaultPipeline()
+	}
+	return c.Deploy.Logs
+}
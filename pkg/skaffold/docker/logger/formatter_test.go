@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	log     latestV1.LogsConfig
+	json    bool
+	filters config.LogFilterOptions
+}
+
+func (c *mockConfig) JSONLogs() bool {
+	return c.json
+}
+
+func (c *mockConfig) LogFilters() config.LogFilterOptions {
+	return c.filters
+}
+
+func (c *mockConfig) LogFiles() config.LogFileOptions {
+	return config.LogFileOptions{}
+}
+
+func (c *mockConfig) PipelineForImage(string) (latestV1.Pipeline, bool) {
+	var pipeline latestV1.Pipeline
+	pipeline.Deploy.Logs = c.log
+	return pipeline, true
+}
+
+func (c *mockConfig) DefaultPipeline() latestV1.Pipeline {
+	var pipeline latestV1.Pipeline
+	pipeline.Deploy.Logs = c.log
+	return pipeline
+}
+
+func TestPrintLine(t *testing.T) {
+	tests := []struct {
+		description string
+		prefix      string
+		muted       bool
+		json        bool
+		expected    string
+	}{
+		{
+			description: "container prefix",
+			prefix:      "container",
+			expected:    "[web-1]TEXT\n",
+		},
+		{
+			description: "auto prefix",
+			prefix:      "auto",
+			expected:    "[web-1]TEXT\n",
+		},
+		{
+			description: "no prefix",
+			prefix:      "none",
+			expected:    "TEXT\n",
+		},
+		{
+			description: "json",
+			prefix:      "container",
+			json:        true,
+			expected:    `{"timestamp":"2021-06-01T10:00:00Z","container":"web-1","image":"web:tag","message":"TEXT"}` + "\n",
+		},
+		{
+			description: "muted",
+			prefix:      "container",
+			muted:       true,
+			expected:    "",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var buf bytes.Buffer
+			container := tracker.Container{Name: "web-1", ID: "id", Image: "web:tag"}
+
+			f := NewDockerLogFormatter(&mockConfig{log: latestV1.LogsConfig{Prefix: test.prefix}, json: test.json}, output.NewColorPicker(), func() bool { return test.muted }, container)
+			line := "TEXT\n"
+			if test.json {
+				line = "2021-06-01T10:00:00Z " + line
+			}
+			f.PrintLine(&buf, line)
+
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/sirupsen/logrus"
+
+	sConfig "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log/stream"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// Logger streams the logs of the containers deployed by the docker deployer.
+type Logger struct {
+	output      io.Writer
+	client      docker.LocalDaemon
+	tracker     *tracker.ContainerTracker
+	config      Config
+	colorPicker output.ColorPicker
+
+	muted             int32
+	cancel            context.CancelFunc
+	events            chan tracker.Container
+	trackedContainers trackedContainers
+}
+
+type Config interface {
+	JSONLogs() bool
+	LogFilters() sConfig.LogFilterOptions
+	LogFiles() sConfig.LogFileOptions
+	PipelineForImage(imageName string) (latestV1.Pipeline, bool)
+	DefaultPipeline() latestV1.Pipeline
+}
+
+// NewLogger creates a new Logger for the containers tracked by the given tracker.
+func NewLogger(client docker.LocalDaemon, tracker *tracker.ContainerTracker, config Config) *Logger {
+	return &Logger{
+		client:      client,
+		tracker:     tracker,
+		config:      config,
+		colorPicker: output.NewColorPicker(),
+		cancel:      func() {},
+	}
+}
+
+// RegisterArtifacts tracks the provided build artifacts in the colorpicker
+func (l *Logger) RegisterArtifacts(artifacts []graph.Artifact) {
+	for _, artifact := range artifacts {
+		l.colorPicker.AddImage(artifact.Tag)
+	}
+}
+
+// SetSince is a noop: containers are recreated on each deploy,
+// so their logs are always streamed from the start.
+func (l *Logger) SetSince(time.Time) {}
+
+// Start starts streaming the logs of the deployed containers,
+// and of the containers deployed later on.
+func (l *Logger) Start(ctx context.Context, out io.Writer) error {
+	l.output = out
+
+	ctx, cancel := context.WithCancel(ctx)
+	l.cancel = cancel
+	l.events = make(chan tracker.Container)
+	l.tracker.Register(l.events)
+
+	for _, c := range l.tracker.Containers() {
+		if !l.trackedContainers.add(c.ID) {
+			go l.streamContainerLogs(ctx, c)
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case c := <-l.events:
+				if !l.trackedContainers.add(c.ID) {
+					go l.streamContainerLogs(ctx, c)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop stops the logger.
+func (l *Logger) Stop() {
+	// deregister first, so that the tracker doesn't notify a logger that stopped receiving
+	if l.events != nil {
+		l.tracker.Deregister(l.events)
+	}
+	l.cancel()
+}
+
+func (l *Logger) streamContainerLogs(ctx context.Context, c tracker.Container) {
+	logrus.Infof("Streaming logs from container: %s", c.Name)
+
+	tr, tw := io.Pipe()
+	go func() {
+		rc, err := l.client.ContainerLogs(ctx, tw, c.ID, nil)
+		if err != nil {
+			// Don't print errors if the user interrupted the logs
+			// or if the logs were interrupted because of a configuration change
+			if ctx.Err() != context.Canceled {
+				logrus.Warnf("retrieving logs of container %s: %v", c.Name, err)
+			}
+			_ = tw.Close()
+			return
+		}
+		defer rc.Close()
+
+		// Containers aren't run with a TTY, so stdout and stderr are multiplexed.
+		if _, err := stdcopy.StdCopy(tw, tw, rc); err != nil && ctx.Err() != context.Canceled {
+			logrus.Warnf("streaming logs of container %s: %v", c.Name, err)
+		}
+		_ = tw.Close()
+	}()
+
+	formatter := NewDockerLogFormatter(l.config, l.colorPicker, l.IsMuted, c)
+	file, err := log.OpenFile(l.config.LogFiles(), c.Name+".log")
+	if err != nil {
+		logrus.Warnf("unable to persist logs of container %s: %v", c.Name, err)
+	} else if file != nil {
+		defer file.Close()
+		formatter = log.WithFile(formatter, file)
+	}
+	if err := stream.StreamRequest(ctx, l.output, formatter, tr); err != nil {
+		logrus.Errorf("streaming request %s", err)
+	}
+}
+
+// Mute mutes the logs.
+func (l *Logger) Mute() {
+	atomic.StoreInt32(&l.muted, 1)
+}
+
+// Unmute unmutes the logs.
+func (l *Logger) Unmute() {
+	atomic.StoreInt32(&l.muted, 0)
+}
+
+// IsMuted says if the logs are to be muted.
+func (l *Logger) IsMuted() bool {
+	return atomic.LoadInt32(&l.muted) == 1
+}
+
+type trackedContainers struct {
+	sync.Mutex
+	ids map[string]bool
+}
+
+// add adds a containerID to be tracked. Return true if the container
+// was already tracked.
+func (t *trackedContainers) add(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+	alreadyTracked := t.ids[id]
+	if t.ids == nil {
+		t.ids = map[string]bool{}
+	}
+	t.ids[id] = true
+
+	return alreadyTracked
+}
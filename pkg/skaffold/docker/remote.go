@@ -93,6 +93,34 @@ func Push(tarPath, tag string, cfg Config) (string, error) {
 	return getRemoteDigest(tag, cfg)
 }
 
+// PushImage pushes an in-memory image and returns its digest.
+func PushImage(img v1.Image, tag string, cfg Config) (string, error) {
+	t, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.Write(t, img, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, t, err)
+	}
+
+	return digest(img)
+}
+
+// PushIndex pushes an in-memory multi-platform image index and returns its digest.
+func PushIndex(idx v1.ImageIndex, tag string, cfg Config) (string, error) {
+	t, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.WriteIndex(t, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, t, err)
+	}
+
+	return digest(idx)
+}
+
 func getRemoteImage(identifier string, cfg Config) (v1.Image, error) {
 	ref, err := parseReference(identifier, cfg)
 	if err != nil {
@@ -111,6 +139,16 @@ func getRemoteIndex(identifier string, cfg Config) (v1.ImageIndex, error) {
 	return remoteIndex(ref, remote.WithAuthFromKeychain(primaryKeychain))
 }
 
+// RemoteDescriptor fetches the descriptor of a remote image or image index.
+func RemoteDescriptor(identifier string, cfg Config, opts ...remote.Option) (*remote.Descriptor, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return remote.Get(ref, append(opts, remote.WithAuthFromKeychain(primaryKeychain))...)
+}
+
 // IsInsecure tests if an image is pulled from an insecure registry; default is false
 func IsInsecure(ref name.Reference, insecureRegistries map[string]bool) bool {
 	return insecureRegistries[ref.Context().Registry.Name()]
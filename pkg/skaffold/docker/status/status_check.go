@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// DefaultStatusCheckDeadline is the default timeout for container status checks
+	DefaultStatusCheckDeadline = 10 * time.Minute
+
+	// Poll period for checking set to 1 second
+	defaultPollPeriodInMilliseconds = 1000
+)
+
+const tabHeader = " -"
+
+// Monitor waits for the containers deployed by the docker deployer to be running,
+// and healthy if they define a health check.
+type Monitor struct {
+	client          docker.LocalDaemon
+	tracker         *tracker.ContainerTracker
+	deadlineSeconds int
+
+	lock           sync.Mutex
+	seenContainers map[string]bool
+}
+
+// NewMonitor returns a status monitor for the containers tracked by the given tracker.
+func NewMonitor(client docker.LocalDaemon, tracker *tracker.ContainerTracker, deadlineSeconds int) *Monitor {
+	return &Monitor{
+		client:          client,
+		tracker:         tracker,
+		deadlineSeconds: deadlineSeconds,
+		seenContainers:  make(map[string]bool),
+	}
+}
+
+// Check runs the status checks on the containers deployed in the current skaffold dev iteration.
+func (m *Monitor) Check(ctx context.Context, out io.Writer) error {
+	event.StatusCheckEventStarted()
+
+	start := time.Now()
+	output.Default.Fprintln(out, "Waiting for containers to stabilize...")
+
+	errCode, err := m.statusCheck(ctx, out)
+	event.StatusCheckEventEnded(errCode, err)
+	if err != nil {
+		return err
+	}
+
+	output.Default.Fprintln(out, "Containers stabilized in", util.ShowHumanizeTime(time.Since(start)))
+	return nil
+}
+
+// Reset forgets the containers already checked.
+func (m *Monitor) Reset() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.seenContainers = make(map[string]bool)
+}
+
+func (m *Monitor) statusCheck(ctx context.Context, out io.Writer) (proto.StatusCode, error) {
+	var containers []tracker.Container
+	m.lock.Lock()
+	for _, c := range m.tracker.Containers() {
+		if m.seenContainers[c.ID] {
+			continue
+		}
+		m.seenContainers[c.ID] = true
+		containers = append(containers, c)
+	}
+	m.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]proto.ActionableErr, len(containers))
+	var wg sync.WaitGroup
+	for i, c := range containers {
+		wg.Add(1)
+		go func(i int, c tracker.Container) {
+			defer wg.Done()
+			// keep polling the container status until it fails/succeeds/times out
+			results[i] = pollContainerStatus(ctx, m.client, c, getDeadline(m.deadlineSeconds))
+			m.printStatusCheckSummary(out, c, results[i])
+			// if one container fails, cancel status checks for all containers.
+			if results[i].ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+				cancel()
+			}
+		}(i, c)
+	}
+	wg.Wait()
+
+	return getSkaffoldDeployStatus(results)
+}
+
+// WaitForReady waits for a container to be running, and healthy if it defines a health check,
+// or to have completed successfully. A deadline of 0 means the default status check deadline.
+func WaitForReady(ctx context.Context, client docker.LocalDaemon, c tracker.Container, deadline time.Duration) error {
+	if deadline <= 0 {
+		deadline = DefaultStatusCheckDeadline
+	}
+	ae := pollContainerStatus(ctx, client, c, deadline)
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+		return fmt.Errorf("container %s is not ready: %s", c.Name, ae.Message)
+	}
+	return nil
+}
+
+func pollContainerStatus(ctx context.Context, client docker.LocalDaemon, c tracker.Container, deadline time.Duration) proto.ActionableErr {
+	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
+	ticker := time.NewTicker(pollDuration)
+	defer ticker.Stop()
+
+	// Add poll duration to account for one last attempt after the deadline.
+	timeoutContext, cancel := context.WithTimeout(ctx, deadline+pollDuration)
+	defer cancel()
+	logrus.Debugf("checking status of container %s", c.Name)
+
+	for {
+		select {
+		case <-timeoutContext.Done():
+			if timeoutContext.Err() == context.DeadlineExceeded {
+				return proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED,
+					Message: fmt.Sprintf("could not stabilize within %v", deadline),
+				}
+			}
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+				Message: "check cancelled",
+			}
+		case <-ticker.C:
+			info, err := client.ContainerInspect(timeoutContext, c.ID)
+			if err != nil {
+				if timeoutContext.Err() != nil {
+					continue
+				}
+				return proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+					Message: fmt.Sprintf("inspecting container: %v", err),
+				}
+			}
+			if ae, done := containerStatus(info); done {
+				return ae
+			}
+		}
+	}
+}
+
+// containerStatus returns the status of a container, and whether the status check is complete.
+func containerStatus(info types.ContainerJSON) (proto.ActionableErr, bool) {
+	if info.ContainerJSONBase == nil || info.State == nil {
+		return proto.ActionableErr{}, false
+	}
+	state := info.State
+
+	switch state.Status {
+	case "running":
+		if state.Health == nil {
+			return proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, true
+		}
+		switch state.Health.Status {
+		case types.Healthy:
+			return proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, true
+		case types.Unhealthy:
+			msg := "container is unhealthy"
+			if n := len(state.Health.Log); n > 0 {
+				msg = fmt.Sprintf("%s: %s", msg, strings.TrimSpace(state.Health.Log[n-1].Output))
+			}
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNHEALTHY,
+				Message: msg,
+			}, true
+		}
+	case "exited", "dead":
+		if state.ExitCode == 0 && state.Status == "exited" {
+			return proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS}, true
+		}
+		msg := fmt.Sprintf("container exited with code %d", state.ExitCode)
+		if state.Error != "" {
+			msg = fmt.Sprintf("%s: %s", msg, state.Error)
+		}
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			Message: msg,
+		}, true
+	}
+	return proto.ActionableErr{}, false
+}
+
+func (m *Monitor) printStatusCheckSummary(out io.Writer, c tracker.Container, ae proto.ActionableErr) {
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		// Don't print the status summary if the user ctrl-C or
+		// another container failed
+		return
+	}
+	resource := fmt.Sprintf("container/%s", c.Name)
+	event.ResourceStatusCheckEventCompleted(resource, ae)
+	eventV2.ResourceStatusCheckEventCompleted(resource, sErrors.V2fromV1(ae))
+	out = output.WithEventContext(out, constants.Deploy, resource)
+
+	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+		fmt.Fprintf(out, "%s %s failed. Error: %s.\n", tabHeader, resource, ae.Message)
+		return
+	}
+	fmt.Fprintf(out, "%s %s is ready.\n", tabHeader, resource)
+}
+
+func getSkaffoldDeployStatus(results []proto.ActionableErr) (proto.StatusCode, error) {
+	var failed int
+	errCode := proto.StatusCode_STATUSCHECK_SUCCESS
+	for _, ae := range results {
+		if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+			continue
+		}
+		failed++
+		if errCode == proto.StatusCode_STATUSCHECK_SUCCESS || errCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+			errCode = ae.ErrCode
+		}
+	}
+	if failed == 0 {
+		return proto.StatusCode_STATUSCHECK_SUCCESS, nil
+	}
+	return errCode, fmt.Errorf("%d/%d container(s) failed", failed, len(results))
+}
+
+func getDeadline(d int) time.Duration {
+	if d > 0 {
+		return time.Duration(d) * time.Second
+	}
+	return DefaultStatusCheckDeadline
+}
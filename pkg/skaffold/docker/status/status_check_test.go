@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestContainerStatus(t *testing.T) {
+	tests := []struct {
+		description  string
+		state        *types.ContainerState
+		expected     proto.ActionableErr
+		expectedDone bool
+	}{
+		{
+			description: "no state",
+		},
+		{
+			description: "created",
+			state:       &types.ContainerState{Status: "created"},
+		},
+		{
+			description:  "running",
+			state:        &types.ContainerState{Status: "running"},
+			expected:     proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			expectedDone: true,
+		},
+		{
+			description: "starting health check",
+			state:       &types.ContainerState{Status: "running", Health: &types.Health{Status: types.Starting}},
+		},
+		{
+			description:  "healthy",
+			state:        &types.ContainerState{Status: "running", Health: &types.Health{Status: types.Healthy}},
+			expected:     proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			expectedDone: true,
+		},
+		{
+			description: "unhealthy",
+			state: &types.ContainerState{Status: "running", Health: &types.Health{
+				Status: types.Unhealthy,
+				Log:    []*types.HealthcheckResult{{Output: "connection refused\n"}},
+			}},
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNHEALTHY,
+				Message: "container is unhealthy: connection refused",
+			},
+			expectedDone: true,
+		},
+		{
+			description:  "completed",
+			state:        &types.ContainerState{Status: "exited"},
+			expected:     proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			expectedDone: true,
+		},
+		{
+			description: "failed",
+			state:       &types.ContainerState{Status: "exited", ExitCode: 1},
+			expected: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+				Message: "container exited with code 1",
+			},
+			expectedDone: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			info := types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{State: test.state}}
+
+			ae, done := containerStatus(info)
+
+			t.CheckDeepEqual(test.expected, ae)
+			t.CheckDeepEqual(test.expectedDone, done)
+		})
+	}
+}
+
+func TestGetSkaffoldDeployStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		results     []proto.ActionableErr
+		expected    proto.StatusCode
+		shouldErr   bool
+	}{
+		{
+			description: "no containers",
+			expected:    proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "all succeeded",
+			results: []proto.ActionableErr{
+				{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+				{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
+			},
+			expected: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "one failed, one cancelled",
+			results: []proto.ActionableErr{
+				{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
+				{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED},
+			},
+			expected:  proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			code, err := getSkaffoldDeployStatus(test.results)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, code)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracker
+
+import (
+	"sort"
+	"sync"
+)
+
+// Container is a container created by the docker deployer.
+type Container struct {
+	Name  string // container name
+	ID    string // container ID
+	Image string // image tag the container runs
+}
+
+// ContainerTracker keeps track of the containers deployed by the docker deployer,
+// and notifies its listeners of each new container.
+type ContainerTracker struct {
+	lock       sync.RWMutex
+	containers map[string]Container // artifact image name -> container
+	listeners  []*listener
+}
+
+// listener is a registered channel. done is closed when the channel is deregistered,
+// so that pending notifications don't block once the listener stopped receiving.
+type listener struct {
+	events  chan Container
+	done    chan struct{}
+	sending sync.WaitGroup // notifications not yet received
+}
+
+// NewContainerTracker creates a new ContainerTracker.
+func NewContainerTracker() *ContainerTracker {
+	return &ContainerTracker{
+		containers: make(map[string]Container),
+	}
+}
+
+// Add tracks the container running the given artifact image and notifies the listeners.
+func (t *ContainerTracker) Add(imageName string, c Container) {
+	t.lock.Lock()
+	t.containers[imageName] = c
+	for _, l := range t.listeners {
+		l.sending.Add(1)
+		go func(l *listener) {
+			defer l.sending.Done()
+			select {
+			case l.events <- c:
+			case <-l.done:
+			}
+		}(l)
+	}
+	t.lock.Unlock()
+}
+
+// Remove stops tracking the container running the given artifact image.
+func (t *ContainerTracker) Remove(imageName string) {
+	t.lock.Lock()
+	delete(t.containers, imageName)
+	t.lock.Unlock()
+}
+
+// ContainerForImage returns the container running the given artifact image.
+func (t *ContainerTracker) ContainerForImage(imageName string) (Container, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	c, found := t.containers[imageName]
+	return c, found
+}
+
+// Containers returns the tracked containers, sorted by name.
+func (t *ContainerTracker) Containers() []Container {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var containers []Container
+	for _, c := range t.containers {
+		containers = append(containers, c)
+	}
+	sort.Slice(containers, func(i, j int) bool { return containers[i].Name < containers[j].Name })
+	return containers
+}
+
+// Register registers a channel to be notified of new containers.
+func (t *ContainerTracker) Register(l chan Container) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.listeners = append(t.listeners, &listener{events: l, done: make(chan struct{})})
+}
+
+// Deregister stops notifying the given channel of new containers,
+// including of the containers it wasn't notified of yet.
+// The channel receives no container once Deregister returns.
+func (t *ContainerTracker) Deregister(l chan Container) {
+	t.lock.Lock()
+	var deregistered *listener
+	for i, listener := range t.listeners {
+		if listener.events == l {
+			deregistered = listener
+			t.listeners = append(t.listeners[:i], t.listeners[i+1:]...)
+			break
+		}
+	}
+	t.lock.Unlock()
+
+	if deregistered != nil {
+		close(deregistered.done)
+		deregistered.sending.Wait()
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestContainerTracker(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tracker := NewContainerTracker()
+		events := make(chan Container)
+		tracker.Register(events)
+
+		web := Container{Name: "web", ID: "id1", Image: "web:1"}
+		tracker.Add("web", web)
+		t.CheckDeepEqual(web, <-events)
+
+		db := Container{Name: "db", ID: "id2", Image: "db:1"}
+		tracker.Add("db", db)
+		t.CheckDeepEqual(db, <-events)
+
+		t.CheckDeepEqual([]Container{db, web}, tracker.Containers())
+
+		c, found := tracker.ContainerForImage("web")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(web, c)
+
+		tracker.Remove("web")
+		_, found = tracker.ContainerForImage("web")
+		t.CheckFalse(found)
+		t.CheckDeepEqual([]Container{db}, tracker.Containers())
+
+		tracker.Deregister(events)
+		tracker.Add("web", web)
+		t.CheckDeepEqual([]Container{db, web}, tracker.Containers())
+		select {
+		case <-events:
+			t.Fatal("deregistered listener should not be notified")
+		default:
+		}
+	})
+}
+
+func TestDeregisterUnblocksNotifications(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tracker := NewContainerTracker()
+		events := make(chan Container)
+		tracker.Register(events)
+
+		// the listener stopped receiving
+		tracker.Add("web", Container{Name: "web", ID: "id1", Image: "web:1"})
+
+		done := make(chan struct{})
+		go func() {
+			tracker.Deregister(events)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("notification of a deregistered listener is still pending")
+		}
+		select {
+		case <-events:
+			t.Fatal("deregistered listener should not be notified")
+		default:
+		}
+	})
+}
@@ -145,6 +145,8 @@ func unknownErrForPhase(phase constants.Phase) proto.StatusCode {
 		return proto.StatusCode_INIT_UNKNOWN
 	case constants.Test:
 		return proto.StatusCode_TEST_UNKNOWN
+	case constants.Verify:
+		return proto.StatusCode_VERIFY_UNKNOWN
 	case constants.Deploy:
 		return proto.StatusCode_DEPLOY_UNKNOWN
 	case constants.StatusCheck:
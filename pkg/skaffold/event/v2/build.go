@@ -33,8 +33,15 @@ func CacheCheckInProgress(artifact string) {
 	buildSubtaskEvent(artifact, Cache, InProgress, nil)
 }
 
-func CacheCheckMiss(artifact string) {
-	buildSubtaskEvent(artifact, Cache, Failed, nil)
+func CacheCheckMiss(artifact, reason string) {
+	handler.handleBuildSubtaskEvent(&proto.BuildSubtaskEvent{
+		Id:       artifact,
+		TaskId:   fmt.Sprintf("%s-%d", constants.Build, handler.iteration),
+		Artifact: artifact,
+		Step:     Cache,
+		Status:   Failed,
+		Reason:   reason,
+	})
 }
 
 func CacheCheckHit(artifact string) {
@@ -349,10 +349,26 @@ func TaskSucceeded(task constants.Phase) {
 }
 
 // PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
-	event := proto.PortForwardEvent{
+func PortForwarded(localPort, reservedPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+	event := newPortForwardEvent(localPort, reservedPort, remotePort, podName, containerName, namespace, portName, resourceType, resourceName, address)
+	event.Status = Succeeded
+	handler.handlePortForwardEvent(event)
+}
+
+// PortForwardFailed notifies that a remote port couldn't be forwarded locally.
+func PortForwardFailed(localPort, reservedPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string, reason string, err error) {
+	event := newPortForwardEvent(localPort, reservedPort, remotePort, podName, containerName, namespace, portName, resourceType, resourceName, address)
+	event.Status = Failed
+	event.Reason = reason
+	event.ActionableErr = sErrors.ActionableErrV2(handler.cfg, constants.PortForward, err)
+	handler.handlePortForwardEvent(event)
+}
+
+func newPortForwardEvent(localPort, reservedPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) *proto.PortForwardEvent {
+	return &proto.PortForwardEvent{
 		TaskId:        fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration),
 		LocalPort:     localPort,
+		ReservedPort:  reservedPort,
 		PodName:       podName,
 		ContainerName: containerName,
 		Namespace:     namespace,
@@ -366,9 +382,12 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 			StrVal: remotePort.StrVal,
 		},
 	}
-	handler.handle(&proto.Event{
+}
+
+func (ev *eventHandler) handlePortForwardEvent(e *proto.PortForwardEvent) {
+	ev.handle(&proto.Event{
 		EventType: &proto.Event_PortEvent{
-			PortEvent: &event,
+			PortEvent: e,
 		},
 	})
 }
@@ -31,6 +31,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -289,6 +290,25 @@ func TestTaskFailed(t *testing.T) {
 	}
 }
 
+func TestPortForwardFailed(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latestV1.Pipeline{{}}, "test"))
+
+	PortForwarded(8080, 9000, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe != nil && pe.Status == Succeeded && pe.ReservedPort == 9000
+	})
+
+	PortForwardFailed(8080, 0, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "PortTaken", errors.New("port 8080 is taken"))
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe != nil && pe.Status == Failed && pe.Reason == "PortTaken" && pe.ActionableErr.GetMessage() == "port 8080 is taken"
+	})
+}
+
 func TestAutoTriggerDiff(t *testing.T) {
 	tests := []struct {
 		description  string
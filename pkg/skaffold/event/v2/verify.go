@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// VerifyInProgress adds an event to mark that a verify test case starts.
+func VerifyInProgress(id int, name string) {
+	handler.handleVerifySubtaskEvent(&proto.VerifySubtaskEvent{
+		Id:     strconv.Itoa(id),
+		TaskId: fmt.Sprintf("%s-%d", constants.Verify, handler.iteration),
+		Name:   name,
+		Status: InProgress,
+	})
+}
+
+// VerifyFailed adds an event to mark that a verify test case failed.
+func VerifyFailed(id int, name string, err error) {
+	handler.handleVerifySubtaskEvent(&proto.VerifySubtaskEvent{
+		Id:            strconv.Itoa(id),
+		TaskId:        fmt.Sprintf("%s-%d", constants.Verify, handler.iteration),
+		Name:          name,
+		Status:        Failed,
+		ActionableErr: sErrors.ActionableErrV2(handler.cfg, constants.Verify, err),
+	})
+}
+
+// VerifySucceeded adds an event to mark that a verify test case succeeded.
+func VerifySucceeded(id int, name string) {
+	handler.handleVerifySubtaskEvent(&proto.VerifySubtaskEvent{
+		Id:     strconv.Itoa(id),
+		TaskId: fmt.Sprintf("%s-%d", constants.Verify, handler.iteration),
+		Name:   name,
+		Status: Succeeded,
+	})
+}
+
+func (ev *eventHandler) handleVerifySubtaskEvent(e *proto.VerifySubtaskEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_VerifyEvent{
+			VerifyEvent: e,
+		},
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"errors"
+	"testing"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func TestVerifyEvents(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latestV1.Pipeline{{}}, "test"))
+
+	VerifyInProgress(0, "smoke")
+	VerifySucceeded(0, "smoke")
+	VerifyInProgress(1, "e2e")
+	VerifyFailed(1, "e2e", errors.New("exit status 1"))
+
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+
+		var statuses []string
+		for _, ev := range handler.eventLog {
+			if ve := ev.GetVerifyEvent(); ve != nil {
+				statuses = append(statuses, ve.Id+" "+ve.Name+" "+ve.Status)
+				if ve.Status == Failed && ve.ActionableErr.ErrCode != proto.StatusCode_VERIFY_UNKNOWN {
+					return false
+				}
+			}
+		}
+		return len(statuses) == 4 &&
+			statuses[0] == "0 smoke InProgress" &&
+			statuses[1] == "0 smoke Succeeded" &&
+			statuses[2] == "1 e2e InProgress" &&
+			statuses[3] == "1 e2e Failed"
+	})
+}
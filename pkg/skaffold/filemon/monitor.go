@@ -16,16 +16,23 @@ limitations under the License.
 
 package filemon
 
+import (
+	"path/filepath"
+	"sort"
+)
+
 // Monitor monitors files changes for multiples components.
 type Monitor interface {
 	Register(deps func() ([]string, error), onChange func(Events)) error
 	Run(debounce bool) error
 	Reset()
+	MarkChanged(paths []string)
 }
 
 type watchList struct {
 	changedComponents map[int]bool
 	components        []*component
+	forced            map[string]bool
 }
 
 // NewMonitor creates a new Monitor.
@@ -61,6 +68,17 @@ func (w *watchList) Reset() {
 	w.changedComponents = map[int]bool{}
 }
 
+// MarkChanged reports files as modified on the next Run, even if
+// their modification time didn't change.
+func (w *watchList) MarkChanged(paths []string) {
+	if w.forced == nil {
+		w.forced = map[string]bool{}
+	}
+	for _, path := range paths {
+		w.forced[absPath(path)] = true
+	}
+}
+
 // Run watches files until the context is cancelled or an error occurs.
 func (w *watchList) Run(debounce bool) error {
 	changed := 0
@@ -70,6 +88,7 @@ func (w *watchList) Run(debounce bool) error {
 			return err
 		}
 		e := events(component.state, state)
+		w.addForced(&e, state)
 
 		if e.HasChanged() {
 			w.changedComponents[i] = true
@@ -78,6 +97,7 @@ func (w *watchList) Run(debounce bool) error {
 			changed++
 		}
 	}
+	w.forced = nil
 
 	// Rapid file changes that are more frequent than the poll interval would trigger
 	// multiple rebuilds.
@@ -93,3 +113,33 @@ func (w *watchList) Run(debounce bool) error {
 	}
 	return nil
 }
+
+// addForced adds the files marked as changed that are dependencies
+// of a component to its modified files.
+func (w *watchList) addForced(e *Events, state FileMap) {
+	if len(w.forced) == 0 {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, path := range e.Added {
+		seen[path] = true
+	}
+	for _, path := range e.Modified {
+		seen[path] = true
+	}
+	for path := range state {
+		if w.forced[absPath(path)] && !seen[path] {
+			e.Modified = append(e.Modified, path)
+		}
+	}
+	sort.Strings(e.Modified)
+}
+
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	return abs
+}
@@ -74,6 +74,37 @@ func TestFileMonitor(t *testing.T) {
 	}
 }
 
+func TestFileMonitorMarkChanged(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("file", "other")
+		t.Chdir(tmpDir.Root())
+
+		monitor := NewMonitor()
+
+		changed := callback{}
+		err := monitor.Register(func() ([]string, error) { return []string{"file", "other"}, nil }, changed.call)
+		t.CheckNoError(err)
+
+		// Paths that aren't dependencies are ignored
+		monitor.MarkChanged([]string{"unknown"})
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, changed.calls())
+
+		// Files marked as changed are reported as modified
+		monitor.MarkChanged([]string{tmpDir.Path("file")})
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, changed.calls())
+		t.CheckDeepEqual([]string{"file"}, changed.events[0].Modified)
+
+		// Marks are consumed by a single run
+		err = monitor.Run(false)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, changed.calls())
+	})
+}
+
 type callback struct {
 	events []Events
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// NewCleanupRunner creates a new runner for pre-cleanup and post-cleanup lifecycle hooks.
+// Post-cleanup hooks are also run when the cleanup fails.
+func NewCleanupRunner(cli *kubectl.CLI, d v1.CleanupHooks, opts CleanupEnvOpts) PhaseRunner {
+	convert := func(items []v1.CleanupHookItem) []phaseHookItem {
+		var hooks []phaseHookItem
+		for _, item := range items {
+			hooks = append(hooks, phaseHookItem{hostHook: item.HostHook, containerHook: item.ContainerHook})
+		}
+		return hooks
+	}
+
+	return phaseRunner{
+		cli:       cli,
+		preHooks:  convert(d.PreHooks),
+		postHooks: convert(d.PostHooks),
+		prePhase:  phases.PreCleanup,
+		postPhase: phases.PostCleanup,
+		env: func(result *string) []string {
+			opts.CleanupResult = result
+			return append(getEnv(staticEnvOpts), getEnv(opts)...)
+		},
+	}
+}
+
+// NewCleanupEnvOpts returns `CleanupEnvOpts` required to create a `PhaseRunner` for cleanup lifecycle hooks
+func NewCleanupEnvOpts(runID string, kubeContext string, namespace string) CleanupEnvOpts {
+	return CleanupEnvOpts{
+		RunID:       runID,
+		KubeContext: kubeContext,
+		Namespace:   namespace,
+	}
+}
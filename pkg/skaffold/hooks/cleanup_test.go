@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCleanupHooks(t *testing.T) {
+	hooks := v1.CleanupHooks{
+		PreHooks: []v1.CleanupHookItem{
+			{
+				ContainerHook: &v1.NamedContainerHook{
+					ContainerHook: v1.ContainerHook{Command: []string{"dump-logs"}},
+					PodName:       "app-0",
+				},
+			},
+		},
+		PostHooks: []v1.CleanupHookItem{
+			{
+				HostHook: &v1.HostHook{
+					OS:      []string{"linux", "darwin"},
+					Command: []string{"sh", "-c", "echo post-hook running with SKAFFOLD_RUN_ID=$SKAFFOLD_RUN_ID,SKAFFOLD_KUBE_CONTEXT=$SKAFFOLD_KUBE_CONTEXT,SKAFFOLD_NAMESPACE=$SKAFFOLD_NAMESPACE,SKAFFOLD_CLEANUP_RESULT=$SKAFFOLD_CLEANUP_RESULT"},
+				},
+			},
+			{
+				HostHook: &v1.HostHook{
+					OS:      []string{"windows"},
+					Command: []string{"cmd.exe", "/C", "echo post-hook running with SKAFFOLD_RUN_ID=%SKAFFOLD_RUN_ID%,SKAFFOLD_KUBE_CONTEXT=%SKAFFOLD_KUBE_CONTEXT%,SKAFFOLD_NAMESPACE=%SKAFFOLD_NAMESPACE%,SKAFFOLD_CLEANUP_RESULT=%SKAFFOLD_CLEANUP_RESULT%"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		description     string
+		cleanupErr      error
+		containerErr    error
+		expectedPostOut string
+		shouldErr       bool
+	}{
+		{
+			description:     "cleanup succeeded",
+			expectedPostOut: "post-hook running with SKAFFOLD_RUN_ID=run1,SKAFFOLD_KUBE_CONTEXT=context1,SKAFFOLD_NAMESPACE=np1,SKAFFOLD_CLEANUP_RESULT=succeeded",
+		},
+		{
+			description:     "cleanup failed",
+			cleanupErr:      errors.New("delete failed"),
+			expectedPostOut: "post-hook running with SKAFFOLD_RUN_ID=run1,SKAFFOLD_KUBE_CONTEXT=context1,SKAFFOLD_NAMESPACE=np1,SKAFFOLD_CLEANUP_RESULT=failed",
+		},
+		{
+			description:  "container hook failed",
+			containerErr: errors.New("pod not found"),
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunErr("kubectl --context context1 --namespace np1 exec app-0 -- dump-logs", test.containerErr))
+			runner := NewCleanupRunner(&kubectl.CLI{KubeContext: "context1", Namespace: "np1"}, hooks, NewCleanupEnvOpts("run1", "context1", "np1"))
+
+			var preOut, postOut bytes.Buffer
+			err := runner.RunPreHooks(context.Background(), &preOut)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckContains("failed to execute container pre-cleanup hook 1", err.Error())
+				return
+			}
+
+			err = runner.RunPostHooks(context.Background(), &postOut, test.cleanupErr)
+			t.CheckNoError(err)
+			t.CheckContains(test.expectedPostOut, postOut.String())
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -99,3 +100,49 @@ func (h containerHook) run(ctx context.Context, out io.Writer) error {
 	}
 	return errs.Wait()
 }
+
+// dockerContainerHook represents a lifecycle hook to be executed inside running docker containers
+type dockerContainerHook struct {
+	cfg          latestV1.ContainerHook
+	client       docker.LocalDaemon
+	containerIDs []string
+}
+
+// run executes the lifecycle hook inside the target containers
+func (h dockerContainerHook) run(ctx context.Context, out io.Writer) error {
+	errs, ctx := errgroup.WithContext(ctx)
+
+	for _, id := range h.containerIDs {
+		id := id
+		errs.Go(func() error {
+			if err := h.client.ContainerExec(ctx, out, id, h.cfg.Command); err != nil {
+				return fmt.Errorf("hook execution failed for container %q: %w", id, err)
+			}
+			return nil
+		})
+	}
+	return errs.Wait()
+}
+
+// namedContainerHook represents a lifecycle hook to be executed inside a named container of a pod
+type namedContainerHook struct {
+	cfg latestV1.NamedContainerHook
+	cli *kubectl.CLI
+}
+
+// run executes the lifecycle hook inside the target container
+func (h namedContainerHook) run(ctx context.Context, out io.Writer) error {
+	args := []string{h.cfg.PodName}
+	if h.cfg.ContainerName != "" {
+		args = append(args, "-c", h.cfg.ContainerName)
+	}
+	args = append(args, "--")
+	args = append(args, h.cfg.Command...)
+	cmd := h.cli.Command(ctx, "exec", args...)
+	cmd.Stderr = out
+	cmd.Stdout = out
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("hook execution failed for pod %q container %q: %w", h.cfg.PodName, h.cfg.ContainerName, err)
+	}
+	return nil
+}
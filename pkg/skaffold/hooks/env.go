@@ -59,6 +59,24 @@ type DeployEnvOpts struct {
 	Namespaces  string
 }
 
+// TestEnvOpts contains the environment variables to be set in a test type lifecycle hook executor.
+type TestEnvOpts struct {
+	TestImage   string
+	TestContext string
+	KubeContext string
+	// TestResult is only set for post-test hooks.
+	TestResult *string
+}
+
+// CleanupEnvOpts contains the environment variables to be set in a cleanup type lifecycle hook executor.
+type CleanupEnvOpts struct {
+	RunID       string
+	KubeContext string
+	Namespace   string
+	// CleanupResult is only set for post-cleanup hooks.
+	CleanupResult *string
+}
+
 type Config interface {
 	DefaultRepo() *string
 	GetWorkingDir() string
@@ -75,7 +93,7 @@ func SetupStaticEnvOptions(cfg Config) {
 	}
 }
 
-// getEnv converts the fields of BuildEnvOpts, SyncEnvOpts, DeployEnvOpts and CommonEnvOpts structs to a `key=value` environment variables slice.
+// getEnv converts the fields of BuildEnvOpts, SyncEnvOpts, DeployEnvOpts, TestEnvOpts, CleanupEnvOpts and CommonEnvOpts structs to a `key=value` environment variables slice.
 // Each field name is converted from CamelCase to SCREAMING_SNAKE_CASE and prefixed with `SKAFFOLD`.
 // For example the field `KubeContext` with value `kind` becomes `SKAFFOLD_KUBE_CONTEXT=kind`
 func getEnv(optsStruct interface{}) []string {
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const (
+	resultSucceeded = "succeeded"
+	resultFailed    = "failed"
+)
+
+// phaseHookItem is a host hook or a named container hook of a phase.
+type phaseHookItem struct {
+	hostHook      *v1.HostHook
+	containerHook *v1.NamedContainerHook
+}
+
+// phaseRunner runs the host and named container hooks around a phase, and gives the result of the phase to the post-step hooks.
+type phaseRunner struct {
+	cli       *kubectl.CLI
+	preHooks  []phaseHookItem
+	postHooks []phaseHookItem
+	prePhase  phase
+	postPhase phase
+	// target describes what the phase is run for, e.g. `for artifact "img"`. Can be empty.
+	target string
+	// env returns the environment variables of the hooks, given the result of the phase for post-step hooks.
+	env func(result *string) []string
+}
+
+func (r phaseRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.preHooks, r.prePhase, r.env(nil))
+}
+
+func (r phaseRunner) RunPostHooks(ctx context.Context, out io.Writer, phaseErr error) error {
+	result := resultSucceeded
+	if phaseErr != nil {
+		result = resultFailed
+	}
+	return r.run(ctx, out, r.postHooks, r.postPhase, r.env(&result))
+}
+
+func (r phaseRunner) run(ctx context.Context, out io.Writer, hooks []phaseHookItem, phase phase, env []string) error {
+	target := ""
+	if r.target != "" {
+		target = " " + r.target
+	}
+	if len(hooks) > 0 {
+		output.Default.Fprintf(out, "Starting %s hooks%s...\n", phase, target)
+	}
+	for i, h := range hooks {
+		if h.hostHook != nil {
+			hook := hostHook{*h.hostHook, env}
+			if err := hook.run(ctx, out); err != nil {
+				return fmt.Errorf("failed to execute host %s hook %d%s: %w", phase, i+1, target, err)
+			}
+		} else if h.containerHook != nil {
+			hook := namedContainerHook{*h.containerHook, r.cli}
+			if err := hook.run(ctx, out); err != nil {
+				return fmt.Errorf("failed to execute container %s hook %d%s: %w", phase, i+1, target, err)
+			}
+		}
+	}
+	if len(hooks) > 0 {
+		output.Default.Fprintf(out, "Completed %s hooks%s\n", phase, target)
+	}
+	return nil
+}
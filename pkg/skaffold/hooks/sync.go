@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -30,8 +31,14 @@ import (
 )
 
 func NewSyncRunner(cli *kubectl.CLI, imageName, imageRef string, namespaces []string, d v1.SyncHooks, opts SyncEnvOpts) Runner {
-	return syncRunner{d, cli, imageName, imageRef, namespaces, opts}
+	return syncRunner{SyncHooks: d, cli: cli, imageName: imageName, imageRef: imageRef, namespaces: namespaces, opts: opts}
 }
+
+// NewDockerSyncRunner returns a sync hooks runner that executes the container hooks in the given docker containers.
+func NewDockerSyncRunner(client docker.LocalDaemon, imageName string, containerIDs []string, d v1.SyncHooks, opts SyncEnvOpts) Runner {
+	return syncRunner{SyncHooks: d, imageName: imageName, client: client, containerIDs: containerIDs, opts: opts}
+}
+
 func NewSyncEnvOpts(a *v1.Artifact, image string, addOrModifyFiles []string, deleteFiles []string, namespaces []string, kubeContext string) (SyncEnvOpts, error) {
 	workDir, err := filepath.Abs(a.Workspace)
 	if err != nil {
@@ -54,6 +61,10 @@ type syncRunner struct {
 	imageRef   string
 	namespaces []string
 	opts       SyncEnvOpts
+
+	// client and containerIDs are set when the container hooks run in docker containers instead of pods
+	client       docker.LocalDaemon
+	containerIDs []string
 }
 
 func (r syncRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
@@ -82,13 +93,7 @@ func (r syncRunner) run(ctx context.Context, out io.Writer, hooks []v1.SyncHookI
 				return fmt.Errorf("failed to execute host %s hook %d for artifact %q: %w", phase, i+1, r.imageName, err)
 			}
 		} else if h.ContainerHook != nil {
-			hook := containerHook{
-				cfg:        *h.ContainerHook,
-				cli:        r.cli,
-				selector:   runningImageSelector(r.imageRef),
-				namespaces: r.namespaces,
-			}
-			if err := hook.run(ctx, out); err != nil {
+			if err := r.containerHook(*h.ContainerHook).run(ctx, out); err != nil {
 				return fmt.Errorf("failed to execute container %s hook %d for artifact %q: %w", phase, i+1, r.imageName, err)
 			}
 		}
@@ -98,3 +103,20 @@ func (r syncRunner) run(ctx context.Context, out io.Writer, hooks []v1.SyncHookI
 	}
 	return nil
 }
+
+// containerHook returns the hook running the given command in the containers of the synced image.
+func (r syncRunner) containerHook(cfg v1.ContainerHook) hook {
+	if r.client != nil {
+		return dockerContainerHook{
+			cfg:          cfg,
+			client:       r.client,
+			containerIDs: r.containerIDs,
+		}
+	}
+	return containerHook{
+		cfg:        cfg,
+		cli:        r.cli,
+		selector:   runningImageSelector(r.imageRef),
+		namespaces: r.namespaces,
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// NewTestRunner creates a new runner for pre-test and post-test lifecycle hooks.
+// Post-test hooks are also run when the tests fail.
+func NewTestRunner(cli *kubectl.CLI, imageName string, d v1.TestHooks, opts TestEnvOpts) PhaseRunner {
+	convert := func(items []v1.TestHookItem) []phaseHookItem {
+		var hooks []phaseHookItem
+		for _, item := range items {
+			hooks = append(hooks, phaseHookItem{hostHook: item.HostHook, containerHook: item.ContainerHook})
+		}
+		return hooks
+	}
+
+	return phaseRunner{
+		cli:       cli,
+		preHooks:  convert(d.PreHooks),
+		postHooks: convert(d.PostHooks),
+		prePhase:  phases.PreTest,
+		postPhase: phases.PostTest,
+		target:    fmt.Sprintf("for artifact %q", imageName),
+		env: func(result *string) []string {
+			opts.TestResult = result
+			return append(getEnv(staticEnvOpts), getEnv(opts)...)
+		},
+	}
+}
+
+// NewTestEnvOpts returns `TestEnvOpts` required to create a `PhaseRunner` for test lifecycle hooks
+func NewTestEnvOpts(tc *v1.TestCase, image string, kubeContext string) (TestEnvOpts, error) {
+	w, err := filepath.Abs(tc.Workspace)
+	if err != nil {
+		return TestEnvOpts{}, fmt.Errorf("determining test workspace directory for image %v: %w", tc.ImageName, err)
+	}
+	return TestEnvOpts{
+		TestImage:   image,
+		TestContext: w,
+		KubeContext: kubeContext,
+	}, nil
+}
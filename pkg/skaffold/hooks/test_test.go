@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTestHooks(t *testing.T) {
+	testutil.Run(t, "TestTestHooks", func(t *testutil.T) {
+		workDir, _ := filepath.Abs("./foo")
+		hooks := v1.TestHooks{
+			PreHooks: []v1.TestHookItem{
+				{
+					HostHook: &v1.HostHook{
+						OS:      []string{"linux", "darwin"},
+						Command: []string{"sh", "-c", "echo pre-hook running with SKAFFOLD_TEST_IMAGE=$SKAFFOLD_TEST_IMAGE,SKAFFOLD_TEST_CONTEXT=$SKAFFOLD_TEST_CONTEXT,SKAFFOLD_KUBE_CONTEXT=$SKAFFOLD_KUBE_CONTEXT,SKAFFOLD_TEST_RESULT=$SKAFFOLD_TEST_RESULT"},
+					},
+				},
+				{
+					HostHook: &v1.HostHook{
+						OS:      []string{"windows"},
+						Command: []string{"cmd.exe", "/C", "echo pre-hook running with SKAFFOLD_TEST_IMAGE=%SKAFFOLD_TEST_IMAGE%,SKAFFOLD_TEST_CONTEXT=%SKAFFOLD_TEST_CONTEXT%,SKAFFOLD_KUBE_CONTEXT=%SKAFFOLD_KUBE_CONTEXT%,SKAFFOLD_TEST_RESULT="},
+					},
+				},
+				{
+					ContainerHook: &v1.NamedContainerHook{
+						ContainerHook: v1.ContainerHook{Command: []string{"seed-db"}},
+						PodName:       "db-0",
+						ContainerName: "postgres",
+					},
+				},
+			},
+			PostHooks: []v1.TestHookItem{
+				{
+					HostHook: &v1.HostHook{
+						OS:      []string{"linux", "darwin"},
+						Command: []string{"sh", "-c", "echo post-hook running with SKAFFOLD_TEST_IMAGE=$SKAFFOLD_TEST_IMAGE,SKAFFOLD_TEST_RESULT=$SKAFFOLD_TEST_RESULT"},
+					},
+				},
+				{
+					HostHook: &v1.HostHook{
+						OS:      []string{"windows"},
+						Command: []string{"cmd.exe", "/C", "echo post-hook running with SKAFFOLD_TEST_IMAGE=%SKAFFOLD_TEST_IMAGE%,SKAFFOLD_TEST_RESULT=%SKAFFOLD_TEST_RESULT%"},
+					},
+				},
+				{
+					ContainerHook: &v1.NamedContainerHook{
+						ContainerHook: v1.ContainerHook{Command: []string{"drop-db"}},
+						PodName:       "db-0",
+					},
+				},
+			},
+		}
+		preHostHookOut := fmt.Sprintf("pre-hook running with SKAFFOLD_TEST_IMAGE=gcr.io/foo/img1:latest,SKAFFOLD_TEST_CONTEXT=%s,SKAFFOLD_KUBE_CONTEXT=context1,SKAFFOLD_TEST_RESULT=\n", workDir)
+		preContainerHookOut := "seeded"
+		postHostHookOut := "post-hook running with SKAFFOLD_TEST_IMAGE=gcr.io/foo/img1:latest,SKAFFOLD_TEST_RESULT=failed"
+		postContainerHookOut := "dropped"
+
+		tc := &v1.TestCase{ImageName: "img1", Workspace: workDir, LifecycleHooks: hooks}
+		opts, err := NewTestEnvOpts(tc, "gcr.io/foo/img1:latest", "context1")
+		t.CheckNoError(err)
+		runner := NewTestRunner(&kubectl.CLI{KubeContext: "context1", Namespace: "np1"}, tc.ImageName, tc.LifecycleHooks, opts)
+
+		t.Override(&util.DefaultExecCommand,
+			testutil.CmdRunWithOutput("kubectl --context context1 --namespace np1 exec db-0 -c postgres -- seed-db", preContainerHookOut).
+				AndRunWithOutput("kubectl --context context1 --namespace np1 exec db-0 -- drop-db", postContainerHookOut))
+		var preOut, postOut bytes.Buffer
+		err = runner.RunPreHooks(context.Background(), &preOut)
+		t.CheckNoError(err)
+		t.CheckContains(preHostHookOut, preOut.String())
+		t.CheckContains(preContainerHookOut, preOut.String())
+		err = runner.RunPostHooks(context.Background(), &postOut, errors.New("test failed"))
+		t.CheckNoError(err)
+		t.CheckContains(postHostHookOut, postOut.String())
+		t.CheckContains(postContainerHookOut, postOut.String())
+	})
+}
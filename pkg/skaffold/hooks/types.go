@@ -29,20 +29,41 @@ type Runner interface {
 	RunPostHooks(ctx context.Context, out io.Writer) error
 }
 
+// PhaseRunner represents a lifecycle hooks runner for a phase whose post-step hooks are given the result of the phase
+type PhaseRunner interface {
+	// RunPreHooks executes all pre-step hooks defined by the `PhaseRunner`
+	RunPreHooks(ctx context.Context, out io.Writer) error
+	// RunPostHooks executes all post-step hooks defined by the `PhaseRunner`, given the error returned by the phase, if any
+	RunPostHooks(ctx context.Context, out io.Writer, phaseErr error) error
+}
+
+// hook represents a single lifecycle hook
+type hook interface {
+	run(ctx context.Context, out io.Writer) error
+}
+
 type phase string
 
 var phases = struct {
-	PreBuild   phase
-	PostBuild  phase
-	PreSync    phase
-	PostSync   phase
-	PreDeploy  phase
-	PostDeploy phase
+	PreBuild    phase
+	PostBuild   phase
+	PreSync     phase
+	PostSync    phase
+	PreDeploy   phase
+	PostDeploy  phase
+	PreTest     phase
+	PostTest    phase
+	PreCleanup  phase
+	PostCleanup phase
 }{
-	PreBuild:   "pre-build",
-	PostBuild:  "post-build",
-	PreSync:    "pre-sync",
-	PostSync:   "post-sync",
-	PreDeploy:  "pre-deploy",
-	PostDeploy: "post-deploy",
+	PreBuild:    "pre-build",
+	PostBuild:   "post-build",
+	PreSync:     "pre-sync",
+	PostSync:    "post-sync",
+	PreDeploy:   "pre-deploy",
+	PostDeploy:  "post-deploy",
+	PreTest:     "pre-test",
+	PostTest:    "post-test",
+	PreCleanup:  "pre-cleanup",
+	PostCleanup: "post-cleanup",
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/deploy"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	tag "github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
@@ -47,6 +48,7 @@ func generateSkaffoldConfig(b build.Initializer, d deploy.Initializer) *latestV1
 
 	deploy, profiles := d.DeployConfig()
 	build, portForward := b.BuildConfig()
+	removeUnbuiltArtifactOverrides(deploy.HelmDeploy, build.Artifacts)
 
 	return &latestV1.SkaffoldConfig{
 		APIVersion: latestV1.Version,
@@ -63,6 +65,34 @@ func generateSkaffoldConfig(b build.Initializer, d deploy.Initializer) *latestV1
 	}
 }
 
+// removeUnbuiltArtifactOverrides removes the helm artifact overrides of images that skaffold doesn't build,
+// so that the images set in the charts' values are deployed as is.
+func removeUnbuiltArtifactOverrides(helm *latestV1.HelmDeploy, artifacts []*latestV1.Artifact) {
+	if helm == nil {
+		return
+	}
+
+	built := map[string]bool{}
+	for _, a := range artifacts {
+		built[tag.StripTag(a.ImageName, true)] = true
+	}
+	for i := range helm.Releases {
+		r := &helm.Releases[i]
+		for key, image := range r.ArtifactOverrides {
+			image = tag.StripTag(image, true)
+			if built[image] {
+				r.ArtifactOverrides[key] = image
+			} else {
+				delete(r.ArtifactOverrides, key)
+			}
+		}
+		if len(r.ArtifactOverrides) == 0 {
+			r.ArtifactOverrides = nil
+			r.ImageStrategy = latestV1.HelmImageStrategy{}
+		}
+	}
+}
+
 func suggestConfigName() (string, error) {
 	cwd, err := getWd()
 	if err != nil {
@@ -130,6 +130,104 @@ func TestGenerateSkaffoldConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "helm overrides of unbuilt images are removed",
+			builderConfigInfos: []build.ArtifactInfo{
+				{
+					Builder:   docker.ArtifactConfig{File: "Dockerfile"},
+					ImageName: "image1",
+				},
+			},
+			deployConfig: latestV1.DeployConfig{
+				DeployType: latestV1.DeployType{
+					HelmDeploy: &latestV1.HelmDeploy{
+						Releases: []latestV1.HelmRelease{
+							{Name: "app", ChartPath: "app", ArtifactOverrides: map[string]string{"image": "image1", "redis.image": "redis"}},
+							{Name: "db", ChartPath: "db", ArtifactOverrides: map[string]string{"image": "postgres"}, ImageStrategy: latestV1.HelmImageStrategy{
+								HelmImageConfig: latestV1.HelmImageConfig{HelmConventionConfig: &latestV1.HelmConventionConfig{}},
+							}},
+						},
+					},
+				},
+			},
+			getWd: func() (s string, err error) {
+				return filepath.Join("rootDir", "testConfig"), nil
+			},
+			expectedSkaffoldConfig: &latestV1.SkaffoldConfig{
+				APIVersion: latestV1.Version,
+				Kind:       "Config",
+				Metadata:   latestV1.Metadata{Name: "testconfig"},
+				Pipeline: latestV1.Pipeline{
+					Build: latestV1.BuildConfig{
+						Artifacts: []*latestV1.Artifact{
+							{
+								ImageName: "image1",
+								ArtifactType: latestV1.ArtifactType{
+									DockerArtifact: &latestV1.DockerArtifact{DockerfilePath: "Dockerfile"},
+								},
+							},
+						},
+					},
+					Deploy: latestV1.DeployConfig{
+						DeployType: latestV1.DeployType{
+							HelmDeploy: &latestV1.HelmDeploy{
+								Releases: []latestV1.HelmRelease{
+									{Name: "app", ChartPath: "app", ArtifactOverrides: map[string]string{"image": "image1"}},
+									{Name: "db", ChartPath: "db"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tagged helm overrides of built images are kept",
+			builderConfigInfos: []build.ArtifactInfo{
+				{
+					Builder:   docker.ArtifactConfig{File: "Dockerfile"},
+					ImageName: "gcr.io/k8s-skaffold/app",
+				},
+			},
+			deployConfig: latestV1.DeployConfig{
+				DeployType: latestV1.DeployType{
+					HelmDeploy: &latestV1.HelmDeploy{
+						Releases: []latestV1.HelmRelease{
+							{Name: "app", ChartPath: "app", ArtifactOverrides: map[string]string{"image": "gcr.io/k8s-skaffold/app:v1", "redis.image": "redis:6"}},
+						},
+					},
+				},
+			},
+			getWd: func() (s string, err error) {
+				return filepath.Join("rootDir", "testConfig"), nil
+			},
+			expectedSkaffoldConfig: &latestV1.SkaffoldConfig{
+				APIVersion: latestV1.Version,
+				Kind:       "Config",
+				Metadata:   latestV1.Metadata{Name: "testconfig"},
+				Pipeline: latestV1.Pipeline{
+					Build: latestV1.BuildConfig{
+						Artifacts: []*latestV1.Artifact{
+							{
+								ImageName: "gcr.io/k8s-skaffold/app",
+								ArtifactType: latestV1.ArtifactType{
+									DockerArtifact: &latestV1.DockerArtifact{DockerfilePath: "Dockerfile"},
+								},
+							},
+						},
+					},
+					Deploy: latestV1.DeployConfig{
+						DeployType: latestV1.DeployType{
+							HelmDeploy: &latestV1.HelmDeploy{
+								Releases: []latestV1.HelmRelease{
+									{Name: "app", ChartPath: "app", ArtifactOverrides: map[string]string{"image": "gcr.io/k8s-skaffold/app"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:               "error working dir",
 			builderConfigInfos: []build.ArtifactInfo{},
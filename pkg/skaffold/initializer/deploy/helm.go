@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/prompt"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	tag "github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// helm implements deploymentInitializer for the helm deployer.
+type helm struct {
+	releases []latestV1.HelmRelease
+	images   []string // the images parsed from the charts' values files
+}
+
+// newHelmInitializer returns a helm config generator for the given Chart.yaml files.
+// Unless `force` is set, the user is asked which charts to deploy when several are found.
+func newHelmInitializer(chartFiles []string, force bool) (*helm, error) {
+	var allChartPaths, chartPaths []string
+	for _, file := range chartFiles {
+		allChartPaths = append(allChartPaths, filepath.Dir(file))
+	}
+	for _, chartPath := range allChartPaths {
+		// subcharts are deployed with their parent chart
+		if isSubchart(chartPath, allChartPaths) {
+			continue
+		}
+		chartPaths = append(chartPaths, chartPath)
+	}
+
+	if len(chartPaths) > 1 && !force {
+		chosen, err := prompt.ChooseChartsFunc(chartPaths)
+		if err != nil {
+			return nil, err
+		}
+		chartPaths = chosen
+	}
+
+	h := &helm{}
+	for _, chartPath := range chartPaths {
+		release, images := helmRelease(chartPath)
+		h.releases = append(h.releases, release)
+		h.images = append(h.images, images...)
+	}
+	return h, nil
+}
+
+// isSubchart returns true if the chart is in the directory of another chart.
+func isSubchart(chartPath string, chartPaths []string) bool {
+	for _, parent := range chartPaths {
+		if parent == chartPath {
+			continue
+		}
+		rel, err := filepath.Rel(parent, chartPath)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// helmRelease generates the release of a chart, setting its images
+// with `artifactOverrides` based on the chart's values file.
+func helmRelease(chartPath string) (latestV1.HelmRelease, []string) {
+	release := latestV1.HelmRelease{
+		Name:      releaseName(chartPath),
+		ChartPath: chartPath,
+	}
+
+	values, err := readYaml(filepath.Join(chartPath, "values.yaml"))
+	if err != nil {
+		logrus.Debugf("unable to read values of chart %s: %v", chartPath, err)
+		return release, nil
+	}
+
+	fqn, convention := map[string]string{}, map[string]string{}
+	parseImagesFromValues("", values, fqn, convention)
+
+	// all the images of a release must follow the same image strategy
+	overrides := fqn
+	if len(fqn) == 0 && len(convention) > 0 {
+		overrides = convention
+		release.ImageStrategy = latestV1.HelmImageStrategy{
+			HelmImageConfig: latestV1.HelmImageConfig{
+				HelmConventionConfig: &latestV1.HelmConventionConfig{},
+			},
+		}
+	} else if len(convention) > 0 {
+		logrus.Warnf("images of chart %s set with both an image name and a repository, ignoring the repository ones", chartPath)
+	}
+	if len(overrides) == 0 {
+		return release, nil
+	}
+
+	var images []string
+	release.ArtifactOverrides = util.FlatMap{}
+	for key, image := range overrides {
+		release.ArtifactOverrides[key] = image
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return release, images
+}
+
+// releaseName returns the name of the chart, defaulting to the name of its directory.
+func releaseName(chartPath string) string {
+	chart, err := readYaml(filepath.Join(chartPath, "Chart.yaml"))
+	if err == nil {
+		if name, ok := chart["name"].(string); ok && name != "" {
+			return name
+		}
+	}
+	return filepath.Base(chartPath)
+}
+
+func readYaml(file string) (map[string]interface{}, error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(buf, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// parseImagesFromValues finds the `image` values of a values file.
+// Images given as a full name, e.g. `image: gcr.io/app:v1`, are added to `fqn` without their tag.
+// Images following the helm convention, e.g. `image: {repository: gcr.io/app, tag: v1}`, are added to `convention`.
+func parseImagesFromValues(prefix string, values map[string]interface{}, fqn, convention map[string]string) {
+	for key, value := range values {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case string:
+			if key == "image" && v != "" {
+				// artifacts are named after untagged images
+				fqn[path] = tag.StripTag(v, true)
+			}
+		case map[string]interface{}:
+			if repository, ok := v["repository"].(string); key == "image" && ok && repository != "" {
+				convention[path] = repository
+				continue
+			}
+			parseImagesFromValues(path, v, fqn, convention)
+		}
+	}
+}
+
+// DeployConfig implements the Initializer interface and generates
+// a helm deployment config with a release for each chart.
+func (h *helm) DeployConfig() (latestV1.DeployConfig, []latestV1.Profile) {
+	return latestV1.DeployConfig{
+		DeployType: latestV1.DeployType{
+			HelmDeploy: &latestV1.HelmDeploy{
+				Releases: h.releases,
+			},
+		},
+	}, nil
+}
+
+// GetImages implements the Initializer interface and lists all the
+// images present in the charts' values files.
+func (h *helm) GetImages() []string {
+	return h.images
+}
+
+// Validate implements the Initializer interface and ensures
+// we have at least one chart before generating a config
+func (h *helm) Validate() error {
+	if len(h.releases) == 0 {
+		return errors.NoHelmChartErr{}
+	}
+	return nil
+}
+
+// we don't generate k8s manifests for a helm deploy
+func (h *helm) AddManifestForImage(string, string) {}
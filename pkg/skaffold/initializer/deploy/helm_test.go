@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	initerrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/prompt"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var helmConvention = latestV1.HelmImageStrategy{
+	HelmImageConfig: latestV1.HelmImageConfig{
+		HelmConventionConfig: &latestV1.HelmConventionConfig{},
+	},
+}
+
+func TestGenerateHelmPipeline(t *testing.T) {
+	tests := []struct {
+		description      string
+		files            map[string]string
+		chartFiles       []string
+		force            bool
+		chosenCharts     []string
+		expectedReleases []latestV1.HelmRelease
+		expectedImages   []string
+		shouldErr        bool
+	}{
+		{
+			description: "image names",
+			files: map[string]string{
+				"app/Chart.yaml": "name: my-app",
+				"app/values.yaml": `image: gcr.io/k8s-skaffold/app
+replicaCount: 1
+worker:
+  image: worker:v1
+  args: [run]
+`,
+			},
+			chartFiles: []string{"app/Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{
+				Name:              "my-app",
+				ChartPath:         "app",
+				ArtifactOverrides: map[string]string{"image": "gcr.io/k8s-skaffold/app", "worker.image": "worker"},
+			}},
+			expectedImages: []string{"gcr.io/k8s-skaffold/app", "worker"},
+		},
+		{
+			description: "helm image convention",
+			files: map[string]string{
+				"Chart.yaml": "name: app",
+				"values.yaml": `image:
+  repository: app
+  tag: latest
+`,
+			},
+			chartFiles: []string{"Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{
+				Name:              "app",
+				ChartPath:         ".",
+				ArtifactOverrides: map[string]string{"image": "app"},
+				ImageStrategy:     helmConvention,
+			}},
+			expectedImages: []string{"app"},
+		},
+		{
+			description: "image names take precedence over the helm convention",
+			files: map[string]string{
+				"app/Chart.yaml": "name: app",
+				"app/values.yaml": `image: app
+sidecar:
+  image:
+    repository: sidecar
+`,
+			},
+			chartFiles: []string{"app/Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{
+				Name:              "app",
+				ChartPath:         "app",
+				ArtifactOverrides: map[string]string{"image": "app"},
+			}},
+			expectedImages: []string{"app"},
+		},
+		{
+			description: "no values, chart without name",
+			files: map[string]string{
+				"charts/app/Chart.yaml": "version: 0.1.0",
+			},
+			chartFiles:       []string{"charts/app/Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{Name: "app", ChartPath: "charts/app"}},
+		},
+		{
+			description: "subcharts are skipped",
+			files: map[string]string{
+				"app/Chart.yaml":              "name: app",
+				"app/charts/redis/Chart.yaml": "name: redis",
+			},
+			chartFiles:       []string{"app/Chart.yaml", "app/charts/redis/Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{Name: "app", ChartPath: "app"}},
+		},
+		{
+			description: "subcharts listed before their parent are skipped",
+			files: map[string]string{
+				"Chart.yaml":              "name: app",
+				"charts/redis/Chart.yaml": "name: redis",
+			},
+			chartFiles:       []string{"charts/redis/Chart.yaml", "Chart.yaml"},
+			expectedReleases: []latestV1.HelmRelease{{Name: "app", ChartPath: "."}},
+		},
+		{
+			description: "charts with a common name prefix",
+			files: map[string]string{
+				"app/Chart.yaml":     "name: app",
+				"app-api/Chart.yaml": "name: app-api",
+			},
+			chartFiles: []string{"app/Chart.yaml", "app-api/Chart.yaml"},
+			force:      true,
+			expectedReleases: []latestV1.HelmRelease{
+				{Name: "app", ChartPath: "app"},
+				{Name: "app-api", ChartPath: "app-api"},
+			},
+		},
+		{
+			description: "user chooses charts",
+			files: map[string]string{
+				"backend/Chart.yaml":   "name: backend",
+				"backend/values.yaml":  "image: backend",
+				"frontend/Chart.yaml":  "name: frontend",
+				"frontend/values.yaml": "image: frontend",
+			},
+			chartFiles:   []string{"backend/Chart.yaml", "frontend/Chart.yaml"},
+			chosenCharts: []string{"frontend"},
+			expectedReleases: []latestV1.HelmRelease{
+				{Name: "frontend", ChartPath: "frontend", ArtifactOverrides: map[string]string{"image": "frontend"}},
+			},
+			expectedImages: []string{"frontend"},
+		},
+		{
+			description: "all charts with force",
+			files: map[string]string{
+				"backend/Chart.yaml":   "name: backend",
+				"backend/values.yaml":  "image: backend",
+				"frontend/Chart.yaml":  "name: frontend",
+				"frontend/values.yaml": "image: frontend",
+			},
+			chartFiles: []string{"backend/Chart.yaml", "frontend/Chart.yaml"},
+			force:      true,
+			expectedReleases: []latestV1.HelmRelease{
+				{Name: "backend", ChartPath: "backend", ArtifactOverrides: map[string]string{"image": "backend"}},
+				{Name: "frontend", ChartPath: "frontend", ArtifactOverrides: map[string]string{"image": "frontend"}},
+			},
+			expectedImages: []string{"backend", "frontend"},
+		},
+		{
+			description: "prompt error",
+			chartFiles:  []string{"backend/Chart.yaml", "frontend/Chart.yaml"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+			t.Override(&prompt.ChooseChartsFunc, func([]string) ([]string, error) {
+				if test.shouldErr {
+					return nil, errors.New("error")
+				}
+				return test.chosenCharts, nil
+			})
+
+			h, err := newHelmInitializer(test.chartFiles, test.force)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			deployConfig, profiles := h.DeployConfig()
+			t.CheckDeepEqual(test.expectedReleases, deployConfig.HelmDeploy.Releases)
+			t.CheckDeepEqual(test.expectedImages, h.GetImages())
+			t.CheckDeepEqual(0, len(profiles))
+			t.CheckNoError(h.Validate())
+		})
+	}
+}
+
+func TestValidateHelmWithoutCharts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&prompt.ChooseChartsFunc, func([]string) ([]string, error) { return nil, nil })
+
+		h, err := newHelmInitializer([]string{"backend/Chart.yaml", "frontend/Chart.yaml"}, false)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(initerrors.NoHelmChartErr{}, h.Validate())
+	})
+}
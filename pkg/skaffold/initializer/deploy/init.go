@@ -79,17 +79,20 @@ func (e *emptyDeployInit) Validate() error {
 func (e *emptyDeployInit) AddManifestForImage(string, string) {}
 
 // if any CLI manifests are provided, we always use those as part of a kubectl deploy first
+// if not, then if helm charts are found, we generate a release for each of them
 // if not, then if a kustomization yaml is found, we use that next
 // otherwise, default to a kubectl deploy.
-func NewInitializer(manifests, bases, kustomizations []string, c config.Config) Initializer {
+func NewInitializer(manifests, bases, kustomizations, charts []string, c config.Config) (Initializer, error) {
 	switch {
 	case c.SkipDeploy:
-		return &emptyDeployInit{}
+		return &emptyDeployInit{}, nil
 	case len(c.CliKubernetesManifests) > 0:
-		return &cliDeployInit{c.CliKubernetesManifests}
+		return &cliDeployInit{c.CliKubernetesManifests}, nil
+	case len(charts) > 0:
+		return newHelmInitializer(charts, c.Force || c.Analyze)
 	case len(kustomizations) > 0:
-		return newKustomizeInitializer(c.DefaultKustomization, bases, kustomizations, manifests)
+		return newKustomizeInitializer(c.DefaultKustomization, bases, kustomizations, manifests), nil
 	default:
-		return newKubectlInitializer(manifests)
+		return newKubectlInitializer(manifests), nil
 	}
 }
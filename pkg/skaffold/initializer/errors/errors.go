@@ -51,3 +51,11 @@ func (e BuilderImageAmbiguitiesErr) ExitCode() int { return 104 }
 func (e BuilderImageAmbiguitiesErr) Error() string {
 	return "unable to automatically resolve builder/image pairs; run `skaffold init` without `--force` to manually resolve ambiguities"
 }
+
+// NoHelmChartErr is an error returned by `skaffold init` when no helm chart is chosen to be deployed.
+type NoHelmChartErr struct{}
+
+func (e NoHelmChartErr) ExitCode() int { return 105 }
+func (e NoHelmChartErr) Error() string {
+	return "one or more helm charts must be chosen to deploy with helm"
+}
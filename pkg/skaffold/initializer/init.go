@@ -18,7 +18,6 @@ package initializer
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -63,21 +62,16 @@ func AnalyzeProject(c config.Config) (*analyze.ProjectAnalysis, error) {
 		return nil, err
 	}
 
-	// helm projects can't currently be bootstrapped automatically by skaffold, so we fail fast and link to our docs instead.
-	if len(a.ChartPaths()) > 0 {
-		//nolint
-		return nil, errors.New(`Projects set up to deploy with helm must be manually configured.
-
-See https://skaffold.dev/docs/pipeline-stages/deployers/helm/ for a detailed guide on setting your project up with skaffold.`)
-	}
-
 	return a, nil
 }
 
 // Initialize uses the information gathered by the analyzer to create a skaffold config and generate kubernetes manifests.
 // The returned map[string][]byte represents a mapping from generated config name to its respective manifest data held in a []byte
 func Initialize(out io.Writer, c config.Config, a *analyze.ProjectAnalysis) (*latestV1.SkaffoldConfig, map[string][]byte, error) {
-	deployInitializer := deploy.NewInitializer(a.Manifests(), a.KustomizeBases(), a.KustomizePaths(), c)
+	deployInitializer, err := deploy.NewInitializer(a.Manifests(), a.KustomizeBases(), a.KustomizePaths(), a.ChartPaths(), c)
+	if err != nil {
+		return nil, nil, err
+	}
 	images := deployInitializer.GetImages()
 
 	buildInitializer := build.NewInitializer(a.Builders(), c)
@@ -33,6 +33,7 @@ import (
 var (
 	BuildConfigFunc         = buildConfig
 	ChooseBuildersFunc      = chooseBuilders
+	ChooseChartsFunc        = chooseCharts
 	PortForwardResourceFunc = portForwardResource
 	askOne                  = survey.AskOne
 	ask                     = survey.Ask
@@ -92,6 +93,22 @@ func chooseBuilders(builders []string) ([]string, error) {
 	return chosen, err
 }
 
+// chooseCharts prompts the user to select which helm charts they'd like to deploy as releases
+func chooseCharts(charts []string) ([]string, error) {
+	chosen := []string{}
+	prompt := &survey.MultiSelect{
+		Message: "Which helm charts would you like to deploy as releases?",
+		Options: charts,
+		Default: charts,
+	}
+	err := askOne(prompt, &chosen)
+	if err != nil {
+		return []string{}, fmt.Errorf("getting user choices")
+	}
+
+	return chosen, err
+}
+
 // PortForwardResource prompts the user to give a port to forward the current resource on
 func portForwardResource(out io.Writer, imageName string) (int, error) {
 	var response string
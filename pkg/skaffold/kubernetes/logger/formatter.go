@@ -21,6 +21,7 @@ import (
 	"io"
 	"sync"
 
+	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
@@ -33,6 +34,7 @@ import (
 type kubernetesLogFormatter struct {
 	colorPicker output.ColorPicker
 	prefix      string
+	jsonFormat  bool
 
 	pod       *v1.Pod
 	container v1.ContainerStatus
@@ -42,7 +44,14 @@ type kubernetesLogFormatter struct {
 }
 
 func NewKubernetesLogFormatter(config Config, colorPicker output.ColorPicker, isMuted func() bool, pod *v1.Pod, container v1.ContainerStatus) log.Formatter {
-	return newKubernetesLogFormatter(config, colorPicker, isMuted, pod, container)
+	f := newKubernetesLogFormatter(config, colorPicker, isMuted, pod, container)
+	filters, err := log.NewFilters(logsConfig(config, pod), config.LogFilters(), !config.JSONLogs())
+	if err != nil {
+		// filters are validated with the config, so this isn't expected.
+		logrus.Warnf("ignoring log filters: %v", err)
+		return f
+	}
+	return log.WithFilters(f, filters, log.Source{Container: container.Name, PodLabels: pod.Labels})
 }
 
 func newKubernetesLogFormatter(config Config, colorPicker output.ColorPicker, isMuted func() bool, pod *v1.Pod, container v1.ContainerStatus) *kubernetesLogFormatter {
@@ -51,6 +60,7 @@ func newKubernetesLogFormatter(config Config, colorPicker output.ColorPicker, is
 		pod:         pod,
 		container:   container,
 		prefix:      prefix(config, pod, container),
+		jsonFormat:  config.JSONLogs(),
 		isMuted:     isMuted,
 	}
 }
@@ -58,6 +68,11 @@ func newKubernetesLogFormatter(config Config, colorPicker output.ColorPicker, is
 func (k *kubernetesLogFormatter) Name() string { return k.prefix }
 
 func (k *kubernetesLogFormatter) PrintLine(out io.Writer, line string) {
+	if k.jsonFormat {
+		k.printJSONLine(out, line)
+		return
+	}
+
 	formattedPrefix := k.prefix
 	if output.IsColorable(out) {
 		formattedPrefix = k.color().Sprintf("%s", k.prefix)
@@ -77,6 +92,23 @@ func (k *kubernetesLogFormatter) PrintLine(out io.Writer, line string) {
 	}
 }
 
+// printJSONLine prints the line as a JSON object with the pod and container it comes from.
+func (k *kubernetesLogFormatter) printJSONLine(out io.Writer, line string) {
+	entry := log.NewJSONEntry(line)
+	entry.Namespace = k.pod.Namespace
+	entry.Pod = k.pod.Name
+	entry.Container = k.container.Name
+	entry.Image = k.container.Image
+	formattedLine := entry.String()
+	eventV2.ApplicationLog(k.pod.Name, k.container.Name, "", entry.Message, formattedLine)
+
+	if !k.isMuted() {
+		k.lock.Lock()
+		defer k.lock.Unlock()
+		fmt.Fprint(out, formattedLine)
+	}
+}
+
 func (k *kubernetesLogFormatter) color() output.Color {
 	for _, container := range k.pod.Spec.Containers {
 		if c := k.colorPicker.Pick(container.Image); c != output.None {
@@ -88,18 +120,19 @@ func (k *kubernetesLogFormatter) color() output.Color {
 	return output.None
 }
 
-func prefix(config Config, pod *v1.Pod, container v1.ContainerStatus) string {
-	var c latestV1.Pipeline
-	var present bool
+// logsConfig returns the `deploy.logs` config of the pipeline that built one of the pod's images.
+func logsConfig(config Config, pod *v1.Pod) latestV1.LogsConfig {
 	for _, container := range pod.Spec.Containers {
-		if c, present = config.PipelineForImage(tagutil.StripTag(container.Image, false)); present {
-			break
+		if c, present := config.PipelineForImage(tagutil.StripTag(container.Image, false)); present {
+			return c.Deploy.Logs
 		}
 	}
-	if !present {
-		c = config.DefaultPipeline()
-	}
-	switch c.Deploy.Logs.Prefix {
+	return config.DefaultPipeline().Deploy.Logs
+}
+
+func prefix(config Config, pod *v1.Pod, container v1.ContainerStatus) string {
+	logs := logsConfig(config, pod)
+	switch logs.Prefix {
 	case "auto":
 		if pod.Name != container.Name {
 			return podAndContainerPrefix(pod, container)
@@ -112,7 +145,7 @@ func prefix(config Config, pod *v1.Pod, container v1.ContainerStatus) string {
 	case "none":
 		return ""
 	default:
-		panic("unsupported prefix: " + c.Deploy.Logs.Prefix)
+		panic("unsupported prefix: " + logs.Prefix)
 	}
 }
 
@@ -23,7 +23,9 @@ import (
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -38,7 +40,21 @@ func (m *mockColorPicker) Pick(image string) output.Color {
 func (m *mockColorPicker) AddImage(string) {}
 
 type mockConfig struct {
-	log latestV1.LogsConfig
+	log     latestV1.LogsConfig
+	json    bool
+	filters config.LogFilterOptions
+}
+
+func (c *mockConfig) JSONLogs() bool {
+	return c.json
+}
+
+func (c *mockConfig) LogFilters() config.LogFilterOptions {
+	return c.filters
+}
+
+func (c *mockConfig) LogFiles() config.LogFileOptions {
+	return config.LogFileOptions{}
 }
 
 func (c *mockConfig) Tail() bool {
@@ -88,6 +104,63 @@ func TestPrintLogLine(t *testing.T) {
 	})
 }
 
+func TestPrintJSONLogLine(t *testing.T) {
+	tests := []struct {
+		description string
+		line        string
+		muted       bool
+		expected    string
+	}{
+		{
+			description: "text message",
+			line:        "2021-06-01T10:00:00.5Z Listening on port 8080\n",
+			expected:    `{"timestamp":"2021-06-01T10:00:00.5Z","namespace":"ns","pod":"web-7d8f","container":"web","image":"web:tag","message":"Listening on port 8080"}` + "\n",
+		},
+		{
+			description: "json message",
+			line:        `2021-06-01T10:00:00Z {"severity":"INFO","message":"started"}` + "\n",
+			expected:    `{"timestamp":"2021-06-01T10:00:00Z","namespace":"ns","pod":"web-7d8f","container":"web","image":"web:tag","message":"{\"severity\":\"INFO\",\"message\":\"started\"}","parsed":{"severity":"INFO","message":"started"}}` + "\n",
+		},
+		{
+			description: "muted",
+			line:        "2021-06-01T10:00:00Z Listening on port 8080\n",
+			muted:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var buf bytes.Buffer
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-7d8f", Namespace: "ns"}}
+			container := v1.ContainerStatus{Name: "web", Image: "web:tag"}
+
+			f := NewKubernetesLogFormatter(&mockConfig{log: latestV1.LogsConfig{Prefix: "auto"}, json: true}, &mockColorPicker{}, func() bool { return test.muted }, pod, container)
+			f.PrintLine(&buf, test.line)
+
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintFilteredLogLine(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-7d8f", Labels: map[string]string{"app": "web"}}}
+		cfg := &mockConfig{
+			log:     latestV1.LogsConfig{Prefix: "none", Exclude: []latestV1.LogFilter{{Message: "GET /healthz"}}},
+			filters: config.LogFilterOptions{Include: []string{"label:app=web"}},
+		}
+
+		f := NewKubernetesLogFormatter(cfg, &mockColorPicker{}, func() bool { return false }, pod, v1.ContainerStatus{Name: "web"})
+		f.PrintLine(&buf, "GET /healthz 200\n")
+		f.PrintLine(&buf, "GET /api 500\n")
+
+		other := NewKubernetesLogFormatter(cfg, &mockColorPicker{}, func() bool { return false }, &v1.Pod{}, v1.ContainerStatus{Name: "db"})
+		other.PrintLine(&buf, "ready\n")
+
+		t.CheckDeepEqual("GET /api 500\n", buf.String())
+	})
+}
+
 func TestColorForPod(t *testing.T) {
 	tests := []struct {
 		description   string
@@ -27,9 +27,11 @@ import (
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 
+	sConfig "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log/stream"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -54,6 +56,9 @@ type LogAggregator struct {
 
 type Config interface {
 	Tail() bool
+	JSONLogs() bool
+	LogFilters() sConfig.LogFilterOptions
+	LogFiles() sConfig.LogFileOptions
 	PipelineForImage(imageName string) (latestV1.Pipeline, bool)
 	DefaultPipeline() latestV1.Pipeline
 }
@@ -171,9 +176,15 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 	// So we use --since=Xs and round up to the nearest second to not lose any log.
 	sinceSeconds := fmt.Sprintf("--since=%ds", sinceSeconds(time.Since(a.sinceTime)))
 
+	args := []string{sinceSeconds, "-f", pod.Name, "-c", container.Name, "--namespace", pod.Namespace}
+	if a.config.JSONLogs() {
+		// JSON logs report the time each line was written by the container.
+		args = append(args, "--timestamps")
+	}
+
 	tr, tw := io.Pipe()
 	go func() {
-		if err := a.kubectlcli.Run(ctx, nil, tw, "logs", sinceSeconds, "-f", pod.Name, "-c", container.Name, "--namespace", pod.Namespace); err != nil {
+		if err := a.kubectlcli.Run(ctx, nil, tw, "logs", args...); err != nil {
 			// Don't print errors if the user interrupted the logs
 			// or if the logs were interrupted because of a configuration change
 			if ctx.Err() != context.Canceled {
@@ -184,6 +195,13 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 	}()
 
 	formatter := NewKubernetesLogFormatter(a.config, a.colorPicker, a.IsMuted, pod, container)
+	file, err := log.OpenFile(a.config.LogFiles(), pod.Namespace, pod.Name, container.Name+".log")
+	if err != nil {
+		logrus.Warnf("unable to persist logs of pod: %s container: %s: %v", pod.Name, container.Name, err)
+	} else if file != nil {
+		defer file.Close()
+		formatter = log.WithFile(formatter, file)
+	}
 	if err := stream.StreamRequest(ctx, a.output, formatter, tr); err != nil {
 		logrus.Errorf("streaming request %s", err)
 	}
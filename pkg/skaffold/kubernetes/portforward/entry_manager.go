@@ -45,6 +45,7 @@ var (
 	portForwardEventV2 = func(entry *portForwardEntry) {
 		eventV2.PortForwarded(
 			int32(entry.localPort),
+			int32(entry.reservedPort),
 			entry.resource.Port,
 			entry.podName,
 			entry.containerName,
@@ -54,6 +55,21 @@ var (
 			entry.resource.Name,
 			entry.resource.Address)
 	}
+	portForwardFailedEventV2 = func(entry *portForwardEntry, err error) {
+		eventV2.PortForwardFailed(
+			int32(entry.localPort),
+			int32(entry.reservedPort),
+			entry.resource.Port,
+			entry.podName,
+			entry.containerName,
+			entry.resource.Namespace,
+			entry.portName,
+			string(entry.resource.Type),
+			entry.resource.Name,
+			entry.resource.Address,
+			string(forwardErrorReason(err)),
+			err)
+	}
 )
 
 type forwardedResources struct {
@@ -104,6 +120,9 @@ type EntryManager struct {
 
 	// forwardedResources is a map of portForwardEntry key (string) -> portForwardEntry
 	forwardedResources forwardedResources
+
+	// reservations are the local ports that entries were forwarded to in previous sessions.
+	reservations *PortReservations
 }
 
 // NewEntryManager returns a new port forward entry manager to keep track
@@ -114,6 +133,27 @@ func NewEntryManager(entryForwarder EntryForwarder) *EntryManager {
 	}
 }
 
+// allocateLocalPort returns an available local port for an entry. Unless the entry's resource
+// explicitly requests a local port, the port it was forwarded to in a previous session is
+// preferred to the requested port.
+func (b *EntryManager) allocateLocalPort(out io.Writer, entry *portForwardEntry, requestPort int) int {
+	key := entry.key()
+	reservedPort, reserved := b.reservations.Get(key)
+	if reserved && entry.resource.LocalPort == 0 {
+		requestPort = reservedPort
+	}
+
+	localPort := retrieveAvailablePort(entry.resource.Address, requestPort, &b.forwardedPorts)
+	if reserved && entry.resource.LocalPort == 0 && localPort != reservedPort {
+		entry.reservedPort = reservedPort
+		out = output.WithEventContext(out, constants.PortForward, fmt.Sprintf("%s/%s", entry.resource.Type, entry.resource.Name))
+		output.Yellow.Fprintf(out, "Local port %d reserved for %s/%s in namespace %s is taken, forwarding to local port %d instead.\n",
+			reservedPort, entry.resource.Type, entry.resource.Name, entry.resource.Namespace, localPort)
+	}
+	b.reservations.Reserve(key, localPort)
+	return localPort
+}
+
 func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Writer, entry *portForwardEntry) {
 	out = output.WithEventContext(out, constants.PortForward, fmt.Sprintf("%s/%s", entry.resource.Type, entry.resource.Name))
 
@@ -123,19 +163,20 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Write
 	}
 	b.forwardedResources.Store(entry.key(), entry)
 
-	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
-		output.Green.Fprintln(
-			out,
-			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> %s:%d",
-				entry.resource.Type,
-				entry.resource.Name,
-				entry.resource.Namespace,
-				entry.resource.Port.String(),
-				entry.resource.Address,
-				entry.localPort))
-	} else {
+	if err := b.entryForwarder.Forward(ctx, entry); err != nil {
 		output.Red.Fprintln(out, err)
+		portForwardFailedEventV2(entry, err)
+		return
 	}
+	output.Green.Fprintln(
+		out,
+		fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> %s:%d",
+			entry.resource.Type,
+			entry.resource.Name,
+			entry.resource.Namespace,
+			entry.resource.Port.String(),
+			entry.resource.Address,
+			entry.localPort))
 	portForwardEvent(entry)
 	portForwardEventV2(entry)
 }
@@ -18,6 +18,8 @@ package portforward
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -56,6 +58,59 @@ func TestStop(t *testing.T) {
 	testutil.CheckDeepEqual(t, 0, fakeForwarder.forwardedPorts.Length())
 }
 
+type failingForwarder struct {
+	*testForwarder
+	err error
+}
+
+func (f *failingForwarder) Forward(context.Context, *portForwardEntry) error {
+	return f.err
+}
+
+func TestForwardPortForwardEntryEvents(t *testing.T) {
+	tests := []struct {
+		description    string
+		forwardErr     error
+		expectedEvents []string
+	}{
+		{
+			description:    "forwarded",
+			expectedEvents: []string{"v1 forwarded", "v2 forwarded"},
+		},
+		{
+			description:    "port taken",
+			forwardErr:     &ForwardError{Reason: ReasonPortTaken, Resource: "pod/resource", Err: errors.New("port 9000 is taken")},
+			expectedEvents: []string{"v2 failed: PortTaken"},
+		},
+		{
+			description:    "unknown error",
+			forwardErr:     errors.New("boom"),
+			expectedEvents: []string{"v2 failed: Unknown"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var events []string
+			t.Override(&portForwardEvent, func(*portForwardEntry) { events = append(events, "v1 forwarded") })
+			t.Override(&portForwardEventV2, func(*portForwardEntry) { events = append(events, "v2 forwarded") })
+			t.Override(&portForwardFailedEventV2, func(_ *portForwardEntry, err error) {
+				events = append(events, fmt.Sprintf("v2 failed: %s", forwardErrorReason(err)))
+			})
+
+			pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      "resource",
+				Namespace: "default",
+			}, "", "", "", "", 9000, false)
+
+			em := NewEntryManager(&failingForwarder{testForwarder: newTestForwarder(), err: test.forwardErr})
+			em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+
+			t.CheckDeepEqual(test.expectedEvents, events)
+		})
+	}
+}
+
 func TestForwardedResources(t *testing.T) {
 	pf := &forwardedResources{}
 
@@ -41,6 +41,9 @@ type Config interface {
 	Mode() config.RunMode
 	PortForwardResources() []*latestV1.PortForwardResource
 	PortForwardOptions() config.PortForwardOptions
+	NativePortForward() bool
+	GlobalConfig() string
+	ConfigurationFile() string
 }
 
 // Forwarder is an interface that can modify and manage port-forward processes
@@ -59,18 +62,29 @@ type ForwarderManager struct {
 	namespaces *[]string
 }
 
-// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
+// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding.
+// Ports are forwarded with `kubectl port-forward`, or in-process with client-go if native is true.
+// Resources are forwarded to the local ports reserved for them, if any.
 func NewForwarderManager(cli *kubectl.CLI, podSelector kubernetes.PodSelector, label string, runMode config.RunMode, namespaces *[]string,
-	options config.PortForwardOptions, userDefined []*latestV1.PortForwardResource) *ForwarderManager {
+	options config.PortForwardOptions, native bool, reservations *PortReservations, userDefined []*latestV1.PortForwardResource) *ForwarderManager {
 	if !options.Enabled() {
 		return nil
 	}
 
-	entryManager := NewEntryManager(NewKubectlForwarder(cli))
+	var entryForwarder EntryForwarder = NewKubectlForwarder(cli)
+	if native {
+		entryForwarder = NewNativeForwarder()
+	}
+	entryManager := NewEntryManager(entryForwarder)
+	entryManager.reservations = reservations
 
 	var forwarders []Forwarder
 	if options.ForwardUser(runMode) {
-		forwarders = append(forwarders, NewUserDefinedForwarder(entryManager, userDefined))
+		resources, ingresses := splitIngresses(userDefined)
+		forwarders = append(forwarders, NewUserDefinedForwarder(entryManager, resources))
+		if len(ingresses) > 0 {
+			forwarders = append(forwarders, NewIngressForwarder(entryManager, ingresses))
+		}
 	}
 	if options.ForwardServices(runMode) {
 		forwarders = append(forwarders, NewServicesForwarder(entryManager, label))
@@ -34,6 +34,7 @@ func TestNewForwarderManager(t *testing.T) {
 	tests := []struct {
 		description        string
 		fmOptions          string
+		native             bool
 		expectedForwarders int
 	}{
 		{
@@ -51,6 +52,12 @@ func TestNewForwarderManager(t *testing.T) {
 			fmOptions:          "user,debug",
 			expectedForwarders: 2,
 		},
+		{
+			description:        "native forwarder manager",
+			fmOptions:          "user",
+			native:             true,
+			expectedForwarders: 1,
+		},
 	}
 
 	for _, test := range tests {
@@ -63,10 +70,14 @@ func TestNewForwarderManager(t *testing.T) {
 				"",
 				nil,
 				options,
+				test.native,
+				nil,
 				nil)
 
 			if fm != nil {
 				t.CheckDeepEqual(test.expectedForwarders, len(fm.forwarders))
+				_, native := fm.entryManager.entryForwarder.(*NativeForwarder)
+				t.CheckDeepEqual(test.native, native)
 			}
 		})
 	}
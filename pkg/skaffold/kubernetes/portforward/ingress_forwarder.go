@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+)
+
+// defaultIngressLocalPort is the local port requested for an ingress proxy without a `localPort`.
+const defaultIngressLocalPort = 8080
+
+var (
+	// For testing
+	retrieveIngressRules = getIngressRules
+)
+
+// IngressForwarder serves the host and path rules of user defined Ingress resources
+// with a local HTTP reverse proxy that routes requests to port-forwarded backend services.
+type IngressForwarder struct {
+	entryManager *EntryManager
+	ingresses    []*latestV1.PortForwardResource
+
+	lock    sync.Mutex
+	proxies []*ingressProxy
+}
+
+type ingressProxy struct {
+	entry  *portForwardEntry
+	server *http.Server
+}
+
+// ingressRule is a host and path rule of an Ingress.
+// An empty path type is handled like a `Prefix` path.
+type ingressRule struct {
+	host     string
+	path     string
+	pathType string
+	backend  ingressBackend
+}
+
+// ingressBackend is the service port that an ingressRule routes to.
+type ingressBackend struct {
+	service string
+	port    schemautil.IntOrString
+}
+
+// NewIngressForwarder returns a struct that port-forwards the backends of Ingress resources
+// and routes requests to them from a local reverse proxy.
+func NewIngressForwarder(entryManager *EntryManager, ingresses []*latestV1.PortForwardResource) *IngressForwarder {
+	return &IngressForwarder{
+		entryManager: entryManager,
+		ingresses:    ingresses,
+	}
+}
+
+// splitIngresses separates the user defined Ingress resources from the other port forwarding resources.
+func splitIngresses(resources []*latestV1.PortForwardResource) (others, ingresses []*latestV1.PortForwardResource) {
+	for _, r := range resources {
+		if strings.EqualFold(string(r.Type), string(constants.Ingress)) {
+			ingresses = append(ingresses, r)
+		} else {
+			others = append(others, r)
+		}
+	}
+	return others, ingresses
+}
+
+// Start reads the rules of each Ingress, forwards their backend services and starts serving the proxies.
+// Ingresses that can't be forwarded, e.g. because they aren't deployed, are skipped with a warning.
+func (f *IngressForwarder) Start(ctx context.Context, out io.Writer, namespaces []string) error {
+	ingresses, err := resolveUserDefinedResources(f.ingresses, namespaces)
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	for _, ingress := range ingresses {
+		if err := f.forwardIngress(ctx, out, client, *ingress); err != nil {
+			logrus.Warnf("Skipping port forwarding of ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
+		}
+	}
+	return nil
+}
+
+func (f *IngressForwarder) forwardIngress(ctx context.Context, out io.Writer, client kubernetes.Interface, ingress latestV1.PortForwardResource) error {
+	rules, err := retrieveIngressRules(ctx, client, ingress.Namespace, ingress.Name)
+	if err != nil {
+		return err
+	}
+
+	if ingress.Address == "" {
+		ingress.Address = constants.DefaultPortForwardAddress
+	}
+	requestPort := ingress.LocalPort
+	if requestPort == 0 {
+		requestPort = defaultIngressLocalPort
+	}
+	// The proxy port is allocated first so that backends are forwarded to other ports.
+	entry := newPortForwardEntry(0, ingress, "", "", "", "", 0, false)
+	entry.localPort = f.entryManager.allocateLocalPort(out, entry, requestPort)
+
+	router := &ingressRouter{}
+	backends := map[ingressBackend]*httputil.ReverseProxy{}
+	var backendEntries []*portForwardEntry
+	for _, rule := range rules {
+		proxy, found := backends[rule.backend]
+		if !found {
+			backendEntry := f.backendEntry(out, ingress.Namespace, rule.backend)
+			backendEntries = append(backendEntries, backendEntry)
+			proxy = httputil.NewSingleHostReverseProxy(&url.URL{
+				Scheme: "http",
+				Host:   net.JoinHostPort(backendEntry.resource.Address, strconv.Itoa(backendEntry.localPort)),
+			})
+			backends[rule.backend] = proxy
+		}
+		router.routes = append(router.routes, ingressRoute{ingressRule: rule, proxy: proxy})
+	}
+
+	go func() {
+		for _, backendEntry := range backendEntries {
+			f.entryManager.forwardPortForwardEntry(ctx, out, backendEntry)
+		}
+	}()
+
+	return f.serve(out, entry, router)
+}
+
+// backendEntry returns the port forward entry for a backend service, forwarded on the loopback address.
+func (f *IngressForwarder) backendEntry(out io.Writer, namespace string, backend ingressBackend) *portForwardEntry {
+	resource := latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      backend.service,
+		Namespace: namespace,
+		Port:      backend.port,
+		Address:   constants.DefaultPortForwardAddress,
+	}
+	entry := newPortForwardEntry(0, resource, "", "", "", "", 0, false)
+	if oldEntry, ok := f.entryManager.forwardedResources.Load(entry.key()); ok {
+		entry.localPort = oldEntry.localPort
+		return entry
+	}
+	entry.localPort = f.entryManager.allocateLocalPort(out, entry, 0)
+	return entry
+}
+
+func (f *IngressForwarder) serve(out io.Writer, entry *portForwardEntry, router *ingressRouter) error {
+	out = output.WithEventContext(out, constants.PortForward, fmt.Sprintf("%s/%s", entry.resource.Type, entry.resource.Name))
+	address := net.JoinHostPort(entry.resource.Address, strconv.Itoa(entry.localPort))
+
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		f.entryManager.forwardedPorts.Delete(entry.localPort)
+		return fmt.Errorf("starting the proxy for ingress %s/%s: %w", entry.resource.Namespace, entry.resource.Name, err)
+	}
+	server := &http.Server{Handler: router}
+	go func() {
+		if err := server.Serve(l); err != nil && err != http.ErrServerClosed {
+			logrus.Warnf("proxy for ingress %s/%s stopped: %v", entry.resource.Namespace, entry.resource.Name, err)
+		}
+	}()
+
+	f.lock.Lock()
+	f.proxies = append(f.proxies, &ingressProxy{entry: entry, server: server})
+	f.lock.Unlock()
+
+	output.Green.Fprintf(out, "Serving ingress/%s in namespace %s on http://%s\n", entry.resource.Name, entry.resource.Namespace, address)
+	for _, route := range router.routes {
+		fmt.Fprintf(out, " - %s -> service/%s:%s\n", route.String(), route.backend.service, route.backend.port.String())
+	}
+	portForwardEvent(entry)
+	portForwardEventV2(entry)
+	return nil
+}
+
+// Stop shuts down the proxies. Backend services are terminated along with the other port forwards.
+func (f *IngressForwarder) Stop() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, proxy := range f.proxies {
+		if err := proxy.server.Close(); err != nil {
+			logrus.Debugf("closing proxy for ingress %s/%s: %v", proxy.entry.resource.Namespace, proxy.entry.resource.Name, err)
+		}
+		f.entryManager.forwardedPorts.Delete(proxy.entry.localPort)
+	}
+	f.proxies = nil
+}
+
+// getIngressRules reads the rules of an Ingress. Clusters older than 1.19 only serve
+// `networking.k8s.io/v1beta1` Ingresses.
+func getIngressRules(ctx context.Context, client kubernetes.Interface, namespace, name string) ([]ingressRule, error) {
+	ingress, err := client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return ingressRulesV1(ingress), nil
+	}
+
+	legacy, legacyErr := client.NetworkingV1beta1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if legacyErr != nil {
+		return nil, fmt.Errorf("getting ingress %s/%s: %w", namespace, name, err)
+	}
+	return ingressRulesV1beta1(legacy), nil
+}
+
+func ingressRulesV1(ingress *networkingv1.Ingress) []ingressRule {
+	backend := func(b *networkingv1.IngressBackend) (ingressBackend, bool) {
+		if b == nil || b.Service == nil {
+			return ingressBackend{}, false
+		}
+		port := schemautil.FromInt(int(b.Service.Port.Number))
+		if b.Service.Port.Name != "" {
+			port = schemautil.FromString(b.Service.Port.Name)
+		}
+		return ingressBackend{service: b.Service.Name, port: port}, true
+	}
+
+	var rules []ingressRule
+	for _, r := range ingress.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			b, ok := backend(&p.Backend)
+			if !ok {
+				logrus.Warnf("Skipping path %s%s of ingress %s/%s because its backend isn't a service", r.Host, p.Path, ingress.Namespace, ingress.Name)
+				continue
+			}
+			rule := ingressRule{host: r.Host, path: p.Path, backend: b}
+			if p.PathType != nil {
+				rule.pathType = string(*p.PathType)
+			}
+			rules = append(rules, rule)
+		}
+	}
+	if b, ok := backend(ingress.Spec.DefaultBackend); ok {
+		rules = append(rules, ingressRule{backend: b})
+	}
+	return rules
+}
+
+func ingressRulesV1beta1(ingress *networkingv1beta1.Ingress) []ingressRule {
+	backend := func(b *networkingv1beta1.IngressBackend) (ingressBackend, bool) {
+		if b == nil || b.ServiceName == "" {
+			return ingressBackend{}, false
+		}
+		port := schemautil.FromInt(int(b.ServicePort.IntVal))
+		if b.ServicePort.Type == intstr.String {
+			port = schemautil.FromString(b.ServicePort.StrVal)
+		}
+		return ingressBackend{service: b.ServiceName, port: port}, true
+	}
+
+	var rules []ingressRule
+	for _, r := range ingress.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			b, ok := backend(&p.Backend)
+			if !ok {
+				logrus.Warnf("Skipping path %s%s of ingress %s/%s because its backend isn't a service", r.Host, p.Path, ingress.Namespace, ingress.Name)
+				continue
+			}
+			rule := ingressRule{host: r.Host, path: p.Path, backend: b}
+			if p.PathType != nil {
+				rule.pathType = string(*p.PathType)
+			}
+			rules = append(rules, rule)
+		}
+	}
+	if b, ok := backend(ingress.Spec.Backend); ok {
+		rules = append(rules, ingressRule{backend: b})
+	}
+	return rules
+}
+
+type ingressRoute struct {
+	ingressRule
+	proxy http.Handler
+}
+
+func (r ingressRoute) String() string {
+	path := r.path
+	if path == "" {
+		path = "/"
+	}
+	host := r.host
+	if host == "" {
+		host = "*"
+	}
+	return host + path
+}
+
+// ingressRouter routes requests to the backend of the most specific matching rule,
+// following the Ingress semantics: the longest matching path wins, and `Exact`
+// paths win over `Prefix` paths of the same length.
+type ingressRouter struct {
+	routes []ingressRoute
+}
+
+func (r *ingressRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	route := r.match(req.Host, req.URL.Path)
+	if route == nil {
+		http.NotFound(w, req)
+		return
+	}
+	route.proxy.ServeHTTP(w, req)
+}
+
+func (r *ingressRouter) match(host, path string) *ingressRoute {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	var best *ingressRoute
+	var bestHost, bestPath int
+	for i := range r.routes {
+		route := &r.routes[i]
+		hostScore := matchHost(route.host, host)
+		if hostScore < 0 {
+			continue
+		}
+		pathScore := matchPath(route.path, route.pathType, path)
+		if pathScore < 0 {
+			continue
+		}
+		if best == nil || hostScore > bestHost || (hostScore == bestHost && pathScore > bestPath) {
+			best, bestHost, bestPath = route, hostScore, pathScore
+		}
+	}
+	return best
+}
+
+// matchHost returns how specifically a rule host matches the request host, or -1 if it doesn't.
+// On top of the Ingress semantics, `<name>.localhost` matches rule hosts starting with `<name>.`
+// so that production hosts can be reached locally, e.g. `api.localhost` for `api.example.com`.
+func matchHost(ruleHost, host string) int {
+	switch {
+	case ruleHost == "":
+		return 0
+	case strings.EqualFold(ruleHost, host):
+		return 3
+	case strings.HasSuffix(strings.ToLower(host), ".localhost") && strings.HasPrefix(strings.ToLower(ruleHost), strings.ToLower(strings.TrimSuffix(host, "localhost"))):
+		return 2
+	case strings.HasPrefix(ruleHost, "*."):
+		// A wildcard only matches a single DNS label.
+		suffix := strings.ToLower(ruleHost[1:])
+		label := strings.TrimSuffix(strings.ToLower(host), suffix)
+		if label != strings.ToLower(host) && label != "" && !strings.Contains(label, ".") {
+			return 1
+		}
+	}
+	return -1
+}
+
+// matchPath returns the length of the rule path matching the request path, with a bonus for exact matches,
+// or -1 if it doesn't match.
+func matchPath(rulePath, pathType, path string) int {
+	if rulePath == "" {
+		rulePath = "/"
+	}
+	if pathType == string(networkingv1.PathTypeExact) {
+		if rulePath == path {
+			return 2*len(rulePath) + 1
+		}
+		return -1
+	}
+
+	// `Prefix` paths match element by element.
+	prefix := strings.TrimSuffix(rulePath, "/")
+	if prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/") {
+		return 2 * len(prefix)
+	}
+	return -1
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func ingressV1(rules ...networkingv1.IngressRule) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+		Spec:       networkingv1.IngressSpec{Rules: rules},
+	}
+}
+
+func pathV1(path string, pathType networkingv1.PathType, service string, port networkingv1.ServiceBackendPort) networkingv1.HTTPIngressPath {
+	return networkingv1.HTTPIngressPath{
+		Path:     path,
+		PathType: &pathType,
+		Backend:  networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: service, Port: port}},
+	}
+}
+
+func TestGetIngressRules(t *testing.T) {
+	prefix := networkingv1beta1.PathTypePrefix
+
+	tests := []struct {
+		description string
+		objects     []pkgruntime.Object
+		expected    []ingressRule
+		shouldErr   bool
+	}{
+		{
+			description: "networking.k8s.io/v1",
+			objects: []pkgruntime.Object{&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+				Spec: networkingv1.IngressSpec{
+					DefaultBackend: &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "frontend", Port: networkingv1.ServiceBackendPort{Number: 80}}},
+					Rules: []networkingv1.IngressRule{{
+						Host: "api.example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{
+							pathV1("/v1", networkingv1.PathTypePrefix, "api-v1", networkingv1.ServiceBackendPort{Name: "http"}),
+							pathV1("/healthz", networkingv1.PathTypeExact, "api-v1", networkingv1.ServiceBackendPort{Number: 8080}),
+							{Path: "/static", Backend: networkingv1.IngressBackend{Resource: &corev1.TypedLocalObjectReference{Kind: "StorageBucket", Name: "static"}}},
+						}}},
+					}},
+				},
+			}},
+			expected: []ingressRule{
+				{host: "api.example.com", path: "/v1", pathType: "Prefix", backend: ingressBackend{service: "api-v1", port: schemautil.FromString("http")}},
+				{host: "api.example.com", path: "/healthz", pathType: "Exact", backend: ingressBackend{service: "api-v1", port: schemautil.FromInt(8080)}},
+				{backend: ingressBackend{service: "frontend", port: schemautil.FromInt(80)}},
+			},
+		},
+		{
+			description: "networking.k8s.io/v1beta1",
+			objects: []pkgruntime.Object{&networkingv1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+				Spec: networkingv1beta1.IngressSpec{
+					Rules: []networkingv1beta1.IngressRule{{
+						Host: "api.example.com",
+						IngressRuleValue: networkingv1beta1.IngressRuleValue{HTTP: &networkingv1beta1.HTTPIngressRuleValue{Paths: []networkingv1beta1.HTTPIngressPath{{
+							Path:     "/v2",
+							PathType: &prefix,
+							Backend:  networkingv1beta1.IngressBackend{ServiceName: "api-v2", ServicePort: intstr.FromInt(80)},
+						}}}},
+					}},
+				},
+			}},
+			expected: []ingressRule{
+				{host: "api.example.com", path: "/v2", pathType: "Prefix", backend: ingressBackend{service: "api-v2", port: schemautil.FromInt(80)}},
+			},
+		},
+		{
+			description: "not found",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objects...)
+
+			rules, err := getIngressRules(context.Background(), client, "ns", "web")
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, rules, cmp.AllowUnexported(ingressRule{}, ingressBackend{}))
+			}
+		})
+	}
+}
+
+func TestIngressRouterMatch(t *testing.T) {
+	router := &ingressRouter{}
+	for _, rule := range []ingressRule{
+		{host: "api.example.com", path: "/v1", pathType: "Prefix", backend: ingressBackend{service: "v1"}},
+		{host: "api.example.com", path: "/v1/admin", pathType: "Prefix", backend: ingressBackend{service: "admin"}},
+		{host: "api.example.com", path: "/v1", pathType: "Exact", backend: ingressBackend{service: "v1-root"}},
+		{host: "*.example.com", path: "/", pathType: "Prefix", backend: ingressBackend{service: "wildcard"}},
+		{path: "/", backend: ingressBackend{service: "default"}},
+	} {
+		router.routes = append(router.routes, ingressRoute{ingressRule: rule})
+	}
+
+	tests := []struct {
+		description string
+		host        string
+		path        string
+		expected    string
+	}{
+		{description: "prefix", host: "api.example.com", path: "/v1/users", expected: "v1"},
+		{description: "prefix matches path elements", host: "api.example.com", path: "/v1users", expected: "wildcard"},
+		{description: "longest prefix", host: "api.example.com", path: "/v1/admin/users", expected: "admin"},
+		{description: "exact wins over prefix", host: "api.example.com", path: "/v1", expected: "v1-root"},
+		{description: "port is ignored", host: "api.example.com:8080", path: "/v1/users", expected: "v1"},
+		{description: "localhost alias", host: "api.localhost:8080", path: "/v1/users", expected: "v1"},
+		{description: "wildcard", host: "www.example.com", path: "/index.html", expected: "wildcard"},
+		{description: "wildcard matches a single label", host: "a.b.example.com", path: "/", expected: "default"},
+		{description: "default backend", host: "127.0.0.1:8080", path: "/", expected: "default"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			route := router.match(test.host, test.path)
+
+			t.CheckDeepEqual(test.expected, route.backend.service)
+		})
+	}
+}
+
+func TestIngressForwarder(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		var backendPorts []int
+		for _, name := range []string{"api-v1", "api-v2"} {
+			name := name
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, "%s %s%s", name, r.Host, r.URL.Path)
+			}))
+			defer server.Close()
+			backendPorts = append(backendPorts, server.Listener.Addr().(*net.TCPAddr).Port)
+		}
+		proxyPort := util.GetAvailablePort(util.Loopback, 0, &util.PortSet{})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, append([]int{proxyPort}, backendPorts...)))
+		t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset(ingressV1(networkingv1.IngressRule{
+			Host: "api.example.com",
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{
+				pathV1("/v1", networkingv1.PathTypePrefix, "api-v1", networkingv1.ServiceBackendPort{Number: 80}),
+				pathV1("/v2", networkingv1.PathTypePrefix, "api-v2", networkingv1.ServiceBackendPort{Number: 80}),
+			}}},
+		}))))
+
+		fakeForwarder := newTestForwarder()
+		f := NewIngressForwarder(NewEntryManager(fakeForwarder), []*latestV1.PortForwardResource{
+			{Type: constants.Ingress, Name: "missing"},
+			{Type: constants.Ingress, Name: "web"},
+		})
+		// an Ingress that can't be read doesn't prevent the others from being forwarded.
+		t.CheckNoError(f.Start(context.Background(), ioutil.Discard, []string{"ns"}))
+		defer f.Stop()
+
+		get := func(host, path string) (int, string) {
+			req, err := http.NewRequest("GET", fmt.Sprintf("http://127.0.0.1:%d%s", proxyPort, path), nil)
+			t.CheckNoError(err)
+			req.Host = host
+			resp, err := http.DefaultClient.Do(req)
+			t.CheckNoError(err)
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			t.CheckNoError(err)
+			return resp.StatusCode, string(body)
+		}
+
+		status, body := get("api.localhost:8080", "/v1/users")
+		t.CheckDeepEqual(http.StatusOK, status)
+		t.CheckDeepEqual("api-v1 api.localhost:8080/v1/users", body)
+
+		status, body = get("api.example.com", "/v2")
+		t.CheckDeepEqual(http.StatusOK, status)
+		t.CheckDeepEqual("api-v2 api.example.com/v2", body)
+
+		status, _ = get("api.example.com", "/v3")
+		t.CheckDeepEqual(http.StatusNotFound, status)
+	})
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	k8sportforward "k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ErrorReason describes why the NativeForwarder failed to forward a port.
+type ErrorReason string
+
+const (
+	// ReasonPortTaken is reported when the local port is used by another process.
+	ReasonPortTaken ErrorReason = "PortTaken"
+	// ReasonPodNotFound is reported when no running pod backs the forwarded resource.
+	ReasonPodNotFound ErrorReason = "PodNotFound"
+	// ReasonUpgradeFailed is reported when the connection to the API server can't be upgraded to a port-forward stream.
+	ReasonUpgradeFailed ErrorReason = "UpgradeFailed"
+	// ReasonUnknown is reported for any other error.
+	ReasonUnknown ErrorReason = "Unknown"
+)
+
+// ForwardError is the error reported when the NativeForwarder fails to forward a port.
+type ForwardError struct {
+	Reason   ErrorReason
+	Resource string
+	Err      error
+}
+
+func (e *ForwardError) Error() string {
+	return fmt.Sprintf("port forwarding %s failed (%s): %v", e.Resource, e.Reason, e.Err)
+}
+
+func (e *ForwardError) Unwrap() error {
+	return e.Err
+}
+
+// forwardErrorReason returns the reason of a ForwardError, or ReasonUnknown for any other error.
+func forwardErrorReason(err error) ErrorReason {
+	var fErr *ForwardError
+	if errors.As(err, &fErr) {
+		return fErr.Reason
+	}
+	return ReasonUnknown
+}
+
+// NativeForwarder forwards ports in-process with client-go's port-forward API,
+// instead of running a `kubectl port-forward` process per entry.
+// It reconnects, to the newest pod of the resource, whenever the connection to a pod is lost.
+type NativeForwarder struct {
+	started int32
+	out     io.Writer
+}
+
+// NewNativeForwarder returns a new NativeForwarder
+func NewNativeForwarder() *NativeForwarder {
+	return &NativeForwarder{}
+}
+
+// For testing
+var (
+	findNewestPodForResource = findNewestPod
+	forwardPod               = spdyForwardPod
+	waitReconnect            = 1 * time.Second
+)
+
+func (n *NativeForwarder) Start(out io.Writer) {
+	atomic.StoreInt32(&n.started, 1)
+	n.out = out
+}
+
+// Forward forwards a port in the background, and returns once the port is forwarded
+// or the first attempt failed. It keeps retrying until the entry is terminated.
+func (n *NativeForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	errChan := make(chan error, 1)
+	go n.forward(parentCtx, pfe, errChan)
+	return <-errChan
+}
+
+func (n *NativeForwarder) forward(parentCtx context.Context, pfe *portForwardEntry, errChan chan error) {
+	if atomic.LoadInt32(&n.started) == 0 {
+		errChan <- fmt.Errorf("Forward() called before native forwarder was started")
+		return
+	}
+	defer deferFunc()
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	pfe.terminationLock.Lock()
+	if pfe.terminated {
+		pfe.terminationLock.Unlock()
+		cancel()
+		errChan <- nil
+		return
+	}
+	pfe.cancel = cancel
+	pfe.terminationLock.Unlock()
+
+	report := func(err error) {
+		select {
+		case errChan <- err:
+		default:
+		}
+	}
+
+	var reported, failing bool
+	for {
+		if ctx.Err() != nil {
+			logrus.Debugf("port forwarding %v was cancelled...", pfe)
+			report(nil)
+			return
+		}
+
+		err := n.forwardOnce(ctx, pfe, func(podName string) {
+			if !reported {
+				reported = true
+				report(nil)
+			} else if failing {
+				output.Green.Fprintf(n.out, "port forwarding %v recovered on pod %s\n", pfe, podName)
+				portForwardEventV2(pfe)
+			}
+			failing = false
+		})
+		switch {
+		case ctx.Err() != nil:
+			continue
+		case err == nil:
+			logrus.Debugf("lost connection to pod for %v, reconnecting", pfe)
+		case !reported:
+			reported = true
+			failing = true
+			report(err)
+		case !failing:
+			failing = true
+			output.Red.Fprintf(n.out, "%v, retrying...\n", err)
+			portForwardFailedEventV2(pfe, err)
+		default:
+			logrus.Debugf("%v, retrying...", err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(waitReconnect):
+		}
+	}
+}
+
+// forwardOnce forwards the entry's port to the newest pod of its resource until the
+// connection is lost or the context is cancelled. ready is called once the port is forwarded.
+func (n *NativeForwarder) forwardOnce(ctx context.Context, pfe *portForwardEntry, ready func(podName string)) error {
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	if !isPortFree(address, pfe.localPort) {
+		return &ForwardError{Reason: ReasonPortTaken, Resource: pfe.String(), Err: fmt.Errorf("port %d is taken", pfe.localPort)}
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return &ForwardError{Reason: ReasonUnknown, Resource: pfe.String(), Err: err}
+	}
+	podName, remotePort, err := findNewestPodForResource(ctx, client, pfe)
+	if err != nil {
+		return &ForwardError{Reason: ReasonPodNotFound, Resource: pfe.String(), Err: err}
+	}
+
+	logrus.Debugf("Forwarding %v to pod %s/%d on %s:%d", pfe, podName, remotePort, address, pfe.localPort)
+	readyChan := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- forwardPod(ctx, client, pfe.resource.Namespace, podName, address, pfe.localPort, remotePort, readyChan)
+	}()
+
+	select {
+	case <-readyChan:
+		ready(podName)
+		err = <-errChan
+	case err = <-errChan:
+		select {
+		case <-readyChan:
+			ready(podName)
+		default:
+		}
+	}
+
+	if err != nil {
+		reason := ReasonUnknown
+		switch {
+		case strings.Contains(err.Error(), "error upgrading connection"):
+			reason = ReasonUpgradeFailed
+		case strings.Contains(err.Error(), "unable to listen"):
+			reason = ReasonPortTaken
+		}
+		return &ForwardError{Reason: reason, Resource: pfe.String(), Err: err}
+	}
+	return nil
+}
+
+// spdyForwardPod forwards a local port to a pod's port until the connection is lost or the context is cancelled.
+func spdyForwardPod(ctx context.Context, client kubernetes.Interface, ns, podName, address string, localPort, remotePort int, readyChan chan struct{}) error {
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for port forwarding: %w", err)
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return err
+	}
+	url := client.CoreV1().RESTClient().Post().Resource("pods").Namespace(ns).Name(podName).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopChan := make(chan struct{})
+	ports := []string{fmt.Sprintf("%d:%d", localPort, remotePort)}
+	fw, err := k8sportforward.NewOnAddresses(dialer, []string{address}, ports, stopChan, readyChan, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return err
+	}
+
+	errChan := make(chan error, 1)
+	go func() { errChan <- fw.ForwardPorts() }()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		close(stopChan)
+		return <-errChan
+	}
+}
+
+// Terminate stops forwarding a port.
+func (*NativeForwarder) Terminate(p *portForwardEntry) {
+	logrus.Debugf("Terminating port-forward %v", p)
+
+	p.terminationLock.Lock()
+	defer p.terminationLock.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.terminated = true
+}
+
+// findNewestPod finds the newest running pod of the forwarded resource, and the pod's port to forward to.
+func findNewestPod(ctx context.Context, client kubernetes.Interface, pfe *portForwardEntry) (string, int, error) {
+	ns, name := pfe.resource.Namespace, pfe.resource.Name
+
+	var selector labels.Selector
+	switch strings.ToLower(string(pfe.resource.Type)) {
+	case "pod":
+		pod, err := client.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting pod %s/%s: %w", ns, name, err)
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return "", -1, fmt.Errorf("pod %s/%s is not running", ns, name)
+		}
+		port, err := findContainerPort(*pod, pfe.resource.Port)
+		return name, port, err
+
+	case "service":
+		return findNewestPodForSvc(ctx, ns, name, pfe.resource.Port)
+
+	case "deployment":
+		d, err := client.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting deployment %s/%s: %w", ns, name, err)
+		}
+		if selector, err = metav1.LabelSelectorAsSelector(d.Spec.Selector); err != nil {
+			return "", -1, err
+		}
+
+	case "replicaset":
+		rs, err := client.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting replicaset %s/%s: %w", ns, name, err)
+		}
+		if selector, err = metav1.LabelSelectorAsSelector(rs.Spec.Selector); err != nil {
+			return "", -1, err
+		}
+
+	case "statefulset":
+		sts, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting statefulset %s/%s: %w", ns, name, err)
+		}
+		if selector, err = metav1.LabelSelectorAsSelector(sts.Spec.Selector); err != nil {
+			return "", -1, err
+		}
+
+	case "daemonset":
+		ds, err := client.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting daemonset %s/%s: %w", ns, name, err)
+		}
+		if selector, err = metav1.LabelSelectorAsSelector(ds.Spec.Selector); err != nil {
+			return "", -1, err
+		}
+
+	case "replicationcontroller":
+		rc, err := client.CoreV1().ReplicationControllers(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting replicationcontroller %s/%s: %w", ns, name, err)
+		}
+		selector = labels.SelectorFromSet(rc.Spec.Selector)
+
+	case "job":
+		job, err := client.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", -1, fmt.Errorf("getting job %s/%s: %w", ns, name, err)
+		}
+		if selector, err = metav1.LabelSelectorAsSelector(job.Spec.Selector); err != nil {
+			return "", -1, err
+		}
+
+	default:
+		return "", -1, fmt.Errorf("resource type %q is not supported by the native port forwarder", pfe.resource.Type)
+	}
+
+	podsList, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", -1, fmt.Errorf("listing pods: %w", err)
+	}
+	var pods []corev1.Pod
+	for _, pod := range podsList.Items {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, newestPodsFirst(pods))
+
+	for _, p := range pods {
+		if port, err := findContainerPort(p, pfe.resource.Port); err == nil {
+			return p.Name, port, nil
+		}
+	}
+	return "", -1, fmt.Errorf("no running pods of %s/%s expose port %s", pfe.resource.Type, name, pfe.resource.Port.String())
+}
+
+// findContainerPort resolves a port number or a named port of a pod's containers.
+func findContainerPort(pod corev1.Pod, port schemautil.IntOrString) (int, error) {
+	if port.Type == schemautil.Int {
+		return port.IntVal, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return int(p.ContainerPort), nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("pod %s does not expose port %s", pod.Name, port.String())
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func webPod(name string, phase corev1.PodPhase, created time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: map[string]string{"app": "web"}, CreationTimestamp: metav1.NewTime(created)},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "web",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+		}}},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestFindNewestPod(t *testing.T) {
+	now := time.Now()
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+	}
+
+	tests := []struct {
+		description  string
+		resource     latestV1.PortForwardResource
+		objects      []pkgruntime.Object
+		expectedPod  string
+		expectedPort int
+		shouldErr    bool
+	}{
+		{
+			description:  "pod with port number",
+			resource:     latestV1.PortForwardResource{Type: "pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromInt(9000)},
+			objects:      []pkgruntime.Object{webPod("web-1", corev1.PodRunning, now)},
+			expectedPod:  "web-1",
+			expectedPort: 9000,
+		},
+		{
+			description:  "pod with named port",
+			resource:     latestV1.PortForwardResource{Type: "Pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects:      []pkgruntime.Object{webPod("web-1", corev1.PodRunning, now)},
+			expectedPod:  "web-1",
+			expectedPort: 8080,
+		},
+		{
+			description: "pod not running",
+			resource:    latestV1.PortForwardResource{Type: "pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			objects:     []pkgruntime.Object{webPod("web-1", corev1.PodPending, now)},
+			shouldErr:   true,
+		},
+		{
+			description: "newest running pod of deployment",
+			resource:    latestV1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects: []pkgruntime.Object{
+				deployment,
+				webPod("web-old", corev1.PodRunning, now.Add(-time.Hour)),
+				webPod("web-new", corev1.PodRunning, now.Add(-time.Minute)),
+				webPod("web-pending", corev1.PodPending, now),
+			},
+			expectedPod:  "web-new",
+			expectedPort: 8080,
+		},
+		{
+			description: "no running pod of deployment",
+			resource:    latestV1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			objects:     []pkgruntime.Object{deployment, webPod("web-pending", corev1.PodPending, now)},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported resource type",
+			resource:    latestV1.PortForwardResource{Type: "cronjob", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objects...)
+			pfe := newPortForwardEntry(0, test.resource, "", "", "", "", 9000, false)
+
+			pod, port, err := findNewestPod(context.Background(), client, pfe)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedPod, pod)
+				t.CheckDeepEqual(test.expectedPort, port)
+			}
+		})
+	}
+}
+
+func TestNativeForwarderReconnects(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset()))
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&waitReconnect, time.Duration(0))
+		done := make(chan struct{})
+		t.Override(&deferFunc, func() { close(done) })
+
+		pods := []string{"web-1", "web-2"}
+		var lock sync.Mutex
+		var forwarded []string
+		t.Override(&findNewestPodForResource, func(context.Context, kubernetes.Interface, *portForwardEntry) (string, int, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			pod := pods[0]
+			if len(pods) > 1 {
+				pods = pods[1:]
+			}
+			return pod, 8080, nil
+		})
+		reconnected := make(chan struct{})
+		t.Override(&forwardPod, func(ctx context.Context, _ kubernetes.Interface, _, podName, _ string, _, _ int, readyChan chan struct{}) error {
+			lock.Lock()
+			forwarded = append(forwarded, podName)
+			lock.Unlock()
+			close(readyChan)
+			if podName == "web-1" {
+				// the pod was replaced
+				return nil
+			}
+			close(reconnected)
+			<-ctx.Done()
+			return nil
+		})
+
+		f := NewNativeForwarder()
+		f.Start(ioutil.Discard)
+		pfe := newPortForwardEntry(0, latestV1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)}, "", "", "", "", 9000, false)
+
+		t.CheckNoError(f.Forward(context.Background(), pfe))
+		<-reconnected
+		f.Terminate(pfe)
+		<-done
+
+		lock.Lock()
+		defer lock.Unlock()
+		t.CheckDeepEqual([]string{"web-1", "web-2"}, forwarded)
+	})
+}
+
+func TestNativeForwarderReconnectEvents(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset()))
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&waitReconnect, time.Duration(0))
+		done := make(chan struct{})
+		t.Override(&deferFunc, func() { close(done) })
+
+		var lock sync.Mutex
+		var events []string
+		t.Override(&portForwardEventV2, func(*portForwardEntry) {
+			lock.Lock()
+			events = append(events, "forwarded")
+			lock.Unlock()
+		})
+		t.Override(&portForwardFailedEventV2, func(_ *portForwardEntry, err error) {
+			lock.Lock()
+			events = append(events, "failed: "+string(forwardErrorReason(err)))
+			lock.Unlock()
+		})
+
+		pods := []string{"web-1", "web-2"}
+		t.Override(&findNewestPodForResource, func(context.Context, kubernetes.Interface, *portForwardEntry) (string, int, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			pod := pods[0]
+			if len(pods) > 1 {
+				pods = pods[1:]
+			}
+			return pod, 8080, nil
+		})
+		reconnected := make(chan struct{})
+		t.Override(&forwardPod, func(ctx context.Context, _ kubernetes.Interface, _, podName, _ string, _, _ int, readyChan chan struct{}) error {
+			close(readyChan)
+			if podName == "web-1" {
+				return errors.New("lost connection to pod")
+			}
+			close(reconnected)
+			<-ctx.Done()
+			return nil
+		})
+
+		f := NewNativeForwarder()
+		f.Start(ioutil.Discard)
+		pfe := newPortForwardEntry(0, latestV1.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)}, "", "", "", "", 9000, false)
+
+		t.CheckNoError(f.Forward(context.Background(), pfe))
+		<-reconnected
+		f.Terminate(pfe)
+		<-done
+
+		lock.Lock()
+		defer lock.Unlock()
+		t.CheckDeepEqual([]string{"failed: Unknown", "forwarded"}, events)
+	})
+}
+
+func TestNativeForwarderErrors(t *testing.T) {
+	tests := []struct {
+		description    string
+		portFree       bool
+		findErr        error
+		forwardErr     error
+		expectedReason ErrorReason
+	}{
+		{
+			description:    "port taken",
+			expectedReason: ReasonPortTaken,
+		},
+		{
+			description:    "no pod",
+			portFree:       true,
+			findErr:        errors.New("no running pods"),
+			expectedReason: ReasonPodNotFound,
+		},
+		{
+			description:    "upgrade failed",
+			portFree:       true,
+			forwardErr:     errors.New("error upgrading connection: unauthorized"),
+			expectedReason: ReasonUpgradeFailed,
+		},
+		{
+			description:    "unknown",
+			portFree:       true,
+			forwardErr:     errors.New("boom"),
+			expectedReason: ReasonUnknown,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset()))
+			t.Override(&isPortFree, func(string, int) bool { return test.portFree })
+			t.Override(&waitReconnect, time.Hour)
+			done := make(chan struct{})
+			t.Override(&deferFunc, func() { close(done) })
+			t.Override(&findNewestPodForResource, func(context.Context, kubernetes.Interface, *portForwardEntry) (string, int, error) {
+				return "web-1", 8080, test.findErr
+			})
+			t.Override(&forwardPod, func(context.Context, kubernetes.Interface, string, string, string, int, int, chan struct{}) error {
+				return test.forwardErr
+			})
+
+			f := NewNativeForwarder()
+			f.Start(ioutil.Discard)
+			pfe := newPortForwardEntry(0, latestV1.PortForwardResource{Type: "pod", Name: "web-1", Namespace: "ns", Port: schemautil.FromInt(8080)}, "", "", "", "", 9000, false)
+
+			err := f.Forward(context.Background(), pfe)
+			f.Terminate(pfe)
+			<-done
+
+			var forwardErr *ForwardError
+			t.CheckTrue(errors.As(err, &forwardErr))
+			t.CheckDeepEqual(test.expectedReason, forwardErr.Reason)
+		})
+	}
+}
@@ -151,7 +151,7 @@ func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, &p.entryManager.forwardedPorts)
+	entry.localPort = p.entryManager.allocateLocalPort(p.output, entry, resource.Port.IntVal)
 
 	return entry, nil
 }
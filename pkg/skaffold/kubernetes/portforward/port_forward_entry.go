@@ -33,6 +33,7 @@ type portForwardEntry struct {
 	portName               string
 	ownerReference         string
 	localPort              int
+	reservedPort           int // set when the local port reserved in a previous session was taken
 	automaticPodForwarding bool
 	terminated             bool
 	terminationLock        sync.Mutex
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+)
+
+var (
+	// For testing
+	readPortReservations  = config.GetPortReservations
+	writePortReservations = config.UpdatePortReservations
+)
+
+// PortReservations keeps track of the local ports that resources are forwarded to,
+// so that they are forwarded to the same ports in the next sessions.
+// Reservations are persisted per project in the global Skaffold config.
+// A nil *PortReservations doesn't reserve any port.
+type PortReservations struct {
+	lock       sync.Mutex
+	configFile string
+	project    string
+	ports      map[string]int
+	loaded     bool
+}
+
+// NewPortReservations returns the port reservations of the project defined by the given skaffold config.
+func NewPortReservations(globalConfig, skaffoldConfig string) *PortReservations {
+	project, err := filepath.Abs(skaffoldConfig)
+	if err != nil {
+		project = skaffoldConfig
+	}
+	return &PortReservations{
+		configFile: globalConfig,
+		project:    project,
+	}
+}
+
+// Get returns the local port reserved for a port forward entry.
+func (r *PortReservations) Get(key string) (int, bool) {
+	if r == nil {
+		return 0, false
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.load()
+	port, found := r.ports[key]
+	return port, found
+}
+
+// Reserve reserves a local port for a port forward entry, unless a port was already reserved for it.
+func (r *PortReservations) Reserve(key string, port int) {
+	if r == nil || port <= 0 {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.load()
+	if _, found := r.ports[key]; found {
+		return
+	}
+	r.ports[key] = port
+	if err := writePortReservations(r.configFile, r.project, r.ports); err != nil {
+		logrus.Warnf("Unable to persist the local port reserved for %s: %v", key, err)
+	}
+}
+
+func (r *PortReservations) load() {
+	if r.loaded {
+		return
+	}
+	r.loaded = true
+
+	ports, err := readPortReservations(r.configFile, r.project)
+	if err != nil {
+		logrus.Debugf("Unable to read the reserved local ports: %v", err)
+	}
+	r.ports = map[string]int{}
+	for k, v := range ports {
+		r.ports[k] = v
+	}
+}
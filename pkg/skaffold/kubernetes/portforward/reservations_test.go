@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPortReservations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		project, err := filepath.Abs("skaffold.yaml")
+		t.CheckNoError(err)
+
+		var written []map[string]int
+		t.Override(&readPortReservations, func(configFile, p string) (map[string]int, error) {
+			t.CheckDeepEqual("global-config", configFile)
+			t.CheckDeepEqual(project, p)
+			return map[string]int{"service-web-default-8080": 9000}, nil
+		})
+		t.Override(&writePortReservations, func(_, _ string, ports map[string]int) error {
+			copied := map[string]int{}
+			for k, v := range ports {
+				copied[k] = v
+			}
+			written = append(written, copied)
+			return nil
+		})
+
+		r := NewPortReservations("global-config", "skaffold.yaml")
+		port, found := r.Get("service-web-default-8080")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(9000, port)
+
+		r.Reserve("service-web-default-8080", 9001)
+		r.Reserve("service-db-default-5432", 5432)
+		port, _ = r.Get("service-web-default-8080")
+		t.CheckDeepEqual(9000, port)
+
+		// only new reservations are persisted
+		t.CheckDeepEqual([]map[string]int{{"service-web-default-8080": 9000, "service-db-default-5432": 5432}}, written)
+
+		var none *PortReservations
+		_, found = none.Get("service-web-default-8080")
+		t.CheckFalse(found)
+		none.Reserve("service-web-default-8080", 9000)
+	})
+}
+
+func TestAllocateLocalPort(t *testing.T) {
+	tests := []struct {
+		description      string
+		localPort        int
+		reserved         map[string]int
+		taken            map[int]struct{}
+		expectedPort     int
+		expectedReserved int
+		expectedWarning  string
+	}{
+		{
+			description:  "no reservation",
+			expectedPort: 8080,
+		},
+		{
+			description:  "reserved port",
+			reserved:     map[string]int{"service-web-default-8080": 9000},
+			expectedPort: 9000,
+		},
+		{
+			description:      "reserved port is taken",
+			reserved:         map[string]int{"service-web-default-8080": 9000},
+			taken:            map[int]struct{}{9000: {}},
+			expectedPort:     9001,
+			expectedReserved: 9000,
+			expectedWarning:  "Local port 9000 reserved for service/web in namespace default is taken, forwarding to local port 9001 instead.\n",
+		},
+		{
+			description:  "explicit local port wins",
+			localPort:    7000,
+			reserved:     map[string]int{"service-web-default-8080": 9000},
+			expectedPort: 7000,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&readPortReservations, func(string, string) (map[string]int, error) { return test.reserved, nil })
+			t.Override(&writePortReservations, func(string, string, map[string]int) error { return nil })
+			t.Override(&retrieveAvailablePort, func(_ string, port int, _ *util.PortSet) int {
+				for ; ; port++ {
+					if _, taken := test.taken[port]; !taken {
+						return port
+					}
+				}
+			})
+
+			em := NewEntryManager(newTestForwarder())
+			em.reservations = NewPortReservations("", "skaffold.yaml")
+			entry := newPortForwardEntry(0, latestV1.PortForwardResource{
+				Type:      constants.Service,
+				Name:      "web",
+				Namespace: "default",
+				Port:      schemautil.FromInt(8080),
+				LocalPort: test.localPort,
+			}, "", "", "", "", 0, false)
+			requestPort := test.localPort
+			if requestPort == 0 {
+				requestPort = 8080
+			}
+
+			var out bytes.Buffer
+			port := em.allocateLocalPort(&out, entry, requestPort)
+
+			t.CheckDeepEqual(test.expectedPort, port)
+			t.CheckDeepEqual(test.expectedReserved, entry.reservedPort)
+			t.CheckDeepEqual(test.expectedWarning, out.String())
+			reserved, _ := em.reservations.Get(entry.key())
+			if test.reserved == nil {
+				t.CheckDeepEqual(test.expectedPort, reserved)
+			}
+		})
+	}
+}
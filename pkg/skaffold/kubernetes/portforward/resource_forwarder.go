@@ -68,29 +68,11 @@ func NewUserDefinedForwarder(entryManager *EntryManager, userDefinedResources []
 // forwards them.
 func (p *ResourceForwarder) Start(ctx context.Context, out io.Writer, namespaces []string) error {
 	p.output = out
-	if len(namespaces) == 1 {
-		for _, pf := range p.userDefinedResources {
-			if err := applyWithTemplate(pf); err != nil {
-				return err
-			}
-			if pf.Namespace == "" {
-				pf.Namespace = namespaces[0]
-			}
-		}
-	} else {
-		var validResources []*latestV1.PortForwardResource
-		for _, pf := range p.userDefinedResources {
-			if pf.Namespace != "" {
-				if err := applyWithTemplate(pf); err != nil {
-					return err
-				}
-				validResources = append(validResources, pf)
-			} else {
-				logrus.Warnf("Skipping the port forwarding resource %s/%s because namespace is not specified", pf.Type, pf.Name)
-			}
-		}
-		p.userDefinedResources = validResources
+	userDefinedResources, err := resolveUserDefinedResources(p.userDefinedResources, namespaces)
+	if err != nil {
+		return err
 	}
+	p.userDefinedResources = userDefinedResources
 
 	var serviceResources []*latestV1.PortForwardResource
 	if p.services {
@@ -104,6 +86,35 @@ func (p *ResourceForwarder) Start(ctx context.Context, out io.Writer, namespaces
 	return nil
 }
 
+// resolveUserDefinedResources expands the templates of user defined resources and defaults their namespace.
+// With several namespaces, resources without a namespace are skipped.
+func resolveUserDefinedResources(resources []*latestV1.PortForwardResource, namespaces []string) ([]*latestV1.PortForwardResource, error) {
+	if len(namespaces) == 1 {
+		for _, pf := range resources {
+			if err := applyWithTemplate(pf); err != nil {
+				return nil, err
+			}
+			if pf.Namespace == "" {
+				pf.Namespace = namespaces[0]
+			}
+		}
+		return resources, nil
+	}
+
+	var validResources []*latestV1.PortForwardResource
+	for _, pf := range resources {
+		if pf.Namespace != "" {
+			if err := applyWithTemplate(pf); err != nil {
+				return nil, err
+			}
+			validResources = append(validResources, pf)
+		} else {
+			logrus.Warnf("Skipping the port forwarding resource %s/%s because namespace is not specified", pf.Type, pf.Name)
+		}
+	}
+	return validResources, nil
+}
+
 func applyWithTemplate(resource *latestV1.PortForwardResource) error {
 	if resource.Namespace != "" {
 		namespace, err := util.ExpandEnvTemplateOrFail(resource.Namespace, nil)
@@ -157,7 +168,7 @@ func (p *ResourceForwarder) getCurrentEntry(resource latestV1.PortForwardResourc
 	if requestPort == 0 && resource.Port.IntVal >= 1024 {
 		requestPort = resource.Port.IntVal
 	}
-	entry.localPort = retrieveAvailablePort(resource.Address, requestPort, &p.entryManager.forwardedPorts)
+	entry.localPort = p.entryManager.allocateLocalPort(p.output, entry, requestPort)
 	return entry
 }
 
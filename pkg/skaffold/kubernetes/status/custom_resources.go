@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/resource"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// configConnectorGroupSuffix identifies the API groups of Config Connector resources,
+// which report their health with a `Ready` condition.
+const configConnectorGroupSuffix = ".cnrm.cloud.google.com"
+
+// customResourceType is a kind of custom resource that participates in the status check.
+// A nil rule means the resource is evaluated with its `Ready` condition.
+type customResourceType struct {
+	gvr  schema.GroupVersionResource
+	rule *latestV1.StatusCheckRule
+}
+
+// getCustomResources lists the Config Connector resources and the resources matching a
+// status check rule that were deployed in the current run.
+// API groups are discovered on each call so that CRDs installed by the deployment itself are picked up.
+func getCustomResources(ctx context.Context, disco discovery.DiscoveryInterface, client dynamic.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, rules []latestV1.StatusCheckRule) ([]*resource.Resource, error) {
+	types, err := customResourceTypes(disco, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []*resource.Resource
+	for _, t := range types {
+		list, err := client.Resource(t.gvr).Namespace(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: l.RunIDSelector(),
+		})
+		if isNotListable(err) {
+			logrus.Debugf("skipping status check of %s: %v", t.gvr.GroupResource(), err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch %s: %w", t.gvr.GroupResource(), err)
+		}
+		for _, item := range list.Items {
+			kind := strings.ToLower(item.GetKind())
+			r := resource.NewCustomResource(item.GetName(), kind, t.gvr.GroupResource().String(), item.GetNamespace(), deadlineDuration)
+			if t.rule != nil {
+				r = r.WithStatusCheckRule(*t.rule)
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func customResourceTypes(disco discovery.DiscoveryInterface, rules []latestV1.StatusCheckRule) ([]customResourceType, error) {
+	groups, err := disco.ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover API groups: %w", err)
+	}
+
+	var types []customResourceType
+	seen := map[schema.GroupResource]bool{}
+	for i := range rules {
+		rule := rules[i]
+		gvr, found := resolveRule(disco, groups, rule)
+		if !found || seen[gvr.GroupResource()] {
+			continue
+		}
+		seen[gvr.GroupResource()] = true
+		types = append(types, customResourceType{gvr: gvr, rule: &rule})
+	}
+
+	for _, g := range groups.Groups {
+		if !strings.HasSuffix(g.Name, configConnectorGroupSuffix) {
+			continue
+		}
+		gv := g.PreferredVersion.GroupVersion
+		list, err := disco.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			logrus.Debugf("could not discover resources for %s: %v", gv, err)
+			continue
+		}
+		for _, r := range list.APIResources {
+			if !r.Namespaced || strings.Contains(r.Name, "/") || !hasVerb(r, "list") {
+				continue
+			}
+			gvr := schema.GroupVersionResource{Group: g.Name, Version: versionOf(gv), Resource: r.Name}
+			if seen[gvr.GroupResource()] {
+				continue
+			}
+			seen[gvr.GroupResource()] = true
+			types = append(types, customResourceType{gvr: gvr})
+		}
+	}
+	return types, nil
+}
+
+// resolveRule finds the namespaced resource matching the group, version and kind of a status check rule.
+// Rules for kinds that aren't installed on the cluster are ignored.
+func resolveRule(disco discovery.DiscoveryInterface, groups *metav1.APIGroupList, rule latestV1.StatusCheckRule) (schema.GroupVersionResource, bool) {
+	version := rule.Version
+	if version == "" {
+		version = preferredVersion(groups, rule.Group)
+	}
+	if version == "" {
+		logrus.Debugf("no API group %q found for status check rule of kind %q", rule.Group, rule.Kind)
+		return schema.GroupVersionResource{}, false
+	}
+
+	gv := schema.GroupVersion{Group: rule.Group, Version: version}.String()
+	list, err := disco.ServerResourcesForGroupVersion(gv)
+	if err != nil {
+		logrus.Debugf("could not discover resources for %s: %v", gv, err)
+		return schema.GroupVersionResource{}, false
+	}
+	for _, r := range list.APIResources {
+		if r.Kind != rule.Kind || strings.Contains(r.Name, "/") {
+			continue
+		}
+		if !r.Namespaced {
+			logrus.Debugf("ignoring status check rule for cluster scoped kind %q", rule.Kind)
+			return schema.GroupVersionResource{}, false
+		}
+		return schema.GroupVersionResource{Group: rule.Group, Version: version, Resource: r.Name}, true
+	}
+	logrus.Debugf("no resource of kind %q found in %s", rule.Kind, gv)
+	return schema.GroupVersionResource{}, false
+}
+
+func preferredVersion(groups *metav1.APIGroupList, group string) string {
+	if group == "" {
+		return "v1"
+	}
+	for _, g := range groups.Groups {
+		if g.Name == group {
+			return versionOf(g.PreferredVersion.GroupVersion)
+		}
+	}
+	return ""
+}
+
+func hasVerb(r metav1.APIResource, verb string) bool {
+	for _, v := range r.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+func versionOf(groupVersion string) string {
+	gv, err := schema.ParseGroupVersion(groupVersion)
+	if err != nil {
+		return groupVersion
+	}
+	return gv.Version
+}
@@ -27,7 +27,9 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/singleflight"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/diag"
@@ -43,6 +45,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
@@ -73,6 +76,7 @@ type Config interface {
 	kubectl.Config
 
 	StatusCheckDeadlineSeconds() int
+	StatusCheckRules() []latestV1.StatusCheckRule
 	Muted() config.Muted
 	StatusCheck() *bool
 }
@@ -137,14 +141,19 @@ func (s *Monitor) statusCheck(ctx context.Context, out io.Writer) (proto.StatusC
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, 0)
+	dynClient, err := kubernetesclient.DynamicClient()
+	if err != nil {
+		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
+
+	deployments := make([]*resource.Resource, 0)
 	for _, n := range *s.namespaces {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
-			getDeadline(s.deadlineSeconds))
+		newResources, err := getResources(ctx, client, dynClient, n, s.labeller,
+			getDeadline(s.deadlineSeconds), s.cfg.StatusCheckRules())
 		if err != nil {
 			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
 		}
-		for _, d := range newDeployments {
+		for _, d := range newResources {
 			if s.seenResources.Contains(d) {
 				continue
 			}
@@ -162,7 +171,7 @@ func (s *Monitor) statusCheck(ctx context.Context, out io.Writer) (proto.StatusC
 
 	for _, d := range deployments {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
 			pollDeploymentStatus(ctx, s.cfg, r)
@@ -186,7 +195,46 @@ func (s *Monitor) statusCheck(ctx context.Context, out io.Writer) (proto.StatusC
 	return getSkaffoldDeployStatus(c, deployments)
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+// getResources lists all the resources deployed in the current run that participate in the status check.
+// Deployments are always checked. Other kinds of resources are skipped if they can't be listed,
+// e.g. because of RBAC rules or because the kind doesn't exist in the cluster.
+func getResources(ctx context.Context, client kubernetes.Interface, dynClient dynamic.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, rules []latestV1.StatusCheckRule) ([]*resource.Resource, error) {
+	resources, err := getDeployments(ctx, client, ns, l, deadlineDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, get := range []func(context.Context, kubernetes.Interface, string, *label.DefaultLabeller, time.Duration) ([]*resource.Resource, error){
+		getStatefulSets,
+		getDaemonSets,
+		getJobs,
+		getStandalonePods,
+	} {
+		rs, err := get(ctx, client, ns, l, deadlineDuration)
+		if isNotListable(err) {
+			logrus.Debugf("skipping status check: %v", err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, rs...)
+	}
+
+	crs, err := getCustomResources(ctx, client.Discovery(), dynClient, ns, l, deadlineDuration, rules)
+	if err != nil {
+		return nil, err
+	}
+	return append(resources, crs...), nil
+}
+
+// isNotListable tells if listing resources failed because the user isn't allowed to
+// or because the kind of resources doesn't exist in the cluster.
+func isNotListable(err error) bool {
+	return apierrors.IsForbidden(err) || apierrors.IsNotFound(err)
+}
+
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -194,7 +242,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -202,20 +250,98 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		pd := newPodValidator(client, d.Namespace, l, d.Spec.Template.Labels)
+		deployments[i] = resource.NewResource(d.Name, resource.ResourceTypes.Deployment, d.Namespace, deadline).WithValidator(pd)
+	}
+	return deployments, nil
+}
+
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
+
+	resources := make([]*resource.Resource, len(sets.Items))
+	for i, s := range sets.Items {
+		pd := newPodValidator(client, s.Namespace, l, s.Spec.Template.Labels)
+		resources[i] = resource.NewResource(s.Name, resource.ResourceTypes.StatefulSet, s.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return resources, nil
+}
+
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
+	}
+
+	resources := make([]*resource.Resource, len(sets.Items))
+	for i, s := range sets.Items {
+		pd := newPodValidator(client, s.Namespace, l, s.Spec.Template.Labels)
+		resources[i] = resource.NewResource(s.Name, resource.ResourceTypes.DaemonSet, s.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return resources, nil
+}
+
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	jobs, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+
+	resources := make([]*resource.Resource, len(jobs.Items))
+	for i, j := range jobs.Items {
+		deadline := deadlineDuration
+		if j.Spec.ActiveDeadlineSeconds != nil {
+			deadline = time.Duration(*j.Spec.ActiveDeadlineSeconds) * time.Second
+		}
+		pd := newPodValidator(client, j.Namespace, l, j.Spec.Template.Labels)
+		resources[i] = resource.NewResource(j.Name, resource.ResourceTypes.Job, j.Namespace, deadline).WithValidator(pd)
+	}
+	return resources, nil
+}
+
+// getStandalonePods lists the pods that are not managed by a controller.
+// Pods owned by a Deployment, StatefulSet, DaemonSet or Job are checked through their owner.
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch pods: %w", err)
+	}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+	var resources []*resource.Resource
+	for _, p := range pods.Items {
+		if len(p.OwnerReferences) > 0 {
+			continue
 		}
+		pd := newPodValidator(client, p.Namespace, l, p.Labels)
+		resources = append(resources, resource.NewResource(p.Name, resource.ResourceTypes.StandalonePod, p.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return resources, nil
+}
+
+// newPodValidator returns a diagnostic for the pods of the current run that match the given pod template labels.
+func newPodValidator(client kubernetes.Interface, ns string, l *label.DefaultLabeller, podLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+	for k, v := range podLabels {
+		pd = pd.WithLabel(k, v)
 	}
-	return deployments, nil
+	return pd
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg kubectl.Config, r *resource.Deployment) {
+func pollDeploymentStatus(ctx context.Context, cfg kubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	ticker := time.NewTicker(pollDuration)
 	defer ticker.Stop()
@@ -257,11 +383,11 @@ func pollDeploymentStatus(ctx context.Context, cfg kubectl.Config, r *resource.D
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed == 0 {
 		return proto.StatusCode_STATUSCHECK_SUCCESS, nil
 	}
-	err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+	err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 	for _, r := range rs {
 		if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS &&
 			r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
@@ -278,7 +404,7 @@ func getDeadline(d int) time.Duration {
 	return DefaultStatusCheckDeadline
 }
 
-func (s *Monitor) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s *Monitor) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
@@ -305,7 +431,7 @@ func (s *Monitor) printStatusCheckSummary(out io.Writer, r *resource.Deployment,
 }
 
 // printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s *Monitor) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+func (s *Monitor) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Resource) {
 	ticker := time.NewTicker(reportStatusTime)
 	defer ticker.Stop()
 	for {
@@ -322,7 +448,7 @@ func (s *Monitor) printDeploymentStatus(ctx context.Context, out io.Writer, depl
 	}
 }
 
-func (s *Monitor) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s *Monitor) printStatus(deployments []*resource.Resource, out io.Writer) bool {
 	allDone := true
 	for _, r := range deployments {
 		if r.IsStatusCheckCompleteOrCancelled() {
@@ -26,9 +26,16 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	utilpointer "k8s.io/utils/pointer"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/diag"
@@ -50,7 +57,7 @@ func TestGetDeployments(t *testing.T) {
 	tests := []struct {
 		description string
 		deps        []*appsv1.Deployment
-		expected    []*resource.Deployment
+		expected    []*resource.Resource
 		shouldErr   bool
 	}{
 		{
@@ -78,9 +85,9 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(20)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 10*time.Second),
-				resource.NewDeployment("dep2", "test", 20*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 10*time.Second),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 20*time.Second),
 			},
 		},
 		{
@@ -98,8 +105,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(300)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 300*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 300*time.Second),
 			},
 		},
 		{
@@ -125,9 +132,9 @@ func TestGetDeployments(t *testing.T) {
 					},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
-				resource.NewDeployment("dep2", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second),
+				resource.NewResource("dep2", resource.ResourceTypes.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
@@ -144,13 +151,13 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(600)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
 			description: "no deployments",
-			expected:    []*resource.Deployment{},
+			expected:    []*resource.Resource{},
 		},
 		{
 			description: "multiple deployments in different namespaces",
@@ -176,8 +183,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.ResourceTypes.Deployment, "test", 100*time.Second),
 			},
 		},
 		{
@@ -194,7 +201,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
@@ -210,7 +217,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 	}
 
@@ -223,7 +230,207 @@ func TestGetDeployments(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
-				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		objs        []runtime.Object
+		crs         []runtime.Object
+		rules       []latestV1.StatusCheckRule
+		expected    []*resource.Resource
+	}{
+		{
+			description: "statefulsets, daemonsets and jobs",
+			objs: []runtime.Object{
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runLabels}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test", Labels: runLabels}},
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "test", Labels: runLabels},
+					Spec:       batchv1.JobSpec{ActiveDeadlineSeconds: utilpointer.Int64Ptr(30)},
+				},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("db", resource.ResourceTypes.StatefulSet, "test", 200*time.Second),
+				resource.NewResource("agent", resource.ResourceTypes.DaemonSet, "test", 200*time.Second),
+				resource.NewResource("migrate", resource.ResourceTypes.Job, "test", 30*time.Second),
+			},
+		},
+		{
+			description: "only standalone pods",
+			objs: []runtime.Object{
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "test", Labels: runLabels}},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:            "dep-xyz",
+					Namespace:       "test",
+					Labels:          runLabels,
+					OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "dep"}},
+				}},
+			},
+			expected: []*resource.Resource{
+				resource.NewResource("standalone", resource.ResourceTypes.StandalonePod, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "config connector resources",
+			crs: []runtime.Object{
+				&unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "sql.cnrm.cloud.google.com/v1beta1",
+					"kind":       "SQLInstance",
+					"metadata": map[string]interface{}{
+						"name":      "db",
+						"namespace": "test",
+						"labels":    map[string]interface{}{label.RunIDLabel: labeller.GetRunID()},
+					},
+				}},
+				&unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "sql.cnrm.cloud.google.com/v1beta1",
+					"kind":       "SQLInstance",
+					"metadata": map[string]interface{}{
+						"name":      "other",
+						"namespace": "test",
+					},
+				}},
+			},
+			expected: []*resource.Resource{
+				resource.NewCustomResource("db", "sqlinstance", "sqlinstances.sql.cnrm.cloud.google.com", "test", 200*time.Second),
+			},
+		},
+		{
+			description: "resources matching status check rules",
+			crs: []runtime.Object{
+				&unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "cert-manager.io/v1",
+					"kind":       "Certificate",
+					"metadata": map[string]interface{}{
+						"name":      "tls",
+						"namespace": "test",
+						"labels":    map[string]interface{}{label.RunIDLabel: labeller.GetRunID()},
+					},
+				}},
+			},
+			rules: []latestV1.StatusCheckRule{
+				{Group: "cert-manager.io", Kind: "Certificate", Failed: &latestV1.StatusCondition{Reason: "Failed"}},
+				{Group: "kafka.strimzi.io", Kind: "KafkaTopic"},
+				{Group: "cert-manager.io", Kind: "Issuer"},
+			},
+			expected: []*resource.Resource{
+				resource.NewCustomResource("tls", "certificate", "certificates.cert-manager.io", "test", 200*time.Second).
+					WithStatusCheckRule(latestV1.StatusCheckRule{Group: "cert-manager.io", Kind: "Certificate", Failed: &latestV1.StatusCondition{Reason: "Failed"}}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objs...)
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "sql.cnrm.cloud.google.com/v1beta1",
+					APIResources: []metav1.APIResource{
+						{Name: "sqlinstances", Kind: "SQLInstance", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "sqlinstances/status", Kind: "SQLInstance", Namespaced: true, Verbs: []string{"get"}},
+					},
+				},
+				{
+					GroupVersion: "cert-manager.io/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "certificates", Kind: "Certificate", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "clusterissuers", Kind: "ClusterIssuer", Verbs: []string{"get", "list"}},
+					},
+				},
+			}
+			dynClient := fakedynclient.NewSimpleDynamicClient(runtime.NewScheme(), test.crs...)
+
+			actual, err := getResources(context.Background(), client, dynClient, "test", labeller, 200*time.Second, test.rules)
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetResourcesNotListable(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "run-id")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		resource    string
+		err         error
+		shouldErr   bool
+		expected    []*resource.Resource
+	}{
+		{
+			description: "forbidden statefulsets are skipped",
+			resource:    "statefulsets",
+			err:         apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "", errors.New("rbac")),
+			expected: []*resource.Resource{
+				resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "missing jobs are skipped",
+			resource:    "jobs",
+			err:         apierrors.NewNotFound(schema.GroupResource{Group: "batch", Resource: "jobs"}, ""),
+			expected: []*resource.Resource{
+				resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", 200*time.Second),
+				resource.NewResource("db", resource.ResourceTypes.StatefulSet, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "forbidden config connector resources are skipped",
+			resource:    "sqlinstances",
+			err:         apierrors.NewForbidden(schema.GroupResource{Group: "sql.cnrm.cloud.google.com", Resource: "sqlinstances"}, "", errors.New("rbac")),
+			expected: []*resource.Resource{
+				resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", 200*time.Second),
+				resource.NewResource("db", resource.ResourceTypes.StatefulSet, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "other errors fail",
+			resource:    "daemonsets",
+			err:         errors.New("connection refused"),
+			shouldErr:   true,
+		},
+		{
+			description: "forbidden deployments fail",
+			resource:    "deployments",
+			err:         apierrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "", errors.New("rbac")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runLabels}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runLabels}},
+			)
+			client.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "sql.cnrm.cloud.google.com/v1beta1",
+				APIResources: []metav1.APIResource{{Name: "sqlinstances", Kind: "SQLInstance", Namespaced: true, Verbs: []string{"list"}}},
+			}}
+			dynClient := fakedynclient.NewSimpleDynamicClient(runtime.NewScheme())
+			reactor := func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, test.err
+			}
+			client.PrependReactor("list", test.resource, reactor)
+			dynClient.PrependReactor("list", test.resource, reactor)
+
+			actual, err := getResources(context.Background(), client, dynClient, "test", labeller, 200*time.Second, nil)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual(test.expected, actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
 		})
 	}
@@ -233,7 +440,7 @@ func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
 		counter      *counter
-		deployments  []*resource.Deployment
+		deployments  []*resource.Resource
 		expected     string
 		expectedCode proto.StatusCode
 		shouldErr    bool
@@ -241,24 +448,24 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one error",
 			counter:     &counter{total: 2, failed: 1},
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.ResourceTypes.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
-			expected:     "1/2 deployment(s) failed",
+			expected:     "1/2 resource(s) failed",
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
 			shouldErr:    true,
 		},
 		{
 			description: "no error",
 			counter:     &counter{total: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -266,9 +473,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "multiple errors",
 			counter:     &counter{total: 3, failed: 2},
-			expected:    "2/3 deployment(s) failed",
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			expected:    "2/3 resource(s) failed",
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.ResourceTypes.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
@@ -281,9 +488,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "unable to retrieve pods for deployment",
 			counter:     &counter{total: 1, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("deployment", "test", 1),
+					resource.NewResource("deployment", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
 				),
 			},
@@ -293,17 +500,17 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one deployment failed and others cancelled and or succeeded",
 			counter:     &counter{total: 3, failed: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("deployment-cancelled", "test", 1),
+					resource.NewResource("deployment-cancelled", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
 				),
 				withStatus(
-					resource.NewDeployment("deployment-success", "test", 1),
+					resource.NewResource("deployment-success", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("deployment", "test", 1),
+					resource.NewResource("deployment", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
 				),
 			},
@@ -392,7 +599,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			rc := newCounter(10)
 			rc.pending = test.pending
 			testEvent.InitializeState([]latestV1.Pipeline{{}})
-			r := withStatus(resource.NewDeployment(test.deployment, test.namespace, 0), test.ae)
+			r := withStatus(resource.NewResource(test.deployment, resource.ResourceTypes.Deployment, test.namespace, 0), test.ae)
 			// report status once and set it changed to false.
 			r.ReportSinceLastUpdated(false)
 			r.UpdateStatus(test.ae)
@@ -406,15 +613,15 @@ func TestPrintStatus(t *testing.T) {
 	labeller := label.NewLabeller(true, nil, "run-id")
 	tests := []struct {
 		description string
-		rs          []*resource.Deployment
+		rs          []*resource.Resource
 		expectedOut string
 		expected    bool
 	}{
 		{
 			description: "single resource successful marked complete - skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -422,9 +629,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "single resource in error marked complete -skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "error"},
 				),
 			},
@@ -432,13 +639,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1).
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1).
 						WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 						Message: "pending\n"},
@@ -450,13 +657,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete and retry-able error",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{
 						ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
 						Message: resource.MsgKubectlConnection},
@@ -467,9 +674,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "skip printing if status check is cancelled",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.ResourceTypes.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
 				),
 			},
@@ -490,7 +697,7 @@ func TestPrintStatus(t *testing.T) {
 	}
 }
 
-func withStatus(d *resource.Deployment, ae proto.ActionableErr) *resource.Deployment {
+func withStatus(d *resource.Resource, ae proto.ActionableErr) *resource.Resource {
 	d.UpdateStatus(ae)
 	return d
 }
@@ -559,14 +766,14 @@ func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
 	tests := []struct {
 		description string
-		dep         *resource.Deployment
+		dep         *resource.Resource
 		runs        [][]validator.Resource
 		command     util.Command
 		expected    proto.StatusCode
 	}{
 		{
 			description: "pollDeploymentStatus errors out immediately when container error can't recover",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second),
 			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
 				{validator.NewResource(
@@ -581,7 +788,7 @@ func TestPollDeployment(t *testing.T) {
 		},
 		{
 			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			dep:         resource.NewResource("dep", resource.ResourceTypes.Deployment, "test", time.Second),
 			command: testutil.CmdRunOutErr(
 				// pending due to recoverable error
 				rolloutCmd, "", errors.New("Unable to connect to the server")).
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+)
+
+const megabyte = 1024 * 1024
+
+// OpenFile opens the rotating file that persists the application logs of a container,
+// under the given path in the logs directory, e.g. `<namespace>/<pod>/<container>.log`.
+// It returns nil if application logs aren't persisted.
+func OpenFile(opts config.LogFileOptions, path ...string) (*logfile.RotatingFile, error) {
+	if opts.Dir == "" {
+		return nil, nil
+	}
+	return logfile.CreateRotating(opts.Dir, int64(opts.MaxSizeMB)*megabyte, opts.MaxBackups, path...)
+}
+
+type fileFormatter struct {
+	Formatter
+	file io.Writer
+}
+
+// WithFile returns a Formatter that also writes every log line to a file,
+// before the lines are filtered or muted.
+func WithFile(f Formatter, file *logfile.RotatingFile) Formatter {
+	if file == nil {
+		return f
+	}
+	return &fileFormatter{Formatter: f, file: file}
+}
+
+func (f *fileFormatter) PrintLine(out io.Writer, line string) {
+	if _, err := io.WriteString(f.file, line); err != nil {
+		logrus.Debugf("unable to persist log line: %v", err)
+	}
+	f.Formatter.PrintLine(out, line)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestOpenFileDisabled(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file, err := OpenFile(config.LogFileOptions{}, "web.log")
+		t.CheckNoError(err)
+		t.CheckNil(file)
+
+		f := &recordingFormatter{}
+		t.CheckTrue(WithFile(f, file) == f)
+	})
+}
+
+func TestWithFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		file, err := OpenFile(config.LogFileOptions{Dir: tmpDir.Root(), MaxSizeMB: 1}, "default", "web-7d8f", "web.log")
+		t.CheckNoError(err)
+
+		filters, err := NewFilters(latestV1.LogsConfig{Exclude: []latestV1.LogFilter{{Message: "healthz"}}}, config.LogFilterOptions{}, false)
+		t.CheckNoError(err)
+		f := &recordingFormatter{}
+		formatter := WithFile(WithFilters(f, filters, Source{Container: "web"}), file)
+		formatter.PrintLine(&bytes.Buffer{}, "GET /healthz\n")
+		formatter.PrintLine(&bytes.Buffer{}, "GET /api\n")
+		t.CheckNoError(file.Close())
+
+		// filtered lines are still persisted
+		content, err := ioutil.ReadFile(tmpDir.Path("default/web-7d8f/web.log"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("GET /healthz\nGET /api\n", string(content))
+		t.CheckDeepEqual([]string{"GET /api\n"}, f.lines)
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+const labelFilterPrefix = "label:"
+
+// errorPatterns match common errors, panics and stack traces of Go, Java, Node.js and Python programs.
+var errorPatterns = []string{
+	`(?i)\b(error|fatal|exception)\b`,
+	`\bpanic:`,
+	`^goroutine \d+ \[.*\]:$`,
+	`^\s+at .+`,
+	`^Traceback \(most recent call last\):$`,
+}
+
+var highlightColor = output.Red
+
+// Source describes the container that application log lines come from.
+type Source struct {
+	Container string
+	PodLabels map[string]string
+}
+
+// Filters selects the application log lines to print and highlights patterns in them.
+type Filters struct {
+	include   []filter
+	exclude   []filter
+	highlight []*regexp.Regexp
+}
+
+type filter struct {
+	container string
+	podLabels map[string]string
+	message   *regexp.Regexp
+}
+
+// NewFilters combines the filters of a `deploy.logs` config with the ones given on the command line.
+// Highlighting is skipped if highlight is false, for example when logs are printed as JSON.
+func NewFilters(cfg latestV1.LogsConfig, opts config.LogFilterOptions, highlight bool) (*Filters, error) {
+	include, err := parseFilters(cfg.Include, opts.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := parseFilters(cfg.Exclude, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Filters{include: include, exclude: exclude}
+	if !highlight {
+		return f, nil
+	}
+	patterns := append(append([]string{}, cfg.Highlight...), opts.Highlight...)
+	if (cfg.HighlightErrors != nil && *cfg.HighlightErrors) || opts.HighlightErrors {
+		patterns = append(patterns, errorPatterns...)
+	}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log highlight pattern %q: %w", p, err)
+		}
+		f.highlight = append(f.highlight, re)
+	}
+	return f, nil
+}
+
+// ParseFilter parses a filter given on the command line. Valid filters are
+// `container=<glob>`, `label:<key>=<value>` and `message=<regexp>`.
+func ParseFilter(s string) (latestV1.LogFilter, error) {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 {
+		return latestV1.LogFilter{}, fmt.Errorf("invalid log filter %q: expected `container=<glob>`, `label:<key>=<value>` or `message=<regexp>`", s)
+	}
+	key, value := kv[0], kv[1]
+	switch {
+	case key == "container":
+		return latestV1.LogFilter{Container: value}, nil
+	case key == "message":
+		return latestV1.LogFilter{Message: value}, nil
+	case strings.HasPrefix(key, labelFilterPrefix) && len(key) > len(labelFilterPrefix):
+		return latestV1.LogFilter{PodLabels: map[string]string{strings.TrimPrefix(key, labelFilterPrefix): value}}, nil
+	default:
+		return latestV1.LogFilter{}, fmt.Errorf("invalid log filter %q: expected `container=<glob>`, `label:<key>=<value>` or `message=<regexp>`", s)
+	}
+}
+
+func parseFilters(filters []latestV1.LogFilter, flags []string) ([]filter, error) {
+	for _, s := range flags {
+		f, err := ParseFilter(s)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	var parsed []filter
+	for _, f := range filters {
+		if _, err := path.Match(f.Container, ""); err != nil {
+			return nil, fmt.Errorf("invalid container pattern %q in log filter: %w", f.Container, err)
+		}
+		var re *regexp.Regexp
+		if f.Message != "" {
+			var err error
+			if re, err = regexp.Compile(f.Message); err != nil {
+				return nil, fmt.Errorf("invalid message pattern %q in log filter: %w", f.Message, err)
+			}
+		}
+		parsed = append(parsed, filter{container: f.Container, podLabels: f.PodLabels, message: re})
+	}
+	return parsed, nil
+}
+
+func (f filter) matches(src Source, message string) bool {
+	if f.container != "" {
+		if matched, _ := path.Match(f.container, src.Container); !matched {
+			return false
+		}
+	}
+	for k, v := range f.podLabels {
+		if src.PodLabels[k] != v {
+			return false
+		}
+	}
+	return f.message == nil || f.message.MatchString(message)
+}
+
+// Selects returns true if a log line from the given source should be printed.
+func (f *Filters) Selects(src Source, line string) bool {
+	if f == nil {
+		return true
+	}
+	_, message, _ := splitTimestamp(strings.TrimRight(line, "\r\n"))
+	if len(f.include) > 0 && !anyMatches(f.include, src, message) {
+		return false
+	}
+	return !anyMatches(f.exclude, src, message)
+}
+
+// Highlight colors the parts of a log line that match the highlight patterns.
+func (f *Filters) Highlight(line string) string {
+	if f == nil || len(f.highlight) == 0 {
+		return line
+	}
+	trimmed := strings.TrimRight(line, "\r\n")
+
+	// Mark the matches of all the patterns first so that overlapping matches
+	// are colored once and patterns never match the color codes.
+	matched := make([]bool, len(trimmed))
+	for _, re := range f.highlight {
+		for _, loc := range re.FindAllStringIndex(trimmed, -1) {
+			for i := loc[0]; i < loc[1]; i++ {
+				matched[i] = true
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(trimmed); {
+		end := start
+		for end < len(trimmed) && matched[end] == matched[start] {
+			end++
+		}
+		if matched[start] {
+			sb.WriteString(highlightColor.Sprintf("%s", trimmed[start:end]))
+		} else {
+			sb.WriteString(trimmed[start:end])
+		}
+		start = end
+	}
+	sb.WriteString(line[len(trimmed):])
+	return sb.String()
+}
+
+func (f *Filters) isEmpty() bool {
+	return f == nil || (len(f.include) == 0 && len(f.exclude) == 0 && len(f.highlight) == 0)
+}
+
+func anyMatches(filters []filter, src Source, message string) bool {
+	for _, f := range filters {
+		if f.matches(src, message) {
+			return true
+		}
+	}
+	return false
+}
+
+type filteringFormatter struct {
+	Formatter
+	filters *Filters
+	source  Source
+}
+
+// WithFilters returns a Formatter that only prints the lines selected by the filters,
+// highlighting them when the output supports colors.
+func WithFilters(f Formatter, filters *Filters, src Source) Formatter {
+	if filters.isEmpty() {
+		return f
+	}
+	return &filteringFormatter{Formatter: f, filters: filters, source: src}
+}
+
+func (f *filteringFormatter) PrintLine(out io.Writer, line string) {
+	if !f.filters.Selects(f.source, line) {
+		return
+	}
+	if output.IsColorable(out) {
+		line = f.filters.Highlight(line)
+	}
+	f.Formatter.PrintLine(out, line)
+}
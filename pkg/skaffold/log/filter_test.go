@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      string
+		expected    latestV1.LogFilter
+		shouldErr   bool
+	}{
+		{
+			description: "container",
+			filter:      "container=web-*",
+			expected:    latestV1.LogFilter{Container: "web-*"},
+		},
+		{
+			description: "pod label",
+			filter:      "label:app=web",
+			expected:    latestV1.LogFilter{PodLabels: map[string]string{"app": "web"}},
+		},
+		{
+			description: "message",
+			filter:      "message=GET /healthz(=.*)?",
+			expected:    latestV1.LogFilter{Message: "GET /healthz(=.*)?"},
+		},
+		{
+			description: "missing value",
+			filter:      "container",
+			shouldErr:   true,
+		},
+		{
+			description: "missing label key",
+			filter:      "label:=web",
+			shouldErr:   true,
+		},
+		{
+			description: "unknown key",
+			filter:      "pod=web",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			filter, err := ParseFilter(test.filter)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, filter)
+		})
+	}
+}
+
+func TestNewFiltersErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latestV1.LogsConfig
+		opts        config.LogFilterOptions
+		highlight   bool
+		expected    string
+	}{
+		{
+			description: "invalid message regexp",
+			cfg:         latestV1.LogsConfig{Include: []latestV1.LogFilter{{Message: "("}}},
+			expected:    `invalid message pattern "("`,
+		},
+		{
+			description: "invalid container glob",
+			cfg:         latestV1.LogsConfig{Exclude: []latestV1.LogFilter{{Container: "["}}},
+			expected:    `invalid container pattern "["`,
+		},
+		{
+			description: "invalid flag",
+			opts:        config.LogFilterOptions{Exclude: []string{"web"}},
+			expected:    `invalid log filter "web"`,
+		},
+		{
+			description: "invalid highlight pattern",
+			opts:        config.LogFilterOptions{Highlight: []string{"("}},
+			highlight:   true,
+			expected:    `invalid log highlight pattern "("`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewFilters(test.cfg, test.opts, test.highlight)
+
+			t.CheckErrorContains(test.expected, err)
+		})
+	}
+}
+
+func TestSelects(t *testing.T) {
+	web := Source{Container: "web", PodLabels: map[string]string{"app": "web", "tier": "frontend"}}
+	db := Source{Container: "db-primary", PodLabels: map[string]string{"app": "db"}}
+
+	tests := []struct {
+		description string
+		cfg         latestV1.LogsConfig
+		opts        config.LogFilterOptions
+		src         Source
+		line        string
+		expected    bool
+	}{
+		{
+			description: "no filters",
+			src:         web,
+			line:        "hello\n",
+			expected:    true,
+		},
+		{
+			description: "include container glob",
+			cfg:         latestV1.LogsConfig{Include: []latestV1.LogFilter{{Container: "db-*"}}},
+			src:         db,
+			line:        "ready\n",
+			expected:    true,
+		},
+		{
+			description: "not included",
+			cfg:         latestV1.LogsConfig{Include: []latestV1.LogFilter{{Container: "db-*"}}},
+			src:         web,
+			line:        "ready\n",
+			expected:    false,
+		},
+		{
+			description: "include any of the filters",
+			opts:        config.LogFilterOptions{Include: []string{"container=db-*", "label:tier=frontend"}},
+			src:         web,
+			line:        "ready\n",
+			expected:    true,
+		},
+		{
+			description: "all fields of a filter must match",
+			cfg:         latestV1.LogsConfig{Include: []latestV1.LogFilter{{Container: "web", PodLabels: map[string]string{"app": "db"}}}},
+			src:         web,
+			line:        "ready\n",
+			expected:    false,
+		},
+		{
+			description: "exclude message",
+			opts:        config.LogFilterOptions{Exclude: []string{"message=GET /healthz"}},
+			src:         web,
+			line:        "GET /healthz 200\n",
+			expected:    false,
+		},
+		{
+			description: "exclude takes precedence",
+			cfg: latestV1.LogsConfig{
+				Include: []latestV1.LogFilter{{PodLabels: map[string]string{"app": "web"}}},
+				Exclude: []latestV1.LogFilter{{Message: "^DEBUG"}},
+			},
+			src:      web,
+			line:     "DEBUG connecting\n",
+			expected: false,
+		},
+		{
+			description: "message is matched without timestamp",
+			opts:        config.LogFilterOptions{Exclude: []string{"message=^DEBUG"}},
+			src:         web,
+			line:        "2021-06-01T10:00:00.000000000Z DEBUG connecting\n",
+			expected:    false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			filters, err := NewFilters(test.cfg, test.opts, true)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.expected, filters.Selects(test.src, test.line))
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latestV1.LogsConfig
+		opts        config.LogFilterOptions
+		highlight   bool
+		line        string
+		expected    string
+	}{
+		{
+			description: "no patterns",
+			highlight:   true,
+			line:        "connection refused\n",
+			expected:    "connection refused\n",
+		},
+		{
+			description: "highlight pattern",
+			cfg:         latestV1.LogsConfig{Highlight: []string{"connection refused"}},
+			highlight:   true,
+			line:        "dial tcp: connection refused\n",
+			expected:    "dial tcp: \033[31mconnection refused\033[0m\n",
+		},
+		{
+			description: "overlapping patterns",
+			opts:        config.LogFilterOptions{Highlight: []string{"time", "timeout"}},
+			highlight:   true,
+			line:        "timeout\n",
+			expected:    "\033[31mtimeout\033[0m\n",
+		},
+		{
+			description: "errors",
+			opts:        config.LogFilterOptions{HighlightErrors: true},
+			highlight:   true,
+			line:        "panic: runtime error\n",
+			expected:    "\033[31mpanic:\033[0m runtime \033[31merror\033[0m\n",
+		},
+		{
+			description: "highlighting disabled",
+			opts:        config.LogFilterOptions{HighlightErrors: true},
+			line:        "panic: runtime error\n",
+			expected:    "panic: runtime error\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			defer output.SetupColors(nil, output.DefaultColorCode, false)
+			output.SetupColors(&bytes.Buffer{}, 0, true)
+
+			filters, err := NewFilters(test.cfg, test.opts, test.highlight)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.expected, filters.Highlight(test.line))
+		})
+	}
+}
+
+type recordingFormatter struct {
+	lines []string
+}
+
+func (f *recordingFormatter) Name() string { return "recording" }
+
+func (f *recordingFormatter) PrintLine(_ io.Writer, line string) {
+	f.lines = append(f.lines, line)
+}
+
+func TestWithFilters(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		f := &recordingFormatter{}
+		filters, err := NewFilters(latestV1.LogsConfig{}, config.LogFilterOptions{}, true)
+		t.CheckNoError(err)
+		t.CheckTrue(WithFilters(f, filters, Source{Container: "web"}) == f)
+
+		filters, err = NewFilters(latestV1.LogsConfig{}, config.LogFilterOptions{Exclude: []string{"message=healthz"}, HighlightErrors: true}, true)
+		t.CheckNoError(err)
+		filtered := WithFilters(f, filters, Source{Container: "web"})
+		filtered.PrintLine(&bytes.Buffer{}, "GET /healthz\n")
+		filtered.PrintLine(&bytes.Buffer{}, "error: boom\n")
+
+		// highlighting is skipped for writers that don't support colors
+		t.CheckDeepEqual([]string{"error: boom\n"}, f.lines)
+	})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// for testing
+var now = time.Now
+
+// JSONEntry is an application log line, as printed with `--log-format=json`.
+type JSONEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Namespace string    `json:"namespace,omitempty"`
+	Pod       string    `json:"pod,omitempty"`
+	Container string    `json:"container"`
+	Image     string    `json:"image,omitempty"`
+	Message   string    `json:"message"`
+	// Parsed holds the message when it is itself a JSON object or array.
+	Parsed json.RawMessage `json:"parsed,omitempty"`
+}
+
+// NewJSONEntry creates an entry for a raw log line. A leading RFC3339 timestamp, as added by
+// `kubectl logs --timestamps`, is used as the entry's timestamp. Otherwise, the current time is used.
+func NewJSONEntry(line string) JSONEntry {
+	timestamp, line, found := splitTimestamp(strings.TrimRight(line, "\r\n"))
+	if !found {
+		timestamp = now()
+	}
+
+	entry := JSONEntry{
+		Timestamp: timestamp,
+		Message:   line,
+	}
+	if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if json.Valid([]byte(trimmed)) {
+			entry.Parsed = json.RawMessage(trimmed)
+		}
+	}
+	return entry
+}
+
+// splitTimestamp splits a leading RFC3339 timestamp from a log line.
+func splitTimestamp(line string) (time.Time, string, bool) {
+	if i := strings.IndexByte(line, ' '); i > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, line[:i]); err == nil {
+			return t, line[i+1:], true
+		}
+	}
+	return time.Time{}, line, false
+}
+
+// String returns the entry encoded as a single line of JSON, terminated by a newline.
+func (e JSONEntry) String() string {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("{\"message\":%q}\n", e.Message)
+	}
+	return string(b) + "\n"
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestJSONEntry(t *testing.T) {
+	tests := []struct {
+		description string
+		line        string
+		expected    string
+	}{
+		{
+			description: "plain message",
+			line:        "Listening on port 8080\n",
+			expected:    `{"timestamp":"2021-06-01T10:00:00Z","container":"web","message":"Listening on port 8080"}` + "\n",
+		},
+		{
+			description: "message with timestamp",
+			line:        "2021-06-01T09:59:58.123456789Z Listening on port 8080\n",
+			expected:    `{"timestamp":"2021-06-01T09:59:58.123456789Z","container":"web","message":"Listening on port 8080"}` + "\n",
+		},
+		{
+			description: "json message",
+			line:        `{"level":"info","msg":"started"}` + "\n",
+			expected:    `{"timestamp":"2021-06-01T10:00:00Z","container":"web","message":"{\"level\":\"info\",\"msg\":\"started\"}","parsed":{"level":"info","msg":"started"}}` + "\n",
+		},
+		{
+			description: "invalid json message",
+			line:        "{not json}\n",
+			expected:    `{"timestamp":"2021-06-01T10:00:00Z","container":"web","message":"{not json}"}` + "\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&now, func() time.Time { return time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC) })
+
+			entry := NewJSONEntry(test.line)
+			entry.Container = "web"
+
+			t.CheckDeepEqual(test.expected, entry.String())
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RotatingFile is a log file that is rotated once it reaches a maximum size.
+// Rotated files are renamed `<name>.1`, `<name>.2`... from the most recent to the oldest.
+type RotatingFile struct {
+	sync.Mutex
+	path       string
+	file       *os.File
+	size       int64
+	maxSize    int64
+	maxBackups int
+	refs       int // guarded by openFilesLock
+}
+
+var (
+	// openFiles are the log files currently open, by path.
+	openFiles     = map[string]*RotatingFile{}
+	openFilesLock sync.Mutex
+)
+
+// CreateRotating creates, or appends to, a log file under dir that is rotated
+// once it reaches maxSize bytes. At most maxBackups rotated files are kept.
+// A log file that is already open, e.g. when the logs of a restarted container are
+// streamed again, is shared so that there's at most one open handle per file.
+func CreateRotating(dir string, maxSize int64, maxBackups int, path ...string) (*RotatingFile, error) {
+	logfile := dir
+	for _, p := range path {
+		logfile = filepath.Join(logfile, escape(p))
+	}
+
+	openFilesLock.Lock()
+	defer openFilesLock.Unlock()
+
+	if f, found := openFiles[logfile]; found {
+		f.refs++
+		return f, nil
+	}
+
+	parent := filepath.Dir(logfile)
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create log directory %q: %w", parent, err)
+	}
+
+	f := &RotatingFile{path: logfile, maxSize: maxSize, maxBackups: maxBackups, refs: 1}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	openFiles[logfile] = f
+	return f, nil
+}
+
+// Name returns the path of the current log file.
+func (f *RotatingFile) Name() string {
+	return f.path
+}
+
+// Write appends to the log file, rotating it first if it would exceed its maximum size.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close closes the log file once all the users that opened it have closed it.
+func (f *RotatingFile) Close() error {
+	openFilesLock.Lock()
+	f.refs--
+	if f.refs > 0 {
+		openFilesLock.Unlock()
+		return nil
+	}
+	delete(openFiles, f.path)
+	openFilesLock.Unlock()
+
+	f.Lock()
+	defer f.Unlock()
+
+	return f.file.Close()
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	if f.maxBackups <= 0 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return f.open()
+	}
+
+	// Shift `<name>.N-1` to `<name>.N`, dropping the oldest file.
+	for i := f.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backup(f.path, i), backup(f.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(f.path, backup(f.path, 1)); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func backup(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCreateRotating(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		file, err := CreateRotating(tmpDir.Root(), 10, 2, "default", "web-7d8f", "web*.log")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(tmpDir.Path(filepath.Join("default", "web-7d8f", "web-.log")), file.Name())
+
+		for _, line := range []string{"line 1\n", "line 2\n", "line 3\n", "line 4\n"} {
+			_, err := file.Write([]byte(line))
+			t.CheckNoError(err)
+		}
+		t.CheckNoError(file.Close())
+
+		read := func(suffix string) string {
+			content, err := ioutil.ReadFile(file.Name() + suffix)
+			t.CheckNoError(err)
+			return string(content)
+		}
+		t.CheckDeepEqual("line 4\n", read(""))
+		t.CheckDeepEqual("line 3\n", read(".1"))
+		t.CheckDeepEqual("line 2\n", read(".2"))
+		_, err = os.Stat(file.Name() + ".3")
+		t.CheckTrue(os.IsNotExist(err))
+	})
+}
+
+func TestCreateRotatingAppends(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("web.log", "before restart\n")
+
+		file, err := CreateRotating(tmpDir.Root(), 0, 0, "web.log")
+		t.CheckNoError(err)
+		_, err = file.Write([]byte("after restart\n"))
+		t.CheckNoError(err)
+		t.CheckNoError(file.Close())
+
+		content, err := ioutil.ReadFile(file.Name())
+		t.CheckNoError(err)
+		t.CheckDeepEqual("before restart\nafter restart\n", string(content))
+	})
+}
+
+func TestRotateWithoutBackups(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		file, err := CreateRotating(tmpDir.Root(), 10, 0, "web.log")
+		t.CheckNoError(err)
+		for _, line := range []string{"line 1\n", "line 2\n"} {
+			_, err := file.Write([]byte(line))
+			t.CheckNoError(err)
+		}
+		t.CheckNoError(file.Close())
+
+		_, err = os.Stat(file.Name() + ".1")
+		t.CheckTrue(os.IsNotExist(err))
+		content, err := ioutil.ReadFile(file.Name())
+		t.CheckNoError(err)
+		t.CheckDeepEqual("line 2\n", string(content))
+	})
+}
+
+func TestCreateRotatingSharesOpenFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		first, err := CreateRotating(tmpDir.Root(), 0, 0, "web.log")
+		t.CheckNoError(err)
+		second, err := CreateRotating(tmpDir.Root(), 0, 0, "web.log")
+		t.CheckNoError(err)
+		t.CheckTrue(first == second)
+
+		t.CheckNoError(first.Close())
+		_, err = second.Write([]byte("still open\n"))
+		t.CheckNoError(err)
+		t.CheckNoError(second.Close())
+
+		third, err := CreateRotating(tmpDir.Root(), 0, 0, "web.log")
+		t.CheckNoError(err)
+		t.CheckFalse(third == first)
+		t.CheckNoError(third.Close())
+
+		content, err := ioutil.ReadFile(first.Name())
+		t.CheckNoError(err)
+		t.CheckDeepEqual("still open\n", string(content))
+	})
+}
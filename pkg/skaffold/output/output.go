@@ -19,6 +19,7 @@ package output
 import (
 	"io"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
@@ -27,6 +28,8 @@ import (
 
 const TimestampFormat = "2006-01-02 15:04:05"
 
+var colorCodes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
 type skaffoldWriter struct {
 	MainWriter  io.Writer
 	EventWriter io.Writer
@@ -110,3 +113,32 @@ func WithEventContext(out io.Writer, phase constants.Phase, subtaskID string) io
 
 	return out
 }
+
+// WithCopy returns a writer that writes to out and also copies the text, without colors, to w.
+// The colors and the event context of out are preserved.
+func WithCopy(out io.Writer, w io.Writer) io.Writer {
+	switch o := out.(type) {
+	case skaffoldWriter:
+		return skaffoldWriter{
+			MainWriter:  WithCopy(o.MainWriter, w),
+			EventWriter: o.EventWriter,
+			timestamps:  o.timestamps,
+		}
+	case colorableWriter:
+		return colorableWriter{io.MultiWriter(o.Writer, noColorWriter{w})}
+	default:
+		return io.MultiWriter(out, w)
+	}
+}
+
+// noColorWriter strips the color codes from the text written to it.
+type noColorWriter struct {
+	io.Writer
+}
+
+func (w noColorWriter) Write(p []byte) (int, error) {
+	if _, err := w.Writer.Write(colorCodes.ReplaceAll(p, nil)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -217,3 +217,39 @@ func TestWriteWithTimeStamps(t *testing.T) {
 		})
 	}
 }
+
+func TestWithCopy(t *testing.T) {
+	tests := []struct {
+		name         string
+		writer       func(io.Writer) io.Writer
+		expectedMain string
+	}{
+		{
+			name: "skaffold writer with color",
+			writer: func(out io.Writer) io.Writer {
+				return skaffoldWriter{
+					MainWriter:  colorableWriter{out},
+					EventWriter: ioutil.Discard,
+				}
+			},
+			expectedMain: "\u001B[32mtesting!\u001B[0m",
+		},
+		{
+			name:         "plain writer",
+			writer:       func(out io.Writer) io.Writer { return out },
+			expectedMain: "testing!",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.name, func(t *testutil.T) {
+			var main, copied bytes.Buffer
+			out := WithCopy(test.writer(&main), &copied)
+			Green.Fprintf(out, "testing!")
+
+			t.CheckDeepEqual(test.expectedMain, main.String())
+			t.CheckDeepEqual("testing!", copied.String())
+			t.CheckDeepEqual(IsColorable(test.writer(&main)), IsColorable(out))
+		})
+	}
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/status"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	v1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -68,6 +70,7 @@ func GetDeployer(runCtx *runcontext.RunContext, labeller *label.DefaultLabeller)
 
 	var deployers []deploy.Deployer
 	for _, d := range deployerCfg {
+		start := len(deployers)
 		if d.DockerDeploy != nil {
 			localDeploy = true
 			d, err := docker.NewDeployer(runCtx, labeller, d.DockerDeploy, runCtx.PortForwardResources())
@@ -106,6 +109,14 @@ func GetDeployer(runCtx *runcontext.RunContext, labeller *label.DefaultLabeller)
 			}
 			deployers = append(deployers, deployer)
 		}
+
+		if len(d.CleanupHooks.PreHooks) > 0 || len(d.CleanupHooks.PostHooks) > 0 {
+			cli := pkgkubectl.NewCLI(dCtx, "")
+			opts := hooks.NewCleanupEnvOpts(runCtx.GetRunID(), cli.KubeContext, cli.Namespace)
+			for i := start; i < len(deployers); i++ {
+				deployers[i] = deploy.WithCleanupHooks(deployers[i], hooks.NewCleanupRunner(cli, d.CleanupHooks, opts))
+			}
+		}
 	}
 
 	if localDeploy && remoteDeploy {
@@ -60,7 +60,7 @@ func (l *SkaffoldListener) LogWatchToUser(out io.Writer) {
 func (l *SkaffoldListener) WatchForChanges(ctx context.Context, out io.Writer, devLoop func() error) error {
 	ctxTrigger, cancelTrigger := context.WithCancel(ctx)
 	defer cancelTrigger()
-	trigger, err := trigger.StartTrigger(ctxTrigger, l.Trigger)
+	triggerChan, err := trigger.StartTrigger(ctxTrigger, l.Trigger)
 	if err != nil {
 		return fmt.Errorf("unable to start trigger: %w", err)
 	}
@@ -80,7 +80,10 @@ func (l *SkaffoldListener) WatchForChanges(ctx context.Context, out io.Writer, d
 			if err := l.do(devLoop); err != nil {
 				return err
 			}
-		case <-trigger:
+		case <-triggerChan:
+			if t, ok := l.Trigger.(trigger.ChangedPathsTrigger); ok {
+				l.Monitor.MarkChanged(t.ChangedPaths())
+			}
 			if err := l.do(devLoop); err != nil {
 				return err
 			}
@@ -19,6 +19,7 @@ package runcontext
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -106,6 +107,14 @@ func (ps Pipelines) TestCases() []*latestV1.TestCase {
 	return tests
 }
 
+func (ps Pipelines) VerifyTestCases() []*latestV1.VerifyTestCase {
+	var tests []*latestV1.VerifyTestCase
+	for _, p := range ps.pipelines {
+		tests = append(tests, p.Verify...)
+	}
+	return tests
+}
+
 func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	c := 0
 	// set the group status check deadline to maximum of any individually specified value
@@ -116,6 +125,29 @@ func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	}
 	return c
 }
+
+// TestConcurrency returns the minimum of the test concurrencies set in the pipelines (0 means unlimited), or -1 if none is set.
+func (ps Pipelines) TestConcurrency() int {
+	c := -1
+	for _, p := range ps.pipelines {
+		if p.TestConcurrency == nil {
+			continue
+		}
+		if v := *p.TestConcurrency; c < 0 || (v > 0 && (c == 0 || v < c)) {
+			c = v
+		}
+	}
+	return c
+}
+
+func (ps Pipelines) StatusCheckRules() []latestV1.StatusCheckRule {
+	var rules []latestV1.StatusCheckRule
+	for _, p := range ps.pipelines {
+		rules = append(rules, p.Deploy.StatusCheckRules...)
+	}
+	return rules
+}
+
 func NewPipelines(pipelines []latestV1.Pipeline) Pipelines {
 	m := make(map[string]latestV1.Pipeline)
 	for _, p := range pipelines {
@@ -142,10 +174,18 @@ func (rc *RunContext) Deployers() []latestV1.DeployConfig { return rc.Pipelines.
 
 func (rc *RunContext) TestCases() []*latestV1.TestCase { return rc.Pipelines.TestCases() }
 
+func (rc *RunContext) VerifyTestCases() []*latestV1.VerifyTestCase {
+	return rc.Pipelines.VerifyTestCases()
+}
+
 func (rc *RunContext) StatusCheckDeadlineSeconds() int {
 	return rc.Pipelines.StatusCheckDeadlineSeconds()
 }
 
+func (rc *RunContext) StatusCheckRules() []latestV1.StatusCheckRule {
+	return rc.Pipelines.StatusCheckRules()
+}
+
 func (rc *RunContext) DefaultPipeline() latestV1.Pipeline            { return rc.Pipelines.Head() }
 func (rc *RunContext) GetKubeContext() string                        { return rc.KubeContext }
 func (rc *RunContext) GetPipelines() []latestV1.Pipeline             { return rc.Pipelines.All() }
@@ -159,6 +199,9 @@ func (rc *RunContext) AutoDeploy() bool                              { return rc
 func (rc *RunContext) AutoSync() bool                                { return rc.Opts.AutoSync }
 func (rc *RunContext) CacheArtifacts() bool                          { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                             { return rc.Opts.CacheFile }
+func (rc *RunContext) SharedCache() config.SharedCacheOptions        { return rc.Opts.SharedCache }
+func (rc *RunContext) JSONLogs() bool                                { return rc.Opts.LogFormat.JSON() }
+func (rc *RunContext) LogFilters() config.LogFilterOptions           { return rc.Opts.LogFilters }
 func (rc *RunContext) ConfigurationFile() string                     { return rc.Opts.ConfigurationFile }
 func (rc *RunContext) CustomLabels() []string                        { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                             { return rc.Opts.CustomTag }
@@ -166,6 +209,7 @@ func (rc *RunContext) DefaultRepo() *string                          { return rc
 func (rc *RunContext) Mode() config.RunMode                          { return rc.Opts.Mode() }
 func (rc *RunContext) DigestSource() string                          { return rc.Opts.DigestSource }
 func (rc *RunContext) DryRun() bool                                  { return rc.Opts.DryRun }
+func (rc *RunContext) ExplainCache() bool                            { return rc.Opts.ExplainCache }
 func (rc *RunContext) ForceDeploy() bool                             { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                         { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                      { return rc.Opts.Namespace }
@@ -178,6 +222,7 @@ func (rc *RunContext) NoPruneChildren() bool                         { return rc
 func (rc *RunContext) Notification() bool                            { return rc.Opts.Notification }
 func (rc *RunContext) PortForward() bool                             { return rc.Opts.PortForward.Enabled() }
 func (rc *RunContext) PortForwardOptions() config.PortForwardOptions { return rc.Opts.PortForward }
+func (rc *RunContext) NativePortForward() bool                       { return rc.Opts.PortForwarder.Native() }
 func (rc *RunContext) Prune() bool                                   { return rc.Opts.Prune() }
 func (rc *RunContext) RenderOnly() bool                              { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                          { return rc.Opts.RenderOutput }
@@ -186,15 +231,39 @@ func (rc *RunContext) SkipTests() bool                               { return rc
 func (rc *RunContext) StatusCheck() *bool                            { return rc.Opts.StatusCheck.Value() }
 func (rc *RunContext) IterativeStatusCheck() bool                    { return rc.Opts.IterativeStatusCheck }
 func (rc *RunContext) Tail() bool                                    { return rc.Opts.Tail }
+func (rc *RunContext) TestReport() config.TestReportOptions          { return rc.Opts.TestReport }
 func (rc *RunContext) Trigger() string                               { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions     { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                        { return rc.Opts.WatchPollInterval }
+func (rc *RunContext) WebhookPort() int                              { return rc.Opts.WebhookPort }
 func (rc *RunContext) BuildConcurrency() int                         { return rc.Opts.BuildConcurrency }
+func (rc *RunContext) TestFailFast() bool                            { return rc.Opts.TestFailFast }
 func (rc *RunContext) IsMultiConfig() bool                           { return rc.Pipelines.IsMultiPipeline() }
 func (rc *RunContext) GetRunID() string                              { return rc.RunID }
 func (rc *RunContext) RPCPort() int                                  { return rc.Opts.RPCPort }
 func (rc *RunContext) RPCHTTPPort() int                              { return rc.Opts.RPCHTTPPort }
 
+// TestConcurrency returns the number of artifacts tested concurrently: the `--test-concurrency` flag when set,
+// otherwise the `testConcurrency` of the pipelines, otherwise 1.
+func (rc *RunContext) TestConcurrency() int {
+	if rc.Opts.TestConcurrency >= 0 {
+		return rc.Opts.TestConcurrency
+	}
+	if c := rc.Pipelines.TestConcurrency(); c >= 0 {
+		return c
+	}
+	return 1
+}
+
+// LogFiles returns where application logs are persisted, in a directory named after the run ID.
+func (rc *RunContext) LogFiles() config.LogFileOptions {
+	opts := rc.Opts.LogFiles
+	if opts.Dir != "" {
+		opts.Dir = filepath.Join(opts.Dir, rc.RunID)
+	}
+	return opts
+}
+
 func GetRunContext(opts config.SkaffoldOptions, configs []schemaUtil.VersionedConfig) (*RunContext, error) {
 	var pipelines []latestV1.Pipeline
 	for _, cfg := range configs {
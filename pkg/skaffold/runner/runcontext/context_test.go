@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runcontext
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTestConcurrency(t *testing.T) {
+	tests := []struct {
+		description string
+		flag        int
+		configs     []*int
+		expected    int
+	}{
+		{
+			description: "default",
+			flag:        -1,
+			configs:     []*int{nil},
+			expected:    1,
+		},
+		{
+			description: "set in config",
+			flag:        -1,
+			configs:     []*int{util.IntPtr(3)},
+			expected:    3,
+		},
+		{
+			description: "flag overrides config",
+			flag:        0,
+			configs:     []*int{util.IntPtr(3)},
+			expected:    0,
+		},
+		{
+			description: "minimum across configs",
+			flag:        -1,
+			configs:     []*int{util.IntPtr(0), util.IntPtr(4), nil, util.IntPtr(2)},
+			expected:    2,
+		},
+		{
+			description: "unlimited in all configs",
+			flag:        -1,
+			configs:     []*int{util.IntPtr(0), util.IntPtr(0)},
+			expected:    0,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var pipelines []latestV1.Pipeline
+			for _, c := range test.configs {
+				pipelines = append(pipelines, latestV1.Pipeline{TestConcurrency: c})
+			}
+			rc := &RunContext{
+				Opts:      config.SkaffoldOptions{TestConcurrency: test.flag},
+				Pipelines: NewPipelines(pipelines),
+			}
+
+			t.CheckDeepEqual(test.expected, rc.TestConcurrency())
+		})
+	}
+}
@@ -55,6 +55,13 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	// Verify tests gate the result of the deployment
+	if !r.runCtx.RenderOnly() {
+		if err := r.Verify(ctx, out, artifacts); err != nil {
+			return err
+		}
+	}
+
 	if r.runCtx.Tail() || r.runCtx.PortForward() {
 		output.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 		<-ctx.Done()
@@ -194,6 +194,10 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 			logrus.Warnf("failed to start debugger: %v", err)
 		}
 
+		if err := r.Verify(childCtx, out, r.Builds); err != nil {
+			logrus.Warnln("Verify tests failed:", err)
+		}
+
 		endTrace()
 	}
 	event.DevLoopComplete(r.devIteration)
@@ -347,6 +351,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	if err := r.Verify(ctx, out, r.Builds); err != nil {
+		logrus.Warnln("Verify tests failed:", err)
+	}
+
 	output.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 
 	event.DevLoopComplete(r.devIteration)
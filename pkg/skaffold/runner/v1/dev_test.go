@@ -48,6 +48,8 @@ func (t *NoopMonitor) Run(bool) error {
 
 func (t *NoopMonitor) Reset() {}
 
+func (t *NoopMonitor) MarkChanged([]string) {}
+
 type FailMonitor struct{}
 
 func (t *FailMonitor) Register(func() ([]string, error), func(filemon.Events)) error {
@@ -60,6 +62,8 @@ func (t *FailMonitor) Run(bool) error {
 
 func (t *FailMonitor) Reset() {}
 
+func (t *FailMonitor) MarkChanged([]string) {}
+
 type TestMonitor struct {
 	events    []filemon.Events
 	callbacks []func(filemon.Events)
@@ -95,6 +99,8 @@ func (t *TestMonitor) Run(bool) error {
 
 func (t *TestMonitor) Reset() {}
 
+func (t *TestMonitor) MarkChanged([]string) {}
+
 func mockK8sClient() (k8s.Interface, error) {
 	return fakekubeclientset.NewSimpleClientset(), nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 // SkaffoldRunner is responsible for running the skaffold build, test and deploy config.
@@ -32,6 +33,7 @@ type SkaffoldRunner struct {
 	runner.Builder
 	runner.Pruner
 	test.Tester
+	verify.Verifier
 
 	deployer deploy.Deployer
 	monitor  filemon.Monitor
@@ -44,13 +44,14 @@ func Set(c *latestV1.SkaffoldConfig) error {
 	defaultToLocalBuild(c)
 	setDefaultTagger(c)
 	setDefaultKustomizePath(c)
+	setDefaultComposeFile(c)
 	setDefaultLogsConfig(c)
 
 	for _, a := range c.Build.Artifacts {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
 
-		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
+		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil && a.KoArtifact == nil {
 			defaultToKanikoArtifact(a)
 		} else {
 			defaultToDockerArtifact(a)
@@ -68,6 +69,9 @@ func Set(c *latestV1.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.KoArtifact != nil:
+			setKoArtifactDefaults(a.KoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
@@ -195,6 +199,12 @@ func setDefaultKustomizePath(c *latestV1.SkaffoldConfig) {
 	}
 }
 
+func setDefaultComposeFile(c *latestV1.SkaffoldConfig) {
+	if c.Deploy.DockerDeploy != nil && c.Deploy.DockerDeploy.UseCompose && c.Deploy.DockerDeploy.ComposeFile == "" {
+		c.Deploy.DockerDeploy.ComposeFile = constants.DefaultComposeFilePath
+	}
+}
+
 func setDefaultKubectlManifests(c *latestV1.SkaffoldConfig) {
 	if c.Deploy.KubectlDeploy != nil && len(c.Deploy.KubectlDeploy.Manifests) == 0 && len(c.Deploy.KubectlDeploy.RemoteManifests) == 0 {
 		c.Deploy.KubectlDeploy.Manifests = constants.DefaultKubectlManifests
@@ -234,6 +244,14 @@ func setBuildpackArtifactDefaults(a *latestV1.BuildpackArtifact) {
 	}
 }
 
+func setKoArtifactDefaults(a *latestV1.KoArtifact) {
+	if a.Dependencies == nil {
+		a.Dependencies = &latestV1.KoDependencies{
+			Paths: []string{"."},
+		}
+	}
+}
+
 func setDockerArtifactDefaults(a *latestV1.DockerArtifact) {
 	a.DockerfilePath = valueOrDefault(a.DockerfilePath, constants.DefaultDockerfilePath)
 }
@@ -392,5 +410,8 @@ func setDefaultTestWorkspace(c *latestV1.SkaffoldConfig) {
 			continue
 		}
 		tc.Workspace = valueOrDefault(tc.Workspace, ".")
+		if tc.Cluster != nil {
+			tc.Cluster.StructureTestImage = valueOrDefault(tc.Cluster.StructureTestImage, constants.DefaultStructureTestImage)
+		}
 	}
 }
@@ -322,6 +322,25 @@ func TestSetDefaultsOnLocalBuild(t *testing.T) {
 	testutil.CheckDeepEqual(t, 1, *cfg2.Build.LocalBuild.Concurrency)
 }
 
+func TestSetDefaultsOnClusterTests(t *testing.T) {
+	cfg := &latestV1.SkaffoldConfig{
+		Pipeline: latestV1.Pipeline{
+			Test: []*latestV1.TestCase{
+				{ImageName: "local"},
+				{ImageName: "cluster", Cluster: &latestV1.ClusterTests{}},
+				{ImageName: "custom-image", Cluster: &latestV1.ClusterTests{StructureTestImage: "cst"}},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, (*latestV1.ClusterTests)(nil), cfg.Test[0].Cluster)
+	testutil.CheckDeepEqual(t, constants.DefaultStructureTestImage, cfg.Test[1].Cluster.StructureTestImage)
+	testutil.CheckDeepEqual(t, "cst", cfg.Test[2].Cluster.StructureTestImage)
+}
+
 func TestSetPortForwardLocalPort(t *testing.T) {
 	cfg := &latestV1.SkaffoldConfig{
 		Pipeline: latestV1.Pipeline{
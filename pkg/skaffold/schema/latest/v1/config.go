@@ -68,11 +68,19 @@ type Pipeline struct {
 	// Test describes how images are tested.
 	Test []*TestCase `yaml:"test,omitempty"`
 
+	// TestConcurrency is the number of artifacts tested concurrently. The tests of an artifact always run sequentially.
+	// Set to `0` to test all artifacts in parallel.
+	// Defaults to `1`.
+	TestConcurrency *int `yaml:"testConcurrency,omitempty"`
+
 	// Deploy describes how images are deployed.
 	Deploy DeployConfig `yaml:"deploy,omitempty"`
 
 	// PortForward describes user defined resources to port-forward.
 	PortForward []*PortForwardResource `yaml:"portForward,omitempty"`
+
+	// Verify describes the integration tests to run against the deployed application, after the deploy and status check succeeded.
+	Verify []*VerifyTestCase `yaml:"verify,omitempty"`
 }
 
 // GitInfo contains information on the origin of skaffold configurations cloned from a git repository.
@@ -126,6 +134,8 @@ type ResourceType string
 type PortForwardResource struct {
 	// Type is the Kubernetes type that should be port forwarded.
 	// Acceptable resource types include: `Service`, `Pod` and Controller resource type that has a pod spec: `ReplicaSet`, `ReplicationController`, `Deployment`, `StatefulSet`, `DaemonSet`, `Job`, `CronJob`.
+	// Use `Container` to publish the port of a container deployed to Docker.
+	// Use `Ingress` to serve the host and path rules of an Ingress with a local reverse proxy on `localPort`.
 	Type ResourceType `yaml:"resourceType,omitempty"`
 
 	// Name is the name of the Kubernetes resource to port forward.
@@ -498,6 +508,29 @@ type TestCase struct {
 	// StructureTestArgs lists additional configuration arguments passed to `container-structure-test` binary.
 	// For example: `["--driver=tar", "--no-color", "-q"]`.
 	StructureTestArgs []string `yaml:"structureTestsArgs,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after the tests of the artifact.
+	LifecycleHooks TestHooks `yaml:"hooks,omitempty"`
+
+	// Cluster *alpha* runs the tests inside the cluster as Kubernetes Jobs, using the freshly built image, instead of on the host machine.
+	// Custom test commands run in a container of the built image. Structure tests run with the `tar` driver of `container-structure-test`,
+	// which pulls the built image from its registry.
+	Cluster *ClusterTests `yaml:"cluster,omitempty"`
+}
+
+// ClusterTests describes how to run the tests of an artifact inside the cluster.
+type ClusterTests struct {
+	// Namespace is the Kubernetes namespace in which the test jobs are created.
+	// Defaults to the namespace of the current kube context.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// ServiceAccountName describes the Kubernetes service account to use for the test pods.
+	// Defaults to the `default` service account of the namespace.
+	ServiceAccountName string `yaml:"serviceAccount,omitempty"`
+
+	// StructureTestImage is the image used to run container structure tests.
+	// Defaults to `gcr.io/gcp-runtimes/container-structure-test:latest`.
+	StructureTestImage string `yaml:"structureTestImage,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
@@ -510,12 +543,56 @@ type DeployConfig struct {
 	// StatusCheckDeadlineSeconds *beta* is the deadline for deployments to stabilize in seconds.
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
 
+	// StatusCheckRules *alpha* declares when resources of a given kind are ready or failed,
+	// based on their `.status.conditions`. Matching resources deployed by Skaffold participate in the status check.
+	StatusCheckRules []StatusCheckRule `yaml:"statusCheckRules,omitempty"`
+
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// CleanupHooks describes a set of lifecycle hooks that are executed before and after each deployer cleans up.
+	CleanupHooks CleanupHooks `yaml:"cleanupHooks,omitempty"`
+}
+
+// StatusCheckRule describes how the status check evaluates resources of a given kind.
+type StatusCheckRule struct {
+	// Group is the API group of the resource. Empty for the core group.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group,omitempty"`
+
+	// Version is the API version of the resource. Defaults to the preferred version of the group.
+	// For example: `v1`.
+	Version string `yaml:"version,omitempty"`
+
+	// Kind is the kind of the resource.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// Ready is the condition that marks the resource as ready.
+	// Defaults to the `Ready` condition with status `True`.
+	Ready *StatusCondition `yaml:"ready,omitempty"`
+
+	// Failed is the condition that marks the resource as failed.
+	// If not set, the resource is only failed when it doesn't become ready before the status check deadline.
+	Failed *StatusCondition `yaml:"failed,omitempty"`
+}
+
+// StatusCondition matches an entry of a resource's `.status.conditions`.
+type StatusCondition struct {
+	// Type is the type of the condition. Defaults to `Ready`.
+	Type string `yaml:"type,omitempty"`
+
+	// Status is the expected status of the condition, for example `True` or `False`.
+	// Any status matches if not set, except for the `ready` condition which defaults to `True`.
+	Status string `yaml:"status,omitempty"`
+
+	// Reason is the expected reason of the condition. Any reason matches if not set.
+	// For example: `Failed`.
+	Reason string `yaml:"reason,omitempty"`
 }
 
 // DeployType contains the specific implementation and parameters needed
@@ -544,8 +621,22 @@ type DockerDeploy struct {
 	// UseCompose tells skaffold whether or not to deploy using `docker-compose`.
 	UseCompose bool `yaml:"useCompose,omitempty"`
 
+	// ComposeFile is the path to the compose file used when `useCompose` is true.
+	// Services whose `image` is one of the built artifacts run the freshly built image.
+	// Defaults to `docker-compose.yml`.
+	ComposeFile string `yaml:"composeFile,omitempty" skaffold:"filepath"`
+
 	// Images are the container images to run in Docker.
 	Images []string `yaml:"images" yamltags:"required"`
+
+	// Concurrency is how many containers can be started concurrently. 0 means "no-limit".
+	// Defaults to `0`.
+	Concurrency int `yaml:"concurrency,omitempty"`
+
+	// DependsOn lists, for each image, the images whose containers must be ready before its own container is started.
+	// A container is ready once it's running and healthy, or once it has completed successfully.
+	// For example: `{"api": ["db"]}`.
+	DependsOn map[string][]string `yaml:"dependsOn,omitempty"`
 }
 
 // KubectlDeploy *beta* uses a client side `kubectl apply` to deploy manifests.
@@ -844,6 +935,34 @@ type LogsConfig struct {
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// Include *alpha* lists filters that select the application log lines to print.
+	// If set, only the lines matching at least one of the filters are printed.
+	Include []LogFilter `yaml:"include,omitempty"`
+
+	// Exclude *alpha* lists filters matching the application log lines that are never printed.
+	Exclude []LogFilter `yaml:"exclude,omitempty"`
+
+	// Highlight *alpha* lists regular expressions whose matches are highlighted in application log lines.
+	// For example: `["timeout", "connection refused"]`.
+	Highlight []string `yaml:"highlight,omitempty"`
+
+	// HighlightErrors *alpha* highlights errors, panics and stack traces in application log lines.
+	HighlightErrors *bool `yaml:"highlightErrors,omitempty"`
+}
+
+// LogFilter matches application log lines. A line matches if all the fields that are set match.
+type LogFilter struct {
+	// Container is a glob pattern matching the name of the container.
+	// For example: `web-*`.
+	Container string `yaml:"container,omitempty"`
+
+	// PodLabels are labels that the pod of the container must have.
+	PodLabels map[string]string `yaml:"podLabels,omitempty"`
+
+	// Message is a regular expression matching the log line.
+	// For example: `GET /healthz`.
+	Message string `yaml:"message,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -991,6 +1110,9 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images using [ko](https://github.com/google/ko).
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -1104,6 +1226,44 @@ type CustomTest struct {
 	Dependencies *CustomTestDependencies `yaml:"dependencies,omitempty"`
 }
 
+// VerifyTestCase describes an integration test to run against the deployed application.
+type VerifyTestCase struct {
+	// Name is a unique name for the test.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Container describes a container that runs the test inside the cluster, with access to the services of the application by their DNS names.
+	Container *VerifyContainer `yaml:"container,omitempty" yamltags:"oneOf=verify"`
+
+	// Command is a command that runs the test on the host machine, with access to the forwarded ports of the application.
+	// If the command exits with a non-zero return code, the test will be considered to have failed.
+	Command string `yaml:"command,omitempty" yamltags:"oneOf=verify"`
+
+	// TimeoutSeconds sets the wait time for skaffold for the test to complete.
+	// If unset or 0, Skaffold will wait until the test completes.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+// VerifyContainer describes a container that runs an integration test inside the cluster, as a Kubernetes Job.
+type VerifyContainer struct {
+	// Image is the image of the container. If it is the name of an artifact built by Skaffold, the freshly built tag is used.
+	// For example: `gcr.io/k8s-skaffold/integration-tests`.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Command overrides the entrypoint of the image.
+	Command []string `yaml:"command,omitempty"`
+
+	// Args are the arguments passed to the entrypoint of the image.
+	Args []string `yaml:"args,omitempty"`
+
+	// Env are environment variables set in the container.
+	// For example: `[{"name": "BASE_URL", "value": "http://web:8080"}]`.
+	Env []v1.EnvVar `yaml:"env,omitempty"`
+
+	// Namespace is the Kubernetes namespace in which the test job is created.
+	// Defaults to the namespace of the current kube context.
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
 // CustomTestDependencies is used to specify dependencies for custom test command.
 // `paths` should be specified for file watching to work as expected.
 type CustomTestDependencies struct {
@@ -1339,12 +1499,63 @@ type JibArtifact struct {
 	BaseImage string `yaml:"fromImage,omitempty"`
 }
 
+// KoArtifact *alpha* builds images using [ko](https://github.com/google/ko).
+// It builds Go applications into container images without requiring a Docker daemon.
+type KoArtifact struct {
+	// BaseImage overrides the default ko base image (`gcr.io/distroless/static:nonroot`).
+	BaseImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the location of the main package, relative to the artifact context.
+	// It is the pattern passed to `go build` and must resolve to a single main package.
+	// Ignored if the image name starts with `ko://`, in which case the import path in the image name is used.
+	// Defaults to `.`.
+	// For example: `./cmd/app`.
+	Main string `yaml:"main,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// For example: `["GOPRIVATE=source.developers.google.com", "GOCACHE=/workspace/.gocache"]`.
+	Env []string `yaml:"env,omitempty"`
+
+	// Flags are additional build flags passed to `go build`.
+	// For example: `["-tags=netgo", "-v"]`.
+	Flags []string `yaml:"flags,omitempty"`
+
+	// Ldflags are linker flags passed to `go build`.
+	// For example: `["-s", "-w", "-X main.version=1.0.0"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+
+	// Platforms is the list of platforms to build images for, in the `os/arch[/variant]` form.
+	// Use `all` to build for all platforms supported by the base image.
+	// Defaults to `["linux/amd64"]`.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Dependencies are the file dependencies that Skaffold should watch for both rebuilding and file syncing for this artifact.
+	Dependencies *KoDependencies `yaml:"dependencies,omitempty"`
+}
+
+// KoDependencies is used to specify dependencies for an artifact built by ko.
+type KoDependencies struct {
+	// Paths should be set to the file dependencies for this artifact, so that the Skaffold file watcher knows when to rebuild and perform file synchronization.
+	// Defaults to `["."]`.
+	Paths []string `yaml:"paths,omitempty" yamltags:"oneOf=dependency"`
+
+	// Ignore specifies the paths that should be ignored by Skaffold's file watcher.
+	// If a file exists in both `paths` and in `ignore`, it will be ignored, and will be excluded from both rebuilds and file synchronization.
+	// Will only work in conjunction with `paths`.
+	Ignore []string `yaml:"ignore,omitempty"`
+}
+
 // BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
 type BuildHooks struct {
 	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
 	PreHooks []HostHook `yaml:"before,omitempty"`
 	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
 	PostHooks []HostHook `yaml:"after,omitempty"`
+	// BuilderHooks describes the list of lifecycle hooks to execute inside the builder, after the build context is available and *before* the kaniko executor starts.
+	// With the `cluster` builder they run in the init container of the kaniko pod. With the `googleCloudBuild` builder they run as build steps using the kaniko `initImage`.
+	// Commands run from the build context directory. Only supported by `kaniko` artifacts.
+	BuilderHooks []ContainerHook `yaml:"builder,omitempty"`
 }
 
 // SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
@@ -1379,6 +1590,38 @@ type DeployHooks struct {
 	PostHooks []DeployHookItem `yaml:"after,omitempty"`
 }
 
+// TestHookItem describes a single lifecycle hook to execute before or after the tests of an artifact.
+type TestHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=test_hook"`
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=test_hook"`
+}
+
+// TestHooks describes the list of lifecycle hooks to execute before and after the tests of an artifact.
+type TestHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* the tests of an artifact.
+	PreHooks []TestHookItem `yaml:"before,omitempty"`
+	// PostHooks describes the list of lifecycle hooks to execute *after* the tests of an artifact, even if they failed.
+	PostHooks []TestHookItem `yaml:"after,omitempty"`
+}
+
+// CleanupHookItem describes a single lifecycle hook to execute before or after each deployer cleans up.
+type CleanupHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=cleanup_hook"`
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=cleanup_hook"`
+}
+
+// CleanupHooks describes the list of lifecycle hooks to execute before and after each deployer cleans up.
+type CleanupHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deployer cleans up.
+	PreHooks []CleanupHookItem `yaml:"before,omitempty"`
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deployer cleans up, even if it failed.
+	PostHooks []CleanupHookItem `yaml:"after,omitempty"`
+}
+
 // HostHook describes a lifecycle hook definition to execute on the host machine.
 type HostHook struct {
 	// Command is the command to execute.
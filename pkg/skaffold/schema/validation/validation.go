@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/parser"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -72,9 +74,14 @@ func Process(configs parser.SkaffoldConfigSet, validateConfig Options) error {
 		cfgErrs = append(cfgErrs, validatePortForwardResources(config.PortForward)...)
 		cfgErrs = append(cfgErrs, validateJibPluginTypes(config.Build.Artifacts)...)
 		cfgErrs = append(cfgErrs, validateLogPrefix(config.Deploy.Logs)...)
+		cfgErrs = append(cfgErrs, validateLogFilters(config.Deploy.Logs)...)
 		cfgErrs = append(cfgErrs, validateArtifactTypes(config.Build)...)
+		cfgErrs = append(cfgErrs, validateBuilderHooks(config.Build.Artifacts)...)
 		cfgErrs = append(cfgErrs, validateTaggingPolicy(config.Build)...)
 		cfgErrs = append(cfgErrs, validateCustomTest(config.Test)...)
+		cfgErrs = append(cfgErrs, validateVerifyTestCases(config.Verify)...)
+		cfgErrs = append(cfgErrs, validateDockerDeployDependencies(config.Deploy.DockerDeploy)...)
+		cfgErrs = append(cfgErrs, validateStatusCheckRules(config.Deploy.StatusCheckRules)...)
 		errs = append(errs, wrapWithContext(config, cfgErrs...)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
@@ -99,6 +106,7 @@ func Process(configs parser.SkaffoldConfigSet, validateConfig Options) error {
 func ProcessWithRunContext(runCtx *runcontext.RunContext) error {
 	var errs []error
 	errs = append(errs, validateDockerNetworkContainerExists(runCtx.Artifacts(), runCtx)...)
+	errs = append(errs, validateLogFilterOptions(runCtx.LogFilters())...)
 
 	if len(errs) == 0 {
 		return nil
@@ -178,6 +186,71 @@ func validateAcyclicDependencies(artifacts []*latestV1.Artifact) (errs []error)
 	return
 }
 
+// validateDockerDeployDependencies makes sure the startup dependencies of the docker deployer
+// are deployed images and don't have cyclic references
+func validateDockerDeployDependencies(d *latestV1.DockerDeploy) (errs []error) {
+	if d == nil {
+		return
+	}
+	var images []string
+	for image := range d.DependsOn {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	for _, image := range images {
+		if !util.StrSliceContains(d.Images, image) {
+			errs = append(errs, fmt.Errorf("unknown image %q in docker deploy dependencies", image))
+		}
+		for _, dep := range d.DependsOn[image] {
+			if !util.StrSliceContains(d.Images, dep) {
+				errs = append(errs, fmt.Errorf("unknown dependency %q for image %q in docker deploy dependencies", dep, image))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return
+	}
+
+	visited := make(map[string]bool)
+	for _, image := range images {
+		if err := dockerDeployDFS(image, visited, make(map[string]bool), d.DependsOn); err != nil {
+			return []error{err}
+		}
+	}
+	return
+}
+
+// validateStatusCheckRules makes sure that a `failed` condition can't match every condition of its type
+func validateStatusCheckRules(rules []latestV1.StatusCheckRule) (errs []error) {
+	for _, r := range rules {
+		if r.Failed != nil && r.Failed.Status == "" && r.Failed.Reason == "" {
+			errs = append(errs, fmt.Errorf("status check rule for kind %q: failed condition must set a status or a reason", r.Kind))
+		}
+	}
+	return
+}
+
+func dockerDeployDFS(image string, visited, marked map[string]bool, dependsOn map[string][]string) error {
+	if marked[image] {
+		return fmt.Errorf("cycle detected in docker deploy dependencies involving %q", image)
+	}
+	marked[image] = true
+	defer func() {
+		marked[image] = false
+	}()
+	if visited[image] {
+		return nil
+	}
+	visited[image] = true
+
+	for _, dep := range dependsOn[image] {
+		if err := dockerDeployDFS(dep, visited, marked, dependsOn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // dfs runs a Depth First Search algorithm for cycle detection in a directed graph
 func dfs(artifact *latestV1.Artifact, visited, marked map[string]bool, artifacts map[string]*latestV1.Artifact) error {
 	if marked[artifact.ImageName] {
@@ -489,6 +562,8 @@ func validatePortForwardResources(pfrs []*latestV1.PortForwardResource) []error
 		"daemonset":             {},
 		"cronjob":               {},
 		"job":                   {},
+		"container":             {},
+		"ingress":               {},
 	}
 	for _, pfr := range pfrs {
 		resourceType := strings.ToLower(string(pfr.Type))
@@ -532,7 +607,8 @@ func validateArtifactTypes(bc latestV1.BuildConfig) (errs []error) {
 		}
 	case bc.Cluster != nil:
 		for _, a := range bc.Artifacts {
-			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom {
+			at := misc.ArtifactType(a)
+			if at != misc.Kaniko && at != misc.Custom && at != misc.Ko {
 				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'cluster' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'cluster' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
 			}
 		}
@@ -540,6 +616,16 @@ func validateArtifactTypes(bc latestV1.BuildConfig) (errs []error) {
 	return
 }
 
+// validateBuilderHooks checks that hooks running inside the builder are only defined for kaniko artifacts.
+func validateBuilderHooks(artifacts []*latestV1.Artifact) (errs []error) {
+	for _, a := range artifacts {
+		if len(a.LifecycleHooks.BuilderHooks) > 0 && misc.ArtifactType(a) != misc.Kaniko {
+			errs = append(errs, fmt.Errorf("artifact %s has builder hooks, which are only supported by kaniko artifacts", a.ImageName))
+		}
+	}
+	return
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(lc latestV1.LogsConfig) []error {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
@@ -551,6 +637,22 @@ func validateLogPrefix(lc latestV1.LogsConfig) []error {
 	return nil
 }
 
+// validateLogFilters checks that the `deploy.logs` filters and highlight patterns are valid.
+func validateLogFilters(lc latestV1.LogsConfig) []error {
+	if _, err := log.NewFilters(lc, config.LogFilterOptions{}, true); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// validateLogFilterOptions checks that the log filters and highlight patterns given as flags are valid.
+func validateLogFilterOptions(opts config.LogFilterOptions) []error {
+	if _, err := log.NewFilters(latestV1.LogsConfig{}, opts, true); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
 func validateSingleKubeContext(configs parser.SkaffoldConfigSet) []error {
 	if len(configs) < 2 {
 		return nil
@@ -589,6 +691,24 @@ func validateCustomTest(tcs []*latestV1.TestCase) (errs []error) {
 	return
 }
 
+// validateVerifyTestCases
+// - makes sure that the verify test names are unique
+// - makes sure that each verify test has either a container or a command
+func validateVerifyTestCases(tcs []*latestV1.VerifyTestCase) (errs []error) {
+	seen := map[string]bool{}
+	for _, tc := range tcs {
+		if seen[tc.Name] {
+			errs = append(errs, fmt.Errorf("duplicate verify test name %q: verify test names must be unique", tc.Name))
+		}
+		seen[tc.Name] = true
+
+		if tc.Container == nil && tc.Command == "" {
+			errs = append(errs, fmt.Errorf("verify test %q must have either a container or a command", tc.Name))
+		}
+	}
+	return
+}
+
 func wrapWithContext(config *parser.SkaffoldConfigEntry, errs ...error) []error {
 	var id string
 	if config.Metadata.Name != "" {
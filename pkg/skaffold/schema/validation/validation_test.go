@@ -28,6 +28,7 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/parser"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
@@ -872,6 +873,45 @@ func TestValidateCustomDependencies(t *testing.T) {
 	}
 }
 
+func TestValidateBuilderHooks(t *testing.T) {
+	builderHooks := latestV1.BuildHooks{BuilderHooks: []latestV1.ContainerHook{{Command: []string{"make"}}}}
+	tests := []struct {
+		description    string
+		artifact       *latestV1.Artifact
+		expectedErrors int
+	}{
+		{
+			description: "kaniko artifact",
+			artifact: &latestV1.Artifact{
+				ArtifactType:   latestV1.ArtifactType{KanikoArtifact: &latestV1.KanikoArtifact{}},
+				LifecycleHooks: builderHooks,
+			},
+		},
+		{
+			description: "docker artifact",
+			artifact: &latestV1.Artifact{
+				ArtifactType:   latestV1.ArtifactType{DockerArtifact: &latestV1.DockerArtifact{}},
+				LifecycleHooks: builderHooks,
+			},
+			expectedErrors: 1,
+		},
+		{
+			description: "docker artifact without builder hooks",
+			artifact: &latestV1.Artifact{
+				ArtifactType:   latestV1.ArtifactType{DockerArtifact: &latestV1.DockerArtifact{}},
+				LifecycleHooks: latestV1.BuildHooks{PreHooks: []latestV1.HostHook{{Command: []string{"make"}}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateBuilderHooks([]*latestV1.Artifact{test.artifact})
+
+			t.CheckDeepEqual(test.expectedErrors, len(errs))
+		})
+	}
+}
+
 func TestValidatePortForwardResources(t *testing.T) {
 	tests := []struct {
 		resourceType string
@@ -886,6 +926,7 @@ func TestValidatePortForwardResources(t *testing.T) {
 		{resourceType: "daemonset"},
 		{resourceType: "cronjob"},
 		{resourceType: "job"},
+		{resourceType: "Ingress"},
 		{resourceType: "dne", shouldErr: true},
 	}
 	for _, test := range tests {
@@ -1396,6 +1437,42 @@ func errorsComparer(a, b error) bool {
 	return a.Error() == b.Error()
 }
 
+func TestValidateArtifactTypes(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latestV1.ArtifactType
+		shouldErr   bool
+	}{
+		{
+			description: "kaniko",
+			artifact:    latestV1.ArtifactType{KanikoArtifact: &latestV1.KanikoArtifact{}},
+		},
+		{
+			description: "custom",
+			artifact:    latestV1.ArtifactType{CustomArtifact: &latestV1.CustomArtifact{}},
+		},
+		{
+			description: "ko",
+			artifact:    latestV1.ArtifactType{KoArtifact: &latestV1.KoArtifact{}},
+		},
+		{
+			description: "docker",
+			artifact:    latestV1.ArtifactType{DockerArtifact: &latestV1.DockerArtifact{}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateArtifactTypes(latestV1.BuildConfig{
+				Artifacts: []*latestV1.Artifact{{ImageName: "img", ArtifactType: test.artifact}},
+				BuildType: latestV1.BuildType{Cluster: &latestV1.ClusterDetails{}},
+			})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateTaggingPolicy(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1511,6 +1588,167 @@ func TestValidateCustomTest(t *testing.T) {
 	}
 }
 
+func TestValidateVerifyTestCases(t *testing.T) {
+	tests := []struct {
+		description    string
+		testCases      []*latestV1.VerifyTestCase
+		expectedErrors int
+	}{
+		{
+			description: "container and command tests",
+			testCases: []*latestV1.VerifyTestCase{
+				{Name: "in-cluster", Container: &latestV1.VerifyContainer{Image: "tests"}},
+				{Name: "on-host", Command: "./smoke-test.sh"},
+			},
+		},
+		{
+			description: "duplicate names",
+			testCases: []*latestV1.VerifyTestCase{
+				{Name: "smoke", Command: "./smoke-test.sh"},
+				{Name: "smoke", Command: "./other-test.sh"},
+			},
+			expectedErrors: 1,
+		},
+		{
+			description:    "neither container nor command",
+			testCases:      []*latestV1.VerifyTestCase{{Name: "empty"}},
+			expectedErrors: 1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateVerifyTestCases(test.testCases)
+
+			t.CheckDeepEqual(test.expectedErrors, len(errs))
+		})
+	}
+}
+
+func TestValidateDockerDeployDependencies(t *testing.T) {
+	tests := []struct {
+		description    string
+		dependsOn      map[string][]string
+		expectedErrors []string
+	}{
+		{
+			description: "no dependencies",
+		},
+		{
+			description: "valid dependencies",
+			dependsOn:   map[string][]string{"api": {"db"}, "web": {"api", "db"}},
+		},
+		{
+			description: "unknown images",
+			dependsOn:   map[string][]string{"api": {"cache"}, "worker": {"db"}},
+			expectedErrors: []string{
+				`unknown dependency "cache" for image "api" in docker deploy dependencies`,
+				`unknown image "worker" in docker deploy dependencies`,
+			},
+		},
+		{
+			description:    "cycle",
+			dependsOn:      map[string][]string{"api": {"db"}, "db": {"web"}, "web": {"api"}},
+			expectedErrors: []string{`cycle detected in docker deploy dependencies involving "api"`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateDockerDeployDependencies(&latestV1.DockerDeploy{
+				Images:    []string{"web", "api", "db"},
+				DependsOn: test.dependsOn,
+			})
+
+			var messages []string
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			t.CheckDeepEqual(test.expectedErrors, messages)
+		})
+	}
+}
+
+func TestValidateStatusCheckRules(t *testing.T) {
+	tests := []struct {
+		description string
+		rules       []latestV1.StatusCheckRule
+		shouldErr   bool
+	}{
+		{
+			description: "no rules",
+		},
+		{
+			description: "ready only",
+			rules:       []latestV1.StatusCheckRule{{Group: "cert-manager.io", Kind: "Certificate"}},
+		},
+		{
+			description: "failed with reason",
+			rules: []latestV1.StatusCheckRule{{
+				Kind:   "KafkaTopic",
+				Failed: &latestV1.StatusCondition{Type: "Ready", Reason: "Failed"},
+			}},
+		},
+		{
+			description: "failed matching any condition",
+			rules: []latestV1.StatusCheckRule{{
+				Kind:   "KafkaTopic",
+				Failed: &latestV1.StatusCondition{Type: "Ready"},
+			}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateStatusCheckRules(test.rules)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateLogFilters(t *testing.T) {
+	tests := []struct {
+		description string
+		logs        latestV1.LogsConfig
+		opts        config.LogFilterOptions
+		shouldErr   bool
+	}{
+		{
+			description: "no filters",
+		},
+		{
+			description: "valid filters",
+			logs: latestV1.LogsConfig{
+				Include:   []latestV1.LogFilter{{Container: "web-*", PodLabels: map[string]string{"app": "web"}}},
+				Exclude:   []latestV1.LogFilter{{Message: "GET /healthz"}},
+				Highlight: []string{"timeout"},
+			},
+			opts: config.LogFilterOptions{Include: []string{"label:tier=backend"}},
+		},
+		{
+			description: "invalid message regexp",
+			logs:        latestV1.LogsConfig{Exclude: []latestV1.LogFilter{{Message: "(healthz"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid highlight pattern",
+			logs:        latestV1.LogsConfig{Highlight: []string{"["}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid filter flag",
+			opts:        config.LogFilterOptions{Exclude: []string{"healthz"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := append(validateLogFilters(test.logs), validateLogFilterOptions(test.opts)...)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateKubectlManifests(t *testing.T) {
 	tempDir := t.TempDir()
 	tests := []struct {
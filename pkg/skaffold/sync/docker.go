@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ContainerSyncer syncs files into the containers deployed to a local Docker daemon.
+type ContainerSyncer struct {
+	client  docker.LocalDaemon
+	tracker *tracker.ContainerTracker
+}
+
+func NewContainerSyncer(client docker.LocalDaemon, tracker *tracker.ContainerTracker) *ContainerSyncer {
+	return &ContainerSyncer{
+		client:  client,
+		tracker: tracker,
+	}
+}
+
+func (s *ContainerSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	if !item.HasChanges() {
+		return nil
+	}
+
+	var containerIDs []string
+	for _, c := range s.tracker.Containers() {
+		if c.Image == item.Image {
+			containerIDs = append(containerIDs, c.ID)
+		}
+	}
+	if len(containerIDs) == 0 {
+		return errors.New("didn't sync any files")
+	}
+
+	var copy, delete []string
+	for k := range item.Copy {
+		copy = append(copy, k)
+	}
+	for k := range item.Delete {
+		delete = append(delete, k)
+	}
+
+	opts, err := hooks.NewSyncEnvOpts(item.Artifact, item.Image, copy, delete, nil, "")
+	if err != nil {
+		return err
+	}
+	hooksRunner := hooks.NewDockerSyncRunner(s.client, item.Artifact.ImageName, containerIDs, item.Artifact.Sync.LifecycleHooks, opts)
+	if err := hooksRunner.RunPreHooks(ctx, out); err != nil {
+		return fmt.Errorf("pre-sync hooks failed for artifact %q: %w", item.Artifact.ImageName, err)
+	}
+	if err := s.sync(ctx, out, item, containerIDs); err != nil {
+		return err
+	}
+	if err := hooksRunner.RunPostHooks(ctx, out); err != nil {
+		return fmt.Errorf("post-sync hooks failed for artifact %q: %w", item.Artifact.ImageName, err)
+	}
+	return nil
+}
+
+func (s *ContainerSyncer) sync(ctx context.Context, out io.Writer, item *Item, containerIDs []string) error {
+	if len(item.Copy) > 0 {
+		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
+
+		if err := performInContainers(ctx, containerIDs, func(ctx context.Context, id string) error {
+			return s.copyFiles(ctx, id, item.Copy)
+		}); err != nil {
+			return fmt.Errorf("copying files: %w", err)
+		}
+	}
+
+	if len(item.Delete) > 0 {
+		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
+
+		if err := performInContainers(ctx, containerIDs, func(ctx context.Context, id string) error {
+			return s.deleteFiles(ctx, out, id, item.Delete)
+		}); err != nil {
+			return fmt.Errorf("deleting files: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ContainerSyncer) copyFiles(ctx context.Context, id string, files syncMap) error {
+	reader, writer := io.Pipe()
+	go func() {
+		if err := util.CreateMappedTar(writer, "/", files); err != nil {
+			writer.CloseWithError(err)
+		} else {
+			writer.Close()
+		}
+	}()
+
+	err := s.client.CopyToContainer(ctx, id, "/", reader)
+	reader.Close()
+	return err
+}
+
+func (s *ContainerSyncer) deleteFiles(ctx context.Context, out io.Writer, id string, files syncMap) error {
+	args := []string{"rm", "-rf", "--"}
+	for _, dsts := range files {
+		args = append(args, dsts...)
+	}
+	return s.client.ContainerExec(ctx, out, id, args)
+}
+
+func performInContainers(ctx context.Context, containerIDs []string, fn func(context.Context, string) error) error {
+	errs, ctx := errgroup.WithContext(ctx)
+	for _, id := range containerIDs {
+		id := id
+		errs.Go(func() error {
+			return fn(ctx, id)
+		})
+	}
+	return errs.Wait()
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker/tracker"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeDockerDaemon struct {
+	docker.LocalDaemon
+
+	lock   sync.Mutex
+	copied map[string][]string // container id -> copied files
+	execs  []string
+}
+
+func (f *fakeDockerDaemon) CopyToContainer(_ context.Context, id string, _ string, content io.Reader) error {
+	tr := tar.NewReader(content)
+	var files []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		files = append(files, hdr.Name)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.copied == nil {
+		f.copied = map[string][]string{}
+	}
+	f.copied[id] = append(f.copied[id], files...)
+	return nil
+}
+
+func (f *fakeDockerDaemon) ContainerExec(_ context.Context, _ io.Writer, id string, cmd []string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.execs = append(f.execs, id+": "+strings.Join(cmd, " "))
+	return nil
+}
+
+func TestContainerSyncer(t *testing.T) {
+	tests := []struct {
+		description    string
+		item           *Item
+		expectedCopied map[string][]string
+		expectedExecs  []string
+		shouldErr      bool
+	}{
+		{
+			description: "no changes",
+			item:        &Item{Image: "img:123"},
+		},
+		{
+			description: "copy and delete",
+			item: &Item{
+				Image:    "img:123",
+				Artifact: &latestV1.Artifact{ImageName: "img", Sync: &latestV1.Sync{}},
+				Copy:     map[string][]string{"foo.txt": {"/app/foo.txt"}},
+				Delete:   map[string][]string{"bar.txt": {"/app/bar.txt"}},
+			},
+			expectedCopied: map[string][]string{"id1": {"/app/foo.txt"}},
+			expectedExecs:  []string{"id1: rm -rf -- /app/bar.txt"},
+		},
+		{
+			description: "container hooks",
+			item: &Item{
+				Image: "img:123",
+				Artifact: &latestV1.Artifact{ImageName: "img", Sync: &latestV1.Sync{
+					LifecycleHooks: latestV1.SyncHooks{
+						PreHooks:  []latestV1.SyncHookItem{{ContainerHook: &latestV1.ContainerHook{Command: []string{"pre"}}}},
+						PostHooks: []latestV1.SyncHookItem{{ContainerHook: &latestV1.ContainerHook{Command: []string{"post"}}}},
+					},
+				}},
+				Delete: map[string][]string{"bar.txt": {"/app/bar.txt"}},
+			},
+			expectedExecs: []string{"id1: pre", "id1: rm -rf -- /app/bar.txt", "id1: post"},
+		},
+		{
+			description: "no matching container",
+			item: &Item{
+				Image:    "other:123",
+				Artifact: &latestV1.Artifact{ImageName: "other", Sync: &latestV1.Sync{}},
+				Copy:     map[string][]string{"foo.txt": {"/app/foo.txt"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("foo.txt").Chdir()
+
+			containers := tracker.NewContainerTracker()
+			containers.Add("img", tracker.Container{Name: "img", ID: "id1", Image: "img:123"})
+			daemon := &fakeDockerDaemon{}
+
+			err := NewContainerSyncer(daemon, containers).Sync(context.Background(), ioutil.Discard, test.item)
+
+			t.CheckError(test.shouldErr, err)
+			for _, files := range daemon.copied {
+				sort.Strings(files)
+			}
+			t.CheckDeepEqual(test.expectedCopied, daemon.copied)
+			t.CheckDeepEqual(test.expectedExecs, daemon.execs)
+		})
+	}
+}
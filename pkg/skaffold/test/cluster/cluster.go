@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/logger"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	structureTestsMountPath = "/skaffold/structure-tests"
+	structureTestsVolume    = "structure-tests"
+)
+
+type Config interface {
+	kubectl.Config
+	logger.Config
+}
+
+// Runner runs the custom and structure tests of a test case inside the cluster, as Kubernetes Jobs.
+type Runner struct {
+	testCase *latestV1.TestCase
+	jobs     *JobRunner
+}
+
+// New creates a new cluster.Runner.
+func New(cfg Config, tc *latestV1.TestCase) *Runner {
+	return &Runner{
+		testCase: tc,
+		jobs:     NewJobRunner(cfg, tc.Cluster.Namespace, tc.Cluster.ServiceAccountName),
+	}
+}
+
+// Name describes the tests run in the cluster by their structure test files and custom test commands.
+func (r *Runner) Name() string {
+	var tests []string
+	if len(r.testCase.StructureTests) > 0 {
+		tests = append(tests, "structure: "+strings.Join(r.testCase.StructureTests, ", "))
+	}
+	for _, ct := range r.testCase.CustomTests {
+		tests = append(tests, "custom: "+ct.Command)
+	}
+	return fmt.Sprintf("cluster (%s)", strings.Join(tests, "; "))
+}
+
+// Test is the entrypoint for running tests inside the cluster
+func (r *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
+	event.TestInProgress()
+	if err := r.runTests(ctx, out, imageTag); err != nil {
+		event.TestFailed(r.testCase.ImageName, err)
+		return err
+	}
+	event.TestComplete()
+	return nil
+}
+
+func (r *Runner) runTests(ctx context.Context, out io.Writer, imageTag string) error {
+	if len(r.testCase.StructureTests) > 0 {
+		if err := r.runStructureTests(ctx, out, imageTag); err != nil {
+			return fmt.Errorf("running structure tests: %w", err)
+		}
+	}
+
+	for _, ct := range r.testCase.CustomTests {
+		name := newJobName()
+		output.Default.Fprintf(out, "Running custom test command %q in job %s\n", ct.Command, name)
+		if err := r.jobs.Run(ctx, out, name, customTestContainer(ct, imageTag), nil, ct.TimeoutSeconds); err != nil {
+			return fmt.Errorf("running custom test command %q: %w", ct.Command, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runner) runStructureTests(ctx context.Context, out io.Writer, imageTag string) error {
+	files, err := util.ExpandPathsGlob(r.testCase.Workspace, r.testCase.StructureTests)
+	if err != nil {
+		return fmt.Errorf("expanding structure test file paths: %w", err)
+	}
+
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	name := newJobName()
+	configMap, err := structureTestsConfigMap(name, r.jobs.Namespace(), files)
+	if err != nil {
+		return err
+	}
+	configMaps := client.CoreV1().ConfigMaps(r.jobs.Namespace())
+	if _, err := configMaps.Create(ctx, configMap, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating structure tests config map: %w", err)
+	}
+	defer configMaps.Delete(context.Background(), name, metav1.DeleteOptions{})
+
+	output.Default.Fprintf(out, "Running structure tests in job %s\n", name)
+	volumes := []v1.Volume{{
+		Name: structureTestsVolume,
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: name},
+			},
+		},
+	}}
+	return r.jobs.Run(ctx, out, name, r.structureTestContainer(imageTag, files), volumes, 0)
+}
+
+// TestDependencies returns the structure test files and the dependencies of the custom tests.
+func (r *Runner) TestDependencies() ([]string, error) {
+	deps, err := util.ExpandPathsGlob(r.testCase.Workspace, r.testCase.StructureTests)
+	if err != nil {
+		return nil, fmt.Errorf("expanding structure test file paths: %w", err)
+	}
+
+	for _, ct := range r.testCase.CustomTests {
+		runner, err := custom.New(nil, r.testCase.ImageName, r.testCase.Workspace, ct)
+		if err != nil {
+			return nil, err
+		}
+		result, err := runner.TestDependencies()
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, result...)
+	}
+	return deps, nil
+}
+
+func (r *Runner) structureTestContainer(imageTag string, files []string) v1.Container {
+	args := []string{"test", "-v", "warn", "--driver", "tar", "--image", imageTag}
+	for i, f := range files {
+		args = append(args, "--config", path.Join(structureTestsMountPath, structureTestKey(i, f)))
+	}
+	args = append(args, r.testCase.StructureTestArgs...)
+
+	return v1.Container{
+		Name:  testContainerName,
+		Image: r.testCase.Cluster.StructureTestImage,
+		Args:  args,
+		VolumeMounts: []v1.VolumeMount{{
+			Name:      structureTestsVolume,
+			MountPath: structureTestsMountPath,
+		}},
+	}
+}
+
+func customTestContainer(ct latestV1.CustomTest, imageTag string) v1.Container {
+	return v1.Container{
+		Name:    testContainerName,
+		Image:   imageTag,
+		Command: []string{"sh", "-c", ct.Command},
+		Env:     []v1.EnvVar{{Name: "IMAGE", Value: imageTag}},
+	}
+}
+
+// structureTestsConfigMap creates a config map holding the structure test files.
+func structureTestsConfigMap(name, namespace string, files []string) (*v1.ConfigMap, error) {
+	data := map[string]string{}
+	for i, f := range files {
+		content, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading structure test file %q: %w", f, err)
+		}
+		data[structureTestKey(i, f)] = string(content)
+	}
+
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{jobLabel: name},
+		},
+		Data: data,
+	}, nil
+}
+
+// structureTestKey is the config map key of a structure test file.
+// It is prefixed with the index of the file, since files in different directories can have the same name.
+func structureTestKey(i int, file string) string {
+	return fmt.Sprintf("%d-%s", i, filepath.Base(file))
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+type mockConfig struct{}
+
+func (c *mockConfig) GetKubeContext() string              { return "" }
+func (c *mockConfig) GetKubeConfig() string               { return "" }
+func (c *mockConfig) GetKubeNamespace() string            { return "" }
+func (c *mockConfig) Tail() bool                          { return false }
+func (c *mockConfig) JSONLogs() bool                      { return false }
+func (c *mockConfig) LogFilters() config.LogFilterOptions { return config.LogFilterOptions{} }
+func (c *mockConfig) LogFiles() config.LogFileOptions     { return config.LogFileOptions{} }
+func (c *mockConfig) PipelineForImage(string) (latestV1.Pipeline, bool) {
+	return c.DefaultPipeline(), true
+}
+func (c *mockConfig) DefaultPipeline() latestV1.Pipeline {
+	var pipeline latestV1.Pipeline
+	pipeline.Deploy.Logs.Prefix = "auto"
+	return pipeline
+}
+
+func testPod(name string, phase v1.PodPhase, exitCode int32) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-abcde",
+			Namespace: "ns",
+			Labels:    map[string]string{jobLabel: name},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: testContainerName, Image: "img:tag"}}},
+		Status: v1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  testContainerName,
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: exitCode}},
+			}},
+		},
+	}
+}
+
+func createdJobs(client *fakekubeclientset.Clientset) []*batchv1.Job {
+	var jobs []*batchv1.Job
+	for _, a := range client.Actions() {
+		if create, ok := a.(k8stesting.CreateAction); ok && a.GetResource().Resource == "jobs" {
+			jobs = append(jobs, create.GetObject().(*batchv1.Job))
+		}
+	}
+	return jobs
+}
+
+func TestClusterTests(t *testing.T) {
+	tests := []struct {
+		description     string
+		testCase        *latestV1.TestCase
+		pod             *v1.Pod
+		expectedCommand []string
+		expectedArgs    []string
+		expectedImage   string
+		expectedOut     string
+		shouldErr       bool
+	}{
+		{
+			description: "custom test succeeds",
+			testCase: &latestV1.TestCase{
+				ImageName:   "img",
+				CustomTests: []latestV1.CustomTest{{Command: "./test.sh", TimeoutSeconds: 60}},
+				Cluster:     &latestV1.ClusterTests{Namespace: "ns"},
+			},
+			pod:             testPod("skaffold-test-1", v1.PodSucceeded, 0),
+			expectedCommand: []string{"sh", "-c", "./test.sh"},
+			expectedImage:   "img:tag",
+			expectedOut:     "Job skaffold-test-1 finished successfully.",
+		},
+		{
+			description: "custom test fails",
+			testCase: &latestV1.TestCase{
+				ImageName:   "img",
+				CustomTests: []latestV1.CustomTest{{Command: "./test.sh"}},
+				Cluster:     &latestV1.ClusterTests{Namespace: "ns"},
+			},
+			pod:             testPod("skaffold-test-1", v1.PodFailed, 2),
+			expectedCommand: []string{"sh", "-c", "./test.sh"},
+			expectedImage:   "img:tag",
+			expectedOut:     "Job skaffold-test-1 failed.",
+			shouldErr:       true,
+		},
+		{
+			description: "structure tests",
+			testCase: &latestV1.TestCase{
+				ImageName:         "img",
+				StructureTests:    []string{"test.yaml"},
+				StructureTestArgs: []string{"--no-color"},
+				Cluster:           &latestV1.ClusterTests{Namespace: "ns", StructureTestImage: "cst"},
+			},
+			pod:           testPod("skaffold-test-1", v1.PodSucceeded, 0),
+			expectedArgs:  []string{"test", "-v", "warn", "--driver", "tar", "--image", "img:tag", "--config", "/skaffold/structure-tests/0-test.yaml", "--no-color"},
+			expectedImage: "cst",
+			expectedOut:   "Running structure tests in job skaffold-test-1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			tmpDir := t.NewTempDir().Write("test.yaml", "schemaVersion: 2.0.0")
+			test.testCase.Workspace = tmpDir.Root()
+
+			client := fakekubeclientset.NewSimpleClientset(test.pod)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&newJobName, func() string { return "skaffold-test-1" })
+			t.Override(&pollInterval, 10*time.Millisecond)
+
+			var out bytes.Buffer
+			err := New(&mockConfig{}, test.testCase).Test(context.Background(), &out, "img:tag")
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckContains(test.expectedOut, out.String())
+
+			jobs := createdJobs(client)
+			t.CheckDeepEqual(1, len(jobs))
+			container := jobs[0].Spec.Template.Spec.Containers[0]
+			t.CheckDeepEqual(test.expectedImage, container.Image)
+			t.CheckDeepEqual(test.expectedCommand, container.Command)
+			t.CheckDeepEqual(test.expectedArgs, container.Args)
+
+			// The job and the config map are deleted once the tests have run.
+			_, err = client.BatchV1().Jobs("ns").Get(context.Background(), "skaffold-test-1", metav1.GetOptions{})
+			t.CheckError(true, err)
+			_, err = client.CoreV1().ConfigMaps("ns").Get(context.Background(), "skaffold-test-1", metav1.GetOptions{})
+			t.CheckError(true, err)
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		r := New(&mockConfig{}, &latestV1.TestCase{
+			ImageName:      "img",
+			StructureTests: []string{"a.yaml", "b.yaml"},
+			CustomTests:    []latestV1.CustomTest{{Command: "./unit.sh"}, {Command: "./e2e.sh"}},
+			Cluster:        &latestV1.ClusterTests{Namespace: "ns"},
+		})
+
+		t.CheckDeepEqual("cluster (structure: a.yaml, b.yaml; custom: ./unit.sh; custom: ./e2e.sh)", r.Name())
+	})
+}
+
+func TestWaitForJobPodImagePullBackOff(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		pod := testPod("skaffold-test-1", v1.PodPending, 0)
+		pod.Status.ContainerStatuses[0].State = v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "not found"}}
+		client := fakekubeclientset.NewSimpleClientset(pod)
+
+		_, err := waitForJobPod(context.Background(), client.CoreV1().Pods("ns"), "skaffold-test-1")
+
+		t.CheckErrorContains("can't start: ImagePullBackOff: not found", err)
+	})
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/logger"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log/stream"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	jobLabel          = "skaffold.dev/test-job"
+	testContainerName = "test"
+)
+
+var (
+	// For testing
+	newJobName = func() string {
+		return fmt.Sprintf("skaffold-test-%s", util.RandomID()[:8])
+	}
+	pollInterval = time.Second
+)
+
+// JobRunner runs containers to completion inside the cluster, as Kubernetes Jobs.
+type JobRunner struct {
+	cfg            Config
+	namespace      string
+	serviceAccount string
+	colorPicker    output.ColorPicker
+}
+
+// NewJobRunner creates a JobRunner for the given namespace.
+// The namespace defaults to the namespace of the current kube context.
+func NewJobRunner(cfg Config, namespace string, serviceAccount string) *JobRunner {
+	if namespace == "" {
+		namespace = kubectl.NewCLI(cfg, "").Namespace
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	return &JobRunner{
+		cfg:            cfg,
+		namespace:      namespace,
+		serviceAccount: serviceAccount,
+		colorPicker:    output.NewColorPicker(),
+	}
+}
+
+// Namespace returns the namespace in which the Jobs are created.
+func (r *JobRunner) Namespace() string {
+	return r.namespace
+}
+
+// Run runs the container in a Job, streams its logs and waits for it to complete.
+// The Job and its pod are deleted afterwards.
+func (r *JobRunner) Run(ctx context.Context, out io.Writer, name string, container v1.Container, volumes []v1.Volume, timeoutSeconds int) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	job := r.jobSpec(name, container, timeoutSeconds)
+	job.Spec.Template.Spec.Volumes = volumes
+	return r.runJob(ctx, out, client, job)
+}
+
+// jobSpec creates a Job that runs the given container once, without retries.
+func (r *JobRunner) jobSpec(name string, container v1.Container, timeoutSeconds int) *batchv1.Job {
+	backoffLimit := int32(0)
+	labels := map[string]string{jobLabel: name}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					Containers:         []v1.Container{container},
+					RestartPolicy:      v1.RestartPolicyNever,
+					ServiceAccountName: r.serviceAccount,
+				},
+			},
+		},
+	}
+	if timeoutSeconds > 0 {
+		deadline := int64(timeoutSeconds)
+		job.Spec.ActiveDeadlineSeconds = &deadline
+	}
+	return job
+}
+
+func (r *JobRunner) runJob(ctx context.Context, out io.Writer, client kubernetes.Interface, job *batchv1.Job) error {
+	jobs := client.BatchV1().Jobs(r.namespace)
+	if _, err := jobs.Create(ctx, job, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating job: %w", err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		if err := jobs.Delete(context.Background(), job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+			logrus.Warnf("deleting job %s: %v", job.Name, err)
+		}
+	}()
+
+	pods := client.CoreV1().Pods(r.namespace)
+	pod, err := waitForJobPod(ctx, pods, job.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := r.streamLogs(ctx, out, pods, pod); err != nil {
+		logrus.Warnf("streaming logs of job %s: %v", job.Name, err)
+	}
+
+	if err := waitForPodCompletion(ctx, pods, pod.Name); err != nil {
+		output.Red.Fprintf(out, "Job %s failed.\n", job.Name)
+		return err
+	}
+	output.Green.Fprintf(out, "Job %s finished successfully.\n", job.Name)
+	return nil
+}
+
+func (r *JobRunner) streamLogs(ctx context.Context, out io.Writer, pods corev1.PodInterface, pod *v1.Pod) error {
+	c := pod.Spec.Containers[0]
+	rc, err := pods.GetLogs(pod.Name, &v1.PodLogOptions{
+		Follow:    true,
+		Container: c.Name,
+	}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	container := v1.ContainerStatus{Name: c.Name, Image: c.Image}
+	formatter := logger.NewKubernetesLogFormatter(r.cfg, r.colorPicker, func() bool { return false }, pod, container)
+	return stream.StreamRequest(ctx, out, formatter, rc)
+}
+
+// waitForJobPod waits until the pod of the Job has started, and fails early if its image can't be pulled.
+func waitForJobPod(ctx context.Context, pods corev1.PodInterface, jobName string) (*v1.Pod, error) {
+	logrus.Infof("Waiting for the pod of job %s to start", jobName)
+
+	var pod *v1.Pod
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		list, err := pods.List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", jobLabel, jobName)})
+		if err != nil {
+			return false, fmt.Errorf("listing pods of job %s: %w", jobName, err)
+		}
+		if len(list.Items) == 0 {
+			return false, nil
+		}
+
+		pod = &list.Items[0]
+		if pod.Status.Phase != v1.PodPending {
+			return true, nil
+		}
+		for _, c := range pod.Status.ContainerStatuses {
+			if w := c.State.Waiting; w != nil && isUnrecoverable(w.Reason) {
+				return false, fmt.Errorf("pod %s can't start: %s: %s", pod.Name, w.Reason, w.Message)
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+
+	return pod, err
+}
+
+// waitForPodCompletion waits until the pod has succeeded or failed.
+func waitForPodCompletion(ctx context.Context, pods corev1.PodInterface, podName string) error {
+	return wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("getting pod %s: %w", podName, err)
+		}
+
+		switch pod.Status.Phase {
+		case v1.PodSucceeded:
+			return true, nil
+		case v1.PodFailed:
+			return false, podFailedErr(pod)
+		default:
+			return false, nil
+		}
+	}, ctx.Done())
+}
+
+func podFailedErr(pod *v1.Pod) error {
+	for _, c := range pod.Status.ContainerStatuses {
+		if t := c.State.Terminated; t != nil && t.ExitCode != 0 {
+			return fmt.Errorf("pod %s exited with code %d", pod.Name, t.ExitCode)
+		}
+	}
+	if pod.Status.Reason != "" {
+		return fmt.Errorf("pod %s failed: %s: %s", pod.Name, pod.Status.Reason, pod.Status.Message)
+	}
+	return fmt.Errorf("pod %s failed", pod.Name)
+}
+
+func isUnrecoverable(reason string) bool {
+	switch reason {
+	case "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+		return true
+	default:
+		return false
+	}
+}
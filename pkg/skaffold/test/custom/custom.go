@@ -58,6 +58,11 @@ func New(cfg docker.Config, imageName string, ws string, ct latestV1.CustomTest)
 	}, nil
 }
 
+// Name describes the custom test by its command.
+func (ct *Runner) Name() string {
+	return "custom: " + ct.customTest.Command
+}
+
 // Test is the entrypoint for running custom tests
 func (ct *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
 	event.TestInProgress()
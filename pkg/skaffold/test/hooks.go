@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+// withHooks runs the lifecycle hooks of a test case around its testers.
+type withHooks struct {
+	testCase *latestV1.TestCase
+	testers  []ImageTester
+	cli      *kubectl.CLI
+}
+
+func hasHooks(tc *latestV1.TestCase) bool {
+	return len(tc.LifecycleHooks.PreHooks) > 0 || len(tc.LifecycleHooks.PostHooks) > 0
+}
+
+// Test runs the pre-test hooks, the tests, and the post-test hooks even if the tests failed.
+func (w withHooks) Test(ctx context.Context, out io.Writer, tag string) error {
+	opts, err := hooks.NewTestEnvOpts(w.testCase, tag, w.cli.KubeContext)
+	if err != nil {
+		return err
+	}
+	r := hooks.NewTestRunner(w.cli, w.testCase.ImageName, w.testCase.LifecycleHooks, opts)
+	if err := r.RunPreHooks(ctx, out); err != nil {
+		return err
+	}
+
+	testErr := w.runTesters(ctx, out, tag)
+	if err := r.RunPostHooks(ctx, out, testErr); err != nil && testErr == nil {
+		return err
+	}
+	return testErr
+}
+
+func (w withHooks) runTesters(ctx context.Context, out io.Writer, tag string) error {
+	for _, tester := range w.testers {
+		if err := tester.Test(ctx, out, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w withHooks) TestDependencies() ([]string, error) {
+	var deps []string
+	for _, tester := range w.testers {
+		result, err := tester.TestDependencies()
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, result...)
+	}
+	return deps, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeTester struct {
+	err error
+}
+
+func (f fakeTester) Test(_ context.Context, out io.Writer, _ string) error {
+	io.WriteString(out, "running tests\n")
+	return f.err
+}
+
+func (f fakeTester) TestDependencies() ([]string, error) { return []string{"test.yaml"}, nil }
+
+func echoHook(msg string) latestV1.TestHookItem {
+	return latestV1.TestHookItem{
+		HostHook: &latestV1.HostHook{
+			OS:      []string{"linux", "darwin"},
+			Command: []string{"sh", "-c", "echo " + msg},
+		},
+	}
+}
+
+func TestWithHooks(t *testing.T) {
+	tests := []struct {
+		description string
+		testErr     error
+		postHook    string
+		expectedOut []string
+		shouldErr   bool
+	}{
+		{
+			description: "tests pass",
+			postHook:    "post $SKAFFOLD_TEST_RESULT",
+			expectedOut: []string{"pre gcr.io/img:tag", "running tests", "post succeeded"},
+		},
+		{
+			description: "post-test hooks run when the tests fail",
+			testErr:     errors.New("test failed"),
+			postHook:    "post $SKAFFOLD_TEST_RESULT",
+			expectedOut: []string{"pre gcr.io/img:tag", "running tests", "post failed"},
+			shouldErr:   true,
+		},
+		{
+			description: "post-test hook fails",
+			postHook:    "post; exit 1",
+			expectedOut: []string{"running tests", "post"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if runtime.GOOS == "windows" {
+				t.Skip("hooks use sh")
+			}
+
+			tc := &latestV1.TestCase{
+				ImageName: "img",
+				LifecycleHooks: latestV1.TestHooks{
+					PreHooks:  []latestV1.TestHookItem{echoHook("pre $SKAFFOLD_TEST_IMAGE")},
+					PostHooks: []latestV1.TestHookItem{echoHook(test.postHook)},
+				},
+			}
+			tester := withHooks{testCase: tc, testers: []ImageTester{fakeTester{err: test.testErr}}, cli: &kubectl.CLI{}}
+
+			var out bytes.Buffer
+			err := tester.Test(context.Background(), &out, "gcr.io/img:tag")
+
+			t.CheckError(test.shouldErr, err)
+			for _, expected := range test.expectedOut {
+				t.CheckContains(expected, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/cluster"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
+)
+
+const (
+	// maxReportedRuns is the number of test runs kept in the report. The oldest runs of long dev sessions are dropped.
+	maxReportedRuns = 50
+	// maxReportedOutput is the number of bytes of a tester's output kept in the report. Longer outputs keep their end.
+	maxReportedOutput = 64 * 1024
+)
+
+// testResult is the result of a single ImageTester run.
+type testResult struct {
+	Artifact string  `json:"artifact"`
+	Tag      string  `json:"tag"`
+	Type     string  `json:"type"`
+	Name     string  `json:"name"`
+	Duration float64 `json:"durationSeconds"`
+	Output   string  `json:"output"`
+	Failure  string  `json:"failure,omitempty"`
+}
+
+func newTestResult(a graph.Artifact, tester ImageTester, duration time.Duration, out string, err error) testResult {
+	result := testResult{
+		Artifact: a.ImageName,
+		Tag:      a.Tag,
+		Type:     testType(tester),
+		Name:     testName(tester),
+		Duration: duration.Seconds(),
+		Output:   truncateOutput(out),
+	}
+	if err != nil {
+		result.Failure = err.Error()
+	}
+	return result
+}
+
+// truncateOutput keeps the last maxReportedOutput bytes of a tester's output.
+func truncateOutput(out string) string {
+	if len(out) <= maxReportedOutput {
+		return out
+	}
+	start := len(out) - maxReportedOutput
+	for start < len(out) && !utf8.RuneStart(out[start]) {
+		start++
+	}
+	return "[output truncated]\n" + out[start:]
+}
+
+// testRun holds the results of a single call to `Tester.Test`.
+type testRun struct {
+	StartTime time.Time    `json:"startTime"`
+	Results   []testResult `json:"results"`
+}
+
+// reporter records the results of the last test runs, including the ones of previous dev iterations,
+// and writes them to a consolidated report.
+type reporter struct {
+	format string
+	file   string
+
+	mu      sync.Mutex
+	runs    []testRun
+	dropped int // number of test runs dropped from the report
+}
+
+func newReporter(opts config.TestReportOptions) *reporter {
+	if !opts.Format.Enabled() {
+		return nil
+	}
+	return &reporter{
+		format: opts.Format.Value(),
+		file:   opts.ReportFile(),
+	}
+}
+
+// startRun starts recording the results of a new test run.
+func (r *reporter) startRun() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.runs) == maxReportedRuns {
+		copy(r.runs, r.runs[1:])
+		r.runs = r.runs[:len(r.runs)-1]
+		r.dropped++
+	}
+	r.runs = append(r.runs, testRun{StartTime: time.Now()})
+}
+
+// record adds the result of an ImageTester run to the current test run.
+func (r *reporter) record(result testResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := &r.runs[len(r.runs)-1]
+	current.Results = append(current.Results, result)
+}
+
+// write writes the report with the results of all the test runs.
+func (r *reporter) write() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var content []byte
+	var err error
+	if r.format == config.JUnitTestReport {
+		content, err = junitReport(r.runs, r.dropped)
+	} else {
+		content, err = json.MarshalIndent(struct {
+			Runs []testRun `json:"runs"`
+		}{r.runs}, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("creating test report: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.file), 0755); err != nil {
+		return fmt.Errorf("creating test report directory: %w", err)
+	}
+	if err := ioutil.WriteFile(r.file, content, 0644); err != nil {
+		return fmt.Errorf("writing test report %q: %w", r.file, err)
+	}
+	return nil
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Time       string           `xml:"time,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitReport creates a JUnit XML report with a test suite per test run,
+// and a test case per artifact and tester, named after the tests it runs. Test suites are numbered after the `dropped` runs.
+func junitReport(runs []testRun, dropped int) ([]byte, error) {
+	suites := junitTestSuites{}
+	var total float64
+	for i, run := range runs {
+		suite := junitTestSuite{
+			Name:      fmt.Sprintf("skaffold-test-%d", dropped+i+1),
+			Timestamp: run.StartTime.Format("2006-01-02T15:04:05"),
+		}
+		var duration float64
+		for _, r := range run.Results {
+			tc := junitTestCase{
+				Name:      r.Name,
+				ClassName: r.Artifact,
+				Time:      seconds(r.Duration),
+				SystemOut: r.Output,
+			}
+			if r.Failure != "" {
+				tc.Failure = &junitFailure{Message: r.Failure, Text: r.Failure}
+				suite.Failures++
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+			duration += r.Duration
+		}
+		suite.Tests = len(suite.TestCases)
+		suite.Time = seconds(duration)
+
+		suites.TestSuites = append(suites.TestSuites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		total += duration
+	}
+	suites.Time = seconds(total)
+
+	content, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+func seconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
+
+// testType describes the kind of tests an ImageTester runs.
+func testType(tester ImageTester) string {
+	switch t := tester.(type) {
+	case *structure.Runner:
+		return "structure"
+	case *custom.Runner:
+		return "custom"
+	case *cluster.Runner:
+		return "cluster"
+	case withHooks:
+		var types []string
+		for _, inner := range t.testers {
+			types = append(types, testType(inner))
+		}
+		return strings.Join(types, ",")
+	default:
+		return "unknown"
+	}
+}
+
+// testName describes the tests an ImageTester runs, like the command of a custom test
+// or the files of structure tests, so that testers of the same artifact and type are told apart.
+func testName(tester ImageTester) string {
+	switch t := tester.(type) {
+	case interface{ Name() string }:
+		return t.Name()
+	case withHooks:
+		var names []string
+		for _, inner := range t.testers {
+			names = append(names, testName(inner))
+		}
+		return strings.Join(names, "; ")
+	default:
+		return testType(tester)
+	}
+}
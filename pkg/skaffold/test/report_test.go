@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestJSONTestReport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		file := t.NewTempDir().Path("reports/report.json")
+		tester := FullTester{
+			Testers:  ImageTesters{"img": {fakeTester{}}},
+			muted:    config.Muted{},
+			reporter: &reporter{format: config.JSONTestReport, file: file},
+		}
+		bRes := []graph.Artifact{{ImageName: "img", Tag: "img:1"}}
+
+		// The report holds the results of all the runs, like the ones of dev iterations.
+		t.CheckNoError(tester.Test(context.Background(), ioutil.Discard, bRes))
+		tester.Testers["img"] = []ImageTester{fakeTester{err: errors.New("test failed")}}
+		t.CheckError(true, tester.Test(context.Background(), ioutil.Discard, bRes))
+
+		content, err := ioutil.ReadFile(file)
+		t.CheckNoError(err)
+		var report struct {
+			Runs []testRun `json:"runs"`
+		}
+		t.CheckNoError(json.Unmarshal(content, &report))
+		t.CheckDeepEqual(2, len(report.Runs))
+		t.CheckDeepEqual(1, len(report.Runs[1].Results))
+
+		result := report.Runs[1].Results[0]
+		t.CheckDeepEqual("img", result.Artifact)
+		t.CheckDeepEqual("img:1", result.Tag)
+		t.CheckDeepEqual("unknown", result.Type)
+		t.CheckDeepEqual("unknown", result.Name)
+		t.CheckDeepEqual("running tests\n", result.Output)
+		t.CheckDeepEqual("test failed", result.Failure)
+	})
+}
+
+func TestJUnitReport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		runs := []testRun{{
+			Results: []testResult{
+				{Artifact: "img", Type: "structure", Name: "structure: tests/*.yaml", Duration: 1.5, Output: "passed"},
+				{Artifact: "img", Type: "custom", Name: "custom: ./unit.sh", Duration: 0.25, Output: "failed", Failure: "exit status 1"},
+				{Artifact: "img", Type: "custom", Name: "custom: ./e2e.sh", Duration: 0.25, Output: "passed"},
+			},
+		}}
+
+		content, err := junitReport(runs, 0)
+
+		t.CheckNoError(err)
+		t.CheckContains(`<testsuites tests="3" failures="1" time="2.000">`, string(content))
+		t.CheckContains(`<testcase name="structure: tests/*.yaml" classname="img" time="1.500">`, string(content))
+		t.CheckContains(`<testcase name="custom: ./unit.sh" classname="img" time="0.250">`, string(content))
+		t.CheckContains(`<testcase name="custom: ./e2e.sh" classname="img" time="0.250">`, string(content))
+		t.CheckContains(`<failure message="exit status 1">exit status 1</failure>`, string(content))
+		t.CheckContains(`<system-out>failed</system-out>`, string(content))
+	})
+}
+
+func TestTestName(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		unit, err := custom.New(nil, "img", ".", latestV1.CustomTest{Command: "./unit.sh"})
+		t.CheckNoError(err)
+		e2e, err := custom.New(nil, "img", ".", latestV1.CustomTest{Command: "./e2e.sh"})
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual("custom: ./unit.sh", testName(unit))
+		t.CheckDeepEqual("custom: ./unit.sh; custom: ./e2e.sh", testName(withHooks{testers: []ImageTester{unit, e2e}}))
+		t.CheckDeepEqual("unknown", testName(fakeTester{}))
+	})
+}
+
+func TestReporterKeepsLastRuns(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		r := &reporter{format: config.JUnitTestReport}
+		for i := 0; i < maxReportedRuns+2; i++ {
+			r.startRun()
+			r.record(testResult{Artifact: fmt.Sprintf("img-%d", i)})
+		}
+
+		t.CheckDeepEqual(maxReportedRuns, len(r.runs))
+		t.CheckDeepEqual("img-2", r.runs[0].Results[0].Artifact)
+
+		content, err := junitReport(r.runs, r.dropped)
+		t.CheckNoError(err)
+		t.CheckContains(`<testsuite name="skaffold-test-3"`, string(content))
+		t.CheckFalse(strings.Contains(string(content), `<testsuite name="skaffold-test-2"`))
+	})
+}
+
+func TestTruncateOutput(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.CheckDeepEqual("short", truncateOutput("short"))
+
+		long := strings.Repeat("a", maxReportedOutput) + "end"
+		truncated := truncateOutput(long)
+		t.CheckTrue(strings.HasPrefix(truncated, "[output truncated]\n"))
+		t.CheckTrue(strings.HasSuffix(truncated, "end"))
+		t.CheckDeepEqual(maxReportedOutput, len(strings.TrimPrefix(truncated, "[output truncated]\n")))
+	})
+}
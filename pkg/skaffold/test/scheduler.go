@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+)
+
+// runImageTesters runs the testers of all the artifacts.
+// Up to `concurrency` artifacts are tested in parallel, and their output is aggregated per artifact.
+// The testers of a single artifact always run sequentially.
+func (t FullTester) runImageTesters(ctx context.Context, out io.Writer, bRes []graph.Artifact) error {
+	var artifacts []graph.Artifact
+	for _, b := range bRes {
+		if len(t.Testers[b.ImageName]) > 0 {
+			artifacts = append(artifacts, b)
+		}
+	}
+	if len(artifacts) == 0 {
+		return nil
+	}
+
+	concurrency := t.concurrency
+	if concurrency <= 0 || concurrency > len(artifacts) {
+		concurrency = len(artifacts)
+	}
+	if concurrency > 1 {
+		output.Default.Fprintf(out, "Testing %d artifacts in parallel\n", concurrency)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logger := build.NewLogAggregator(out, len(artifacts), concurrency)
+	sem := make(chan bool, concurrency)
+
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	wg.Add(len(artifacts))
+
+	// Start testing the artifacts in order, as soon as the concurrency allows it.
+	go func() {
+		testerID := 0
+		for _, a := range artifacts {
+			a, firstID := a, testerID
+			testerID += len(t.Testers[a.ImageName])
+
+			sem <- true
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if err := t.testArtifact(ctx, logger, a, firstID); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					if t.failFast {
+						cancel()
+					}
+				}
+			}()
+		}
+	}()
+	// print the output of the tests in order
+	logger.PrintInOrder(ctx)
+	wg.Wait()
+
+	switch {
+	case len(errs) == 0:
+		return nil
+	case len(errs) == 1 || t.failFast:
+		// With fail-fast, the other errors are caused by the cancellation of the tests.
+		return fmt.Errorf("running tests: %w", errs[0])
+	default:
+		var messages []string
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return fmt.Errorf("running tests: %d artifacts failed: %s", len(errs), strings.Join(messages, "; "))
+	}
+}
+
+// testArtifact runs the testers of an artifact sequentially.
+// Without fail-fast, all the testers run even if some fail, and the first failure is returned.
+func (t FullTester) testArtifact(ctx context.Context, logger build.LogAggregator, a graph.Artifact, testerID int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	w, closeFn, err := logger.GetWriter()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	var firstErr error
+	for _, tester := range t.Testers[a.ImageName] {
+		err := t.runTester(ctx, output.WithEventContext(w, constants.Test, strconv.Itoa(testerID)), a, tester, testerID)
+		testerID++
+		if err == nil {
+			continue
+		}
+		if t.failFast {
+			return err
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (t FullTester) runTester(ctx context.Context, out io.Writer, a graph.Artifact, tester ImageTester, testerID int) error {
+	eventV2.TesterInProgress(testerID)
+
+	var testOut bytes.Buffer
+	if t.reporter != nil {
+		out = output.WithCopy(out, &testOut)
+	}
+	start := time.Now()
+	err := tester.Test(ctx, out, a.Tag)
+	if t.reporter != nil {
+		t.reporter.record(newTestResult(a, tester, time.Since(start), testOut.String(), err))
+	}
+
+	if err != nil {
+		eventV2.TesterFailed(testerID, err)
+		return err
+	}
+	eventV2.TesterSucceeded(testerID)
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+// recordingTester records the tags it tested.
+type recordingTester struct {
+	err    error
+	mu     *sync.Mutex
+	tested *[]string
+}
+
+func (r recordingTester) Test(_ context.Context, out io.Writer, tag string) error {
+	r.mu.Lock()
+	*r.tested = append(*r.tested, tag)
+	r.mu.Unlock()
+	fmt.Fprintf(out, "testing %s\n", tag)
+	return r.err
+}
+
+func (r recordingTester) TestDependencies() ([]string, error) { return nil, nil }
+
+// barrierTester only succeeds if all the barrier testers run at the same time.
+type barrierTester struct {
+	barrier *sync.WaitGroup
+}
+
+func (b barrierTester) Test(context.Context, io.Writer, string) error {
+	b.barrier.Done()
+	done := make(chan struct{})
+	go func() {
+		b.barrier.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(10 * time.Second):
+		return errors.New("tests didn't run in parallel")
+	}
+}
+
+func (b barrierTester) TestDependencies() ([]string, error) { return nil, nil }
+
+func TestRunImageTesters(t *testing.T) {
+	tests := []struct {
+		description    string
+		concurrency    int
+		failFast       bool
+		errs           map[string]error
+		expectedTested []string
+		expectedErr    string
+	}{
+		{
+			description:    "sequential",
+			concurrency:    1,
+			failFast:       true,
+			expectedTested: []string{"img1:tag", "img1:tag", "img2:tag", "img2:tag", "img3:tag", "img3:tag"},
+		},
+		{
+			description:    "sequential fail-fast",
+			concurrency:    1,
+			failFast:       true,
+			errs:           map[string]error{"img2": errors.New("failed")},
+			expectedTested: []string{"img1:tag", "img1:tag", "img2:tag"},
+			expectedErr:    "running tests: failed",
+		},
+		{
+			description:    "run all",
+			concurrency:    1,
+			errs:           map[string]error{"img1": errors.New("img1 failed"), "img3": errors.New("img3 failed")},
+			expectedTested: []string{"img1:tag", "img1:tag", "img2:tag", "img2:tag", "img3:tag", "img3:tag"},
+			expectedErr:    "running tests: 2 artifacts failed: img1 failed; img3 failed",
+		},
+		{
+			description:    "parallel run all",
+			errs:           map[string]error{"img2": errors.New("img2 failed")},
+			expectedTested: []string{"img1:tag", "img1:tag", "img2:tag", "img2:tag", "img3:tag", "img3:tag"},
+			expectedErr:    "running tests: img2 failed",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+			var mu sync.Mutex
+			var tested []string
+			testers := ImageTesters{}
+			var bRes []graph.Artifact
+			for _, image := range []string{"img1", "img2", "img3"} {
+				tester := recordingTester{err: test.errs[image], mu: &mu, tested: &tested}
+				testers[image] = []ImageTester{tester, tester}
+				bRes = append(bRes, graph.Artifact{ImageName: image, Tag: image + ":tag"})
+			}
+			tester := FullTester{Testers: testers, muted: config.Muted{}, concurrency: test.concurrency, failFast: test.failFast}
+
+			var out bytes.Buffer
+			err := tester.Test(context.Background(), &out, bRes)
+
+			if test.expectedErr == "" {
+				t.CheckNoError(err)
+			} else {
+				t.CheckErrorContains(test.expectedErr, err)
+			}
+			if test.concurrency == 1 {
+				t.CheckDeepEqual(test.expectedTested, tested)
+			} else {
+				t.CheckDeepEqual(len(test.expectedTested), len(tested))
+			}
+			// The output of each artifact isn't interleaved with the others.
+			t.CheckContains("testing img1:tag\ntesting img1:tag\n", out.String())
+		})
+	}
+}
+
+func TestRunImageTestersInParallel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		var barrier sync.WaitGroup
+		barrier.Add(3)
+		testers := ImageTesters{}
+		var bRes []graph.Artifact
+		for _, image := range []string{"img1", "img2", "img3"} {
+			testers[image] = []ImageTester{barrierTester{barrier: &barrier}}
+			bRes = append(bRes, graph.Artifact{ImageName: image, Tag: image + ":tag"})
+		}
+		tester := FullTester{Testers: testers, muted: config.Muted{}, concurrency: 3, failFast: true}
+
+		var out bytes.Buffer
+		err := tester.Test(context.Background(), &out, bRes)
+
+		t.CheckNoError(err)
+		t.CheckContains("Testing 3 artifacts in parallel", out.String())
+	})
+}
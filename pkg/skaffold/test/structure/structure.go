@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -56,6 +57,11 @@ func New(cfg docker.Config, tc *latestV1.TestCase, imageIsLocal bool) (*Runner,
 	}, nil
 }
 
+// Name describes the structure tests by their test files.
+func (cst *Runner) Name() string {
+	return "structure: " + strings.Join(cst.structureTests, ", ")
+}
+
 // Test is the entrypoint for running structure tests
 func (cst *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
 	event.TestInProgress()
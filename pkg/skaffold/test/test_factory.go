@@ -27,18 +27,26 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/logger"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/cluster"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 )
 
 type Config interface {
 	docker.Config
+	kubectl.Config
+	logger.Config
 
 	TestCases() []*latestV1.TestCase
 	Muted() config.Muted
+	TestReport() config.TestReportOptions
+	TestConcurrency() int
+	TestFailFast() bool
 }
 
 // NewTester parses the provided test cases from the Skaffold config,
@@ -51,8 +59,11 @@ func NewTester(cfg Config, imagesAreLocal func(imageName string) (bool, error))
 	}
 
 	return FullTester{
-		Testers: testers,
-		muted:   cfg.Muted(),
+		Testers:     testers,
+		muted:       cfg.Muted(),
+		reporter:    newReporter(cfg.TestReport()),
+		concurrency: cfg.TestConcurrency(),
+		failFast:    cfg.TestFailFast(),
 	}, nil
 }
 
@@ -112,22 +123,21 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []graph.Artifa
 }
 
 func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []graph.Artifact) error {
-	testerID := 0
-	for _, b := range bRes {
-		for _, tester := range t.Testers[b.ImageName] {
-			eventV2.TesterInProgress(testerID)
-			if err := tester.Test(ctx, out, b.Tag); err != nil {
-				eventV2.TesterFailed(testerID, err)
-				return fmt.Errorf("running tests: %w", err)
-			}
-			eventV2.TesterSucceeded(testerID)
-			testerID++
-		}
+	if t.reporter == nil {
+		return t.runImageTesters(ctx, out, bRes)
 	}
-	return nil
+
+	t.reporter.startRun()
+	testErr := t.runImageTesters(ctx, out, bRes)
+
+	fmt.Fprintln(out, " - writing test report to", t.reporter.file)
+	if err := t.reporter.write(); err != nil && testErr == nil {
+		return err
+	}
+	return testErr
 }
 
-func getImageTesters(cfg docker.Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latestV1.TestCase) (ImageTesters, error) {
+func getImageTesters(cfg Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latestV1.TestCase) (ImageTesters, error) {
 	runners := make(map[string][]ImageTester)
 	for _, tc := range tcs {
 		isLocal, err := imagesAreLocal(tc.ImageName)
@@ -135,20 +145,32 @@ func getImageTesters(cfg docker.Config, imagesAreLocal func(imageName string) (b
 			return nil, err
 		}
 
-		if len(tc.StructureTests) != 0 {
-			structureRunner, err := structure.New(cfg, tc, isLocal)
-			if err != nil {
-				return nil, err
+		var testers []ImageTester
+		if tc.Cluster != nil {
+			testers = append(testers, cluster.New(cfg, tc))
+		} else {
+			if len(tc.StructureTests) != 0 {
+				structureRunner, err := structure.New(cfg, tc, isLocal)
+				if err != nil {
+					return nil, err
+				}
+				testers = append(testers, structureRunner)
 			}
-			runners[tc.ImageName] = append(runners[tc.ImageName], structureRunner)
-		}
 
-		for _, customTest := range tc.CustomTests {
-			customRunner, err := custom.New(cfg, tc.ImageName, tc.Workspace, customTest)
-			if err != nil {
-				return nil, err
+			for _, customTest := range tc.CustomTests {
+				customRunner, err := custom.New(cfg, tc.ImageName, tc.Workspace, customTest)
+				if err != nil {
+					return nil, err
+				}
+				testers = append(testers, customRunner)
 			}
-			runners[tc.ImageName] = append(runners[tc.ImageName], customRunner)
+		}
+
+		if hasHooks(tc) {
+			testers = []ImageTester{withHooks{testCase: tc, testers: testers, cli: kubectl.NewCLI(cfg, "")}}
+		}
+		if len(testers) > 0 {
+			runners[tc.ImageName] = append(runners[tc.ImageName], testers...)
 		}
 	}
 	return runners, nil
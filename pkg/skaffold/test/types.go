@@ -45,8 +45,13 @@ type Muted interface {
 // FullTester should always be the ONLY implementation of the Tester interface;
 // newly added testing implementations should implement the imageTester interface.
 type FullTester struct {
-	Testers ImageTesters
-	muted   Muted
+	Testers  ImageTesters
+	muted    Muted
+	reporter *reporter
+	// concurrency is how many artifacts are tested concurrently. 0 means "no-limit".
+	concurrency int
+	// failFast stops all the tests as soon as one fails.
+	failFast bool
 	// imagesAreLocal func(imageName string) (bool, error)
 }
 
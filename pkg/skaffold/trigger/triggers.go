@@ -44,6 +44,7 @@ type Config interface {
 	Trigger() string
 	Artifacts() []*latestV1.Artifact
 	WatchPollInterval() int
+	WebhookPort() int
 }
 
 // NewTrigger creates a new trigger.
@@ -60,6 +61,11 @@ func NewTrigger(cfg Config, isActive func() bool) (Trigger, error) {
 		return &manualTrigger{
 			isActive: isActive,
 		}, nil
+	case "webhook":
+		return &webhookTrigger{
+			port:     cfg.WebhookPort(),
+			isActive: isActive,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported trigger: %s", cfg.Trigger())
 	}
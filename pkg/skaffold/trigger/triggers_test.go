@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/rjeczalik/notify"
 
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
@@ -36,6 +37,7 @@ func TestNewTrigger(t *testing.T) {
 		description       string
 		trigger           string
 		watchPollInterval int
+		webhookPort       int
 		expected          Trigger
 		shouldErr         bool
 	}{
@@ -60,6 +62,12 @@ func TestNewTrigger(t *testing.T) {
 			trigger:     "manual",
 			expected:    &manualTrigger{},
 		},
+		{
+			description: "webhook trigger",
+			trigger:     "webhook",
+			webhookPort: 50053,
+			expected:    &webhookTrigger{port: 50053},
+		},
 		{
 			description: "unknown trigger",
 			trigger:     "unknown",
@@ -71,6 +79,7 @@ func TestNewTrigger(t *testing.T) {
 			cfg := &mockConfig{
 				trigger:           test.trigger,
 				watchPollInterval: test.watchPollInterval,
+				webhookPort:       test.webhookPort,
 				artifacts: []*latestV1.Artifact{
 					{Workspace: "../workspace"},
 					{Workspace: "../workspace"},
@@ -82,7 +91,7 @@ func TestNewTrigger(t *testing.T) {
 
 			t.CheckError(test.shouldErr, err)
 			if !test.shouldErr {
-				t.CheckDeepEqual(test.expected, got, cmp.AllowUnexported(fsNotify.Trigger{}), cmp.Comparer(ignoreFuncComparer), cmp.AllowUnexported(manualTrigger{}), cmp.AllowUnexported(pollTrigger{}))
+				t.CheckDeepEqual(test.expected, got, cmp.AllowUnexported(fsNotify.Trigger{}), cmp.Comparer(ignoreFuncComparer), cmp.AllowUnexported(manualTrigger{}), cmp.AllowUnexported(pollTrigger{}), cmp.AllowUnexported(webhookTrigger{}), cmpopts.IgnoreFields(webhookTrigger{}, "lock"))
 			}
 		})
 	}
@@ -208,9 +217,11 @@ func TestStartTrigger(t *testing.T) {
 type mockConfig struct {
 	trigger           string
 	watchPollInterval int
+	webhookPort       int
 	artifacts         []*latestV1.Artifact
 }
 
 func (c *mockConfig) Trigger() string                 { return c.trigger }
 func (c *mockConfig) WatchPollInterval() int          { return c.watchPollInterval }
 func (c *mockConfig) Artifacts() []*latestV1.Artifact { return c.artifacts }
+func (c *mockConfig) WebhookPort() int                { return c.webhookPort }
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ChangedPathsTrigger is a Trigger that is told which files changed when it fires.
+type ChangedPathsTrigger interface {
+	Trigger
+
+	// ChangedPaths returns the files reported as changed since the last call.
+	ChangedPaths() []string
+}
+
+// webhookRequest is the optional body of a webhook request.
+type webhookRequest struct {
+	// Paths lists the files that changed, relative to the working directory of skaffold.
+	Paths []string `json:"paths"`
+}
+
+// webhookTrigger watches for changes when it receives an HTTP request.
+type webhookTrigger struct {
+	port     int
+	isActive func() bool
+
+	lock  sync.Mutex
+	paths []string
+}
+
+// Debounce tells the watcher to not debounce rapid sequence of changes.
+func (t *webhookTrigger) Debounce() bool {
+	return false
+}
+
+func (t *webhookTrigger) LogWatchToUser(out io.Writer) {
+	if t.isActive() {
+		output.Yellow.Fprintf(out, "Waiting for requests on http://%s:%d to rebuild/redeploy the changes\n", util.Loopback, t.port)
+	} else {
+		output.Yellow.Fprintln(out, "Not watching for changes...")
+	}
+}
+
+// ChangedPaths returns the files reported as changed by the requests received since the last call.
+func (t *webhookTrigger) ChangedPaths() []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	paths := t.paths
+	t.paths = nil
+	return paths
+}
+
+// Start starts listening to webhook requests.
+func (t *webhookTrigger) Start(ctx context.Context) (<-chan bool, error) {
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", util.Loopback, t.port))
+	if err != nil {
+		return nil, fmt.Errorf("listening on port %d: %w", t.port, err)
+	}
+
+	trigger := make(chan bool)
+	server := &http.Server{Handler: t.handler(ctx, trigger)}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	go func() {
+		if err := server.Serve(l); err != nil && err != http.ErrServerClosed {
+			logrus.Warnf("webhook trigger stopped: %v", err)
+		}
+	}()
+
+	return trigger, nil
+}
+
+// handler fires the trigger for each POST request. The request only completes once
+// the dev loop has picked up the trigger, which lets callers drive rebuilds deterministically.
+func (t *webhookTrigger) handler(ctx context.Context, trigger chan<- bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req webhookRequest
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("reading request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if err := json.Unmarshal(body, &req); err != nil {
+				http.Error(w, fmt.Sprintf("parsing request: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		// Ignore if trigger is inactive
+		if !t.isActive() {
+			http.Error(w, "automatic triggers are disabled", http.StatusConflict)
+			return
+		}
+
+		t.lock.Lock()
+		t.paths = append(t.paths, req.Paths...)
+		t.lock.Unlock()
+
+		select {
+		case trigger <- true:
+			w.WriteHeader(http.StatusAccepted)
+		case <-r.Context().Done():
+		case <-ctx.Done():
+			http.Error(w, "skaffold is shutting down", http.StatusServiceUnavailable)
+		}
+	})
+}
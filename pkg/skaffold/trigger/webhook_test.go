@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWebhookTrigger_Debounce(t *testing.T) {
+	trigger := &webhookTrigger{}
+	got, want := trigger.Debounce(), false
+	testutil.CheckDeepEqual(t, want, got)
+}
+
+func TestWebhookTrigger_LogWatchToUser(t *testing.T) {
+	tests := []struct {
+		description string
+		isActive    bool
+		expected    string
+	}{
+		{
+			description: "active webhook trigger",
+			isActive:    true,
+			expected:    "Waiting for requests on http://127.0.0.1:50053 to rebuild/redeploy the changes\n",
+		},
+		{
+			description: "inactive webhook trigger",
+			isActive:    false,
+			expected:    "Not watching for changes...\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			out := new(bytes.Buffer)
+
+			trigger := &webhookTrigger{
+				port:     50053,
+				isActive: func() bool { return test.isActive },
+			}
+			trigger.LogWatchToUser(out)
+
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func TestWebhookTrigger_Handler(t *testing.T) {
+	tests := []struct {
+		description   string
+		method        string
+		body          string
+		isActive      bool
+		expectedCode  int
+		expectedFired bool
+		expectedPaths []string
+	}{
+		{
+			description:   "empty body",
+			method:        http.MethodPost,
+			isActive:      true,
+			expectedCode:  http.StatusAccepted,
+			expectedFired: true,
+		},
+		{
+			description:   "changed paths",
+			method:        http.MethodPost,
+			body:          `{"paths": ["main.go", "pkg/util.go"]}`,
+			isActive:      true,
+			expectedCode:  http.StatusAccepted,
+			expectedFired: true,
+			expectedPaths: []string{"main.go", "pkg/util.go"},
+		},
+		{
+			description:  "invalid body",
+			method:       http.MethodPost,
+			body:         `{"paths": `,
+			isActive:     true,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "wrong method",
+			method:       http.MethodGet,
+			isActive:     true,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			description:  "inactive trigger",
+			method:       http.MethodPost,
+			isActive:     false,
+			expectedCode: http.StatusConflict,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			trigger := &webhookTrigger{
+				isActive: func() bool { return test.isActive },
+			}
+			fired := make(chan bool, 1)
+			handler := trigger.handler(context.Background(), fired)
+
+			req := httptest.NewRequest(test.method, "/", strings.NewReader(test.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			t.CheckDeepEqual(test.expectedCode, rec.Code)
+			t.CheckDeepEqual(test.expectedFired, len(fired) == 1)
+			t.CheckDeepEqual(test.expectedPaths, trigger.ChangedPaths())
+			t.CheckDeepEqual([]string(nil), trigger.ChangedPaths())
+		})
+	}
+}
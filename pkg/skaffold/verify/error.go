@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func cmdFailedErr(name, command string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("command %q failed: %s", command, err),
+			ErrCode: proto.StatusCode_VERIFY_TEST_CMD_FAILED_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFY_TEST_OUTPUT,
+					Action:         fmt.Sprintf("Check the output of verify test %q and its command: %q", name, command),
+				},
+			},
+		},
+	)
+}
+
+func timedOutErr(name string, timeoutSeconds int, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("timed out after %ds", timeoutSeconds),
+			ErrCode: proto.StatusCode_VERIFY_TEST_TIMEDOUT_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_VERIFY_TEST_TIMEOUT,
+					Action:         fmt.Sprintf("Fix the timeoutSeconds of verify test %q: %d", name, timeoutSeconds),
+				},
+			},
+		},
+	)
+}
+
+func jobFailedErr(name, job string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("job %s failed: %s", job, err),
+			ErrCode: proto.StatusCode_VERIFY_TEST_JOB_FAILED_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFY_TEST_OUTPUT,
+					Action:         fmt.Sprintf("Check the output of verify test %q", name),
+				},
+			},
+		},
+	)
+}
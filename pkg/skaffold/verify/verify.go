@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/cluster"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const verifyContainerName = "verify"
+
+var (
+	// For testing
+	newJobName = func() string {
+		return fmt.Sprintf("skaffold-verify-%s", util.RandomID()[:8])
+	}
+	verifyInProgress = eventV2.VerifyInProgress
+	verifyFailed     = eventV2.VerifyFailed
+	verifySucceeded  = eventV2.VerifySucceeded
+)
+
+type Config interface {
+	cluster.Config
+
+	VerifyTestCases() []*latestV1.VerifyTestCase
+}
+
+// Verifier runs the integration tests against the deployed application.
+type Verifier interface {
+	Verify(context.Context, io.Writer, []graph.Artifact) error
+}
+
+// NewVerifier creates a Verifier for the verify test cases of the given config.
+func NewVerifier(cfg Config) Verifier {
+	return &verifier{
+		cfg:       cfg,
+		testCases: cfg.VerifyTestCases(),
+	}
+}
+
+type verifier struct {
+	cfg       Config
+	testCases []*latestV1.VerifyTestCase
+}
+
+// Verify runs all the verify test cases, even if some of them fail,
+// and returns an error listing the failed ones.
+// The status of each test case is reported with its own event, keyed by the index of the test case.
+func (v *verifier) Verify(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
+	if len(v.testCases) == 0 {
+		return nil
+	}
+
+	eventV2.TaskInProgress(constants.Verify, "Verify the deployed application")
+	output.Default.Fprintln(out, "Verifying the deployed application...")
+
+	var failed []string
+	for i, tc := range v.testCases {
+		if ctx.Err() != nil {
+			eventV2.TaskFailed(constants.Verify, ctx.Err())
+			return ctx.Err()
+		}
+
+		verifyInProgress(i, tc.Name)
+		tcOut := output.WithEventContext(out, constants.Verify, strconv.Itoa(i))
+		if err := v.runTestCase(ctx, tcOut, tc, artifacts); err != nil {
+			verifyFailed(i, tc.Name, err)
+			output.Red.Fprintf(out, " - %s failed: %v\n", tc.Name, err)
+			failed = append(failed, tc.Name)
+			continue
+		}
+		verifySucceeded(i, tc.Name)
+		output.Green.Fprintf(out, " - %s passed\n", tc.Name)
+	}
+
+	if len(failed) > 0 {
+		err := fmt.Errorf("verify tests failed: %s", strings.Join(failed, ", "))
+		eventV2.TaskFailed(constants.Verify, err)
+		return err
+	}
+
+	eventV2.TaskSucceeded(constants.Verify)
+	return nil
+}
+
+func (v *verifier) runTestCase(ctx context.Context, out io.Writer, tc *latestV1.VerifyTestCase, artifacts []graph.Artifact) error {
+	if tc.Container != nil {
+		jobs := cluster.NewJobRunner(v.cfg, tc.Container.Namespace, "")
+		name := newJobName()
+		output.Default.Fprintf(out, "Running verify test %s in job %s\n", tc.Name, name)
+		if err := jobs.Run(ctx, out, name, verifyContainer(tc.Container, artifacts), nil, tc.TimeoutSeconds); err != nil {
+			return jobFailedErr(tc.Name, name, err)
+		}
+		return nil
+	}
+
+	output.Default.Fprintf(out, "Running verify test %s: %q\n", tc.Name, tc.Command)
+	return runCommand(ctx, out, tc)
+}
+
+// verifyContainer creates the container of a verify test.
+// Images that are built by Skaffold are replaced by their freshly built tags.
+func verifyContainer(c *latestV1.VerifyContainer, artifacts []graph.Artifact) v1.Container {
+	image := c.Image
+	for _, a := range artifacts {
+		if a.ImageName == c.Image {
+			image = a.Tag
+			break
+		}
+	}
+
+	return v1.Container{
+		Name:    verifyContainerName,
+		Image:   image,
+		Command: c.Command,
+		Args:    c.Args,
+		Env:     c.Env,
+	}
+}
+
+// runCommand runs a verify test command on the host machine.
+func runCommand(ctx context.Context, out io.Writer, tc *latestV1.VerifyTestCase) error {
+	if tc.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(tc.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	// We evaluate the command with a shell so that it can contain env variables.
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", tc.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", tc.Command)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return timedOutErr(tc.Name, tc.TimeoutSeconds, err)
+		}
+		return cmdFailedErr(tc.Name, tc.Command, err)
+	}
+	return nil
+}
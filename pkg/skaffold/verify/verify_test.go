@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+type mockConfig struct {
+	testCases []*latestV1.VerifyTestCase
+}
+
+func (c *mockConfig) VerifyTestCases() []*latestV1.VerifyTestCase { return c.testCases }
+func (c *mockConfig) GetKubeContext() string                      { return "" }
+func (c *mockConfig) GetKubeConfig() string                       { return "" }
+func (c *mockConfig) GetKubeNamespace() string                    { return "" }
+func (c *mockConfig) Tail() bool                                  { return false }
+func (c *mockConfig) JSONLogs() bool                              { return false }
+func (c *mockConfig) LogFilters() config.LogFilterOptions         { return config.LogFilterOptions{} }
+func (c *mockConfig) LogFiles() config.LogFileOptions             { return config.LogFileOptions{} }
+func (c *mockConfig) PipelineForImage(string) (latestV1.Pipeline, bool) {
+	return c.DefaultPipeline(), true
+}
+func (c *mockConfig) DefaultPipeline() latestV1.Pipeline {
+	var pipeline latestV1.Pipeline
+	pipeline.Deploy.Logs.Prefix = "auto"
+	return pipeline
+}
+
+func TestVerifyCommands(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("verify test commands are run with cmd.exe on windows")
+	}
+
+	tests := []struct {
+		description    string
+		testCases      []*latestV1.VerifyTestCase
+		commands       util.Command
+		expectedOut    string
+		expectedEvents []string
+		shouldErr      bool
+	}{
+		{
+			description: "no verify tests",
+		},
+		{
+			description:    "command succeeds",
+			testCases:      []*latestV1.VerifyTestCase{{Name: "smoke", Command: "./smoke-test.sh"}},
+			commands:       testutil.CmdRun("sh -c ./smoke-test.sh"),
+			expectedOut:    " - smoke passed",
+			expectedEvents: []string{"0 smoke InProgress", "0 smoke Succeeded"},
+		},
+		{
+			description: "failed tests don't stop the other tests",
+			testCases: []*latestV1.VerifyTestCase{
+				{Name: "smoke", Command: "./smoke-test.sh"},
+				{Name: "e2e", Command: "./e2e-test.sh"},
+			},
+			commands:    testutil.CmdRunErr("sh -c ./smoke-test.sh", errors.New("exit status 1")).AndRun("sh -c ./e2e-test.sh"),
+			expectedOut: " - e2e passed",
+			expectedEvents: []string{
+				"0 smoke InProgress", "0 smoke Failed VERIFY_TEST_CMD_FAILED_ERR",
+				"1 e2e InProgress", "1 e2e Succeeded",
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&util.DefaultExecCommand, test.commands)
+			events := recordEvents(t)
+
+			var out bytes.Buffer
+			err := NewVerifier(&mockConfig{testCases: test.testCases}).Verify(context.Background(), &out, nil)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckContains(test.expectedOut, out.String())
+			t.CheckDeepEqual(test.expectedEvents, *events)
+		})
+	}
+}
+
+// recordEvents records the events of the verify test cases as `<id> <name> <status> [<error code>]`.
+func recordEvents(t *testutil.T) *[]string {
+	var events []string
+	t.Override(&verifyInProgress, func(id int, name string) {
+		events = append(events, fmt.Sprintf("%d %s InProgress", id, name))
+	})
+	t.Override(&verifySucceeded, func(id int, name string) {
+		events = append(events, fmt.Sprintf("%d %s Succeeded", id, name))
+	})
+	t.Override(&verifyFailed, func(id int, name string, err error) {
+		events = append(events, fmt.Sprintf("%d %s Failed %s", id, name, sErrors.ActionableErrV2(nil, constants.Verify, err).ErrCode))
+	})
+	return &events
+}
+
+func TestVerifyContainer(t *testing.T) {
+	tests := []struct {
+		description    string
+		exitCode       int32
+		phase          v1.PodPhase
+		expectedImage  string
+		expectedEvents []string
+		shouldErr      bool
+	}{
+		{
+			description:    "container succeeds",
+			phase:          v1.PodSucceeded,
+			expectedImage:  "tests:abcdef",
+			expectedEvents: []string{"0 integration InProgress", "0 integration Succeeded"},
+		},
+		{
+			description:    "container fails",
+			phase:          v1.PodFailed,
+			exitCode:       1,
+			expectedImage:  "tests:abcdef",
+			expectedEvents: []string{"0 integration InProgress", "0 integration Failed VERIFY_TEST_JOB_FAILED_ERR"},
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "skaffold-verify-1-abcde",
+					Namespace: "ns",
+					Labels:    map[string]string{"skaffold.dev/test-job": "skaffold-verify-1"},
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: verifyContainerName, Image: "tests:abcdef"}}},
+				Status: v1.PodStatus{
+					Phase: test.phase,
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:  verifyContainerName,
+						State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: test.exitCode}},
+					}},
+				},
+			}
+			client := fakekubeclientset.NewSimpleClientset(pod)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&newJobName, func() string { return "skaffold-verify-1" })
+			events := recordEvents(t)
+
+			testCases := []*latestV1.VerifyTestCase{{
+				Name: "integration",
+				Container: &latestV1.VerifyContainer{
+					Image:     "tests",
+					Args:      []string{"--base-url", "http://web:8080"},
+					Namespace: "ns",
+				},
+			}}
+			artifacts := []graph.Artifact{{ImageName: "tests", Tag: "tests:abcdef"}}
+
+			var out bytes.Buffer
+			err := NewVerifier(&mockConfig{testCases: testCases}).Verify(context.Background(), &out, artifacts)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedEvents, *events)
+			var jobs []*batchv1.Job
+			for _, a := range client.Actions() {
+				if create, ok := a.(k8stesting.CreateAction); ok && a.GetResource().Resource == "jobs" {
+					jobs = append(jobs, create.GetObject().(*batchv1.Job))
+				}
+			}
+			t.CheckDeepEqual(1, len(jobs))
+			container := jobs[0].Spec.Template.Spec.Containers[0]
+			t.CheckDeepEqual(test.expectedImage, container.Image)
+			t.CheckDeepEqual([]string{"--base-url", "http://web:8080"}, container.Args)
+		})
+	}
+}
@@ -38,6 +38,8 @@ const (
 	BuilderType_KANIKO BuilderType = 5
 	// Docker Builder
 	BuilderType_DOCKER BuilderType = 6
+	// Ko Builder
+	BuilderType_KO BuilderType = 7
 )
 
 var BuilderType_name = map[int32]string{
@@ -48,6 +50,7 @@ var BuilderType_name = map[int32]string{
 	4: "CUSTOM",
 	5: "KANIKO",
 	6: "DOCKER",
+	7: "KO",
 }
 
 var BuilderType_value = map[string]int32{
@@ -58,6 +61,7 @@ var BuilderType_value = map[string]int32{
 	"CUSTOM":               4,
 	"KANIKO":               5,
 	"DOCKER":               6,
+	"KO":                   7,
 }
 
 func (x BuilderType) String() string {
@@ -392,6 +396,12 @@ const (
 	StatusCode_STATUSCHECK_UNHEALTHY StatusCode = 357
 	// Executable binary format error
 	StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR StatusCode = 358
+	// Job failed after reaching its backoff limit
+	StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED StatusCode = 359
+	// Job failed after running longer than its active deadline
+	StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED StatusCode = 360
+	// Resource reported a failed status condition
+	StatusCode_STATUSCHECK_CONDITION_FAILED StatusCode = 361
 	// Node memory pressure error
 	StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE StatusCode = 400
 	// Node disk pressure error
@@ -443,6 +453,8 @@ const (
 	StatusCode_BUILD_DOCKER_UNKNOWN StatusCode = 511
 	// Test failed due to unknown reason
 	StatusCode_TEST_UNKNOWN StatusCode = 512
+	// Verify failed due to unknown reason
+	StatusCode_VERIFY_UNKNOWN StatusCode = 513
 	// File Sync Initialize failure
 	StatusCode_SYNC_INIT_ERROR StatusCode = 601
 	// Failed to configure watcher for build dependencies in dev loop
@@ -566,6 +578,12 @@ const (
 	StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR StatusCode = 1401
 	// The Kptfile is not a valid API schema
 	StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR StatusCode = 1402
+	// Verify test command finished with a non-zero exit code
+	StatusCode_VERIFY_TEST_CMD_FAILED_ERR StatusCode = 1601
+	// Verify test timed out
+	StatusCode_VERIFY_TEST_TIMEDOUT_ERR StatusCode = 1602
+	// Verify test job failed
+	StatusCode_VERIFY_TEST_JOB_FAILED_ERR StatusCode = 1603
 	// Catch-all configuration file parsing error
 	StatusCode_CONFIG_FILE_PARSING_ERR StatusCode = 1201
 	// Main configuration file not found
@@ -652,6 +670,9 @@ var StatusCode_name = map[int32]string{
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	358:  "STATUSCHECK_CONTAINER_EXEC_ERROR",
+	359:  "STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED",
+	360:  "STATUSCHECK_JOB_DEADLINE_EXCEEDED",
+	361:  "STATUSCHECK_CONDITION_FAILED",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
 	401:  "STATUSCHECK_NODE_DISK_PRESSURE",
 	402:  "STATUSCHECK_NODE_NETWORK_UNAVAILABLE",
@@ -678,6 +699,7 @@ var StatusCode_name = map[int32]string{
 	510:  "INIT_UNKNOWN",
 	511:  "BUILD_DOCKER_UNKNOWN",
 	512:  "TEST_UNKNOWN",
+	513:  "VERIFY_UNKNOWN",
 	601:  "SYNC_INIT_ERROR",
 	701:  "DEVINIT_REGISTER_BUILD_DEPS",
 	702:  "DEVINIT_REGISTER_TEST_DEPS",
@@ -739,6 +761,9 @@ var StatusCode_name = map[int32]string{
 	1501: "RENDER_KPTFILE_INIT_ERR",
 	1401: "RENDER_KPTFILE_INVALID_YAML_ERR",
 	1402: "RENDER_KPTFILE_INVALID_SCHEMA_ERR",
+	1601: "VERIFY_TEST_CMD_FAILED_ERR",
+	1602: "VERIFY_TEST_TIMEDOUT_ERR",
+	1603: "VERIFY_TEST_JOB_FAILED_ERR",
 	1201: "CONFIG_FILE_PARSING_ERR",
 	1202: "CONFIG_FILE_NOT_FOUND_ERR",
 	1203: "CONFIG_DEPENDENCY_NOT_FOUND_ERR",
@@ -804,6 +829,9 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_CONTAINER_EXEC_ERROR":             358,
+	"STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED":       359,
+	"STATUSCHECK_JOB_DEADLINE_EXCEEDED":            360,
+	"STATUSCHECK_CONDITION_FAILED":                 361,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
 	"STATUSCHECK_NODE_DISK_PRESSURE":               401,
 	"STATUSCHECK_NODE_NETWORK_UNAVAILABLE":         402,
@@ -830,6 +858,7 @@ var StatusCode_value = map[string]int32{
 	"INIT_UNKNOWN":                                 510,
 	"BUILD_DOCKER_UNKNOWN":                         511,
 	"TEST_UNKNOWN":                                 512,
+	"VERIFY_UNKNOWN":                               513,
 	"SYNC_INIT_ERROR":                              601,
 	"DEVINIT_REGISTER_BUILD_DEPS":                  701,
 	"DEVINIT_REGISTER_TEST_DEPS":                   702,
@@ -891,6 +920,9 @@ var StatusCode_value = map[string]int32{
 	"RENDER_KPTFILE_INIT_ERR":                      1501,
 	"RENDER_KPTFILE_INVALID_YAML_ERR":              1401,
 	"RENDER_KPTFILE_INVALID_SCHEMA_ERR":            1402,
+	"VERIFY_TEST_CMD_FAILED_ERR":                   1601,
+	"VERIFY_TEST_TIMEDOUT_ERR":                     1602,
+	"VERIFY_TEST_JOB_FAILED_ERR":                   1603,
 	"CONFIG_FILE_PARSING_ERR":                      1201,
 	"CONFIG_FILE_NOT_FOUND_ERR":                    1202,
 	"CONFIG_DEPENDENCY_NOT_FOUND_ERR":              1203,
@@ -1053,6 +1085,10 @@ const (
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD   SuggestionCode = 1002
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS SuggestionCode = 1003
 	SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME       SuggestionCode = 1004
+	// Check the output of the verify test
+	SuggestionCode_CHECK_VERIFY_TEST_OUTPUT SuggestionCode = 1100
+	// Fix the verify test `timeoutSeconds`
+	SuggestionCode_FIX_VERIFY_TEST_TIMEOUT SuggestionCode = 1101
 )
 
 var SuggestionCode_name = map[int32]string{
@@ -1121,6 +1157,8 @@ var SuggestionCode_name = map[int32]string{
 	1002: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD",
 	1003: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS",
 	1004: "CHECK_TEST_COMMAND_AND_IMAGE_NAME",
+	1100: "CHECK_VERIFY_TEST_OUTPUT",
+	1101: "FIX_VERIFY_TEST_TIMEOUT",
 }
 
 var SuggestionCode_value = map[string]int32{
@@ -1189,6 +1227,8 @@ var SuggestionCode_value = map[string]int32{
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD":                  1002,
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS":                1003,
 	"CHECK_TEST_COMMAND_AND_IMAGE_NAME":                      1004,
+	"CHECK_VERIFY_TEST_OUTPUT":                               1100,
+	"FIX_VERIFY_TEST_TIMEOUT":                                1101,
 }
 
 func (x SuggestionCode) String() string {
@@ -1214,202 +1254,208 @@ func init() {
 func init() { proto.RegisterFile("enums.proto", fileDescriptor_888b6bd9597961ff) }
 
 var fileDescriptor_888b6bd9597961ff = []byte{
-	// 3145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x59, 0x90, 0x1c, 0xc5,
-	0xd1, 0x66, 0x77, 0x76, 0x76, 0x76, 0x4a, 0x02, 0x8a, 0x42, 0xf7, 0x2d, 0x81, 0x04, 0x2c, 0xfc,
-	0x12, 0xff, 0xcf, 0x1f, 0xc4, 0x1f, 0xff, 0x5b, 0x4d, 0x77, 0xcd, 0x4c, 0x31, 0xdd, 0xd5, 0x1d,
-	0x55, 0xd5, 0xbb, 0x5a, 0xbd, 0x74, 0x08, 0x6b, 0x25, 0x04, 0xab, 0x1d, 0xb1, 0xbb, 0xc2, 0xc6,
-	0x27, 0x0f, 0xbe, 0x8f, 0xf0, 0xc9, 0xe1, 0xe3, 0x01, 0xb0, 0x1d, 0x7e, 0x31, 0xe0, 0xfb, 0xe4,
-	0x34, 0xbe, 0x30, 0x97, 0x6f, 0x03, 0x61, 0xbf, 0xf9, 0x36, 0x3e, 0xc2, 0xe6, 0x3e, 0x1d, 0x59,
-	0xd5, 0xd5, 0xc7, 0xcc, 0x2c, 0x7e, 0x20, 0x18, 0x55, 0x7e, 0x9d, 0x95, 0x95, 0x95, 0x95, 0xf9,
-	0x65, 0x2e, 0x5a, 0x33, 0xbf, 0x78, 0xea, 0xc4, 0xf2, 0xfe, 0x93, 0x4b, 0xfd, 0x95, 0x3e, 0x59,
-	0x63, 0xfe, 0xb7, 0xdf, 0x2c, 0x4d, 0xf7, 0xd1, 0x9a, 0xd6, 0xa9, 0xe3, 0x0b, 0x47, 0xe6, 0x97,
-	0xf4, 0xb5, 0x27, 0xe7, 0xc9, 0x26, 0xb4, 0x2e, 0x11, 0x3d, 0x11, 0xcd, 0x8a, 0xb4, 0x95, 0xf0,
-	0xc0, 0x67, 0x32, 0xd5, 0x73, 0x31, 0xc3, 0xa7, 0x91, 0x06, 0xaa, 0x5d, 0xc6, 0x5b, 0x78, 0x8c,
-	0x34, 0x51, 0xbd, 0x45, 0x0f, 0xb1, 0x00, 0x8f, 0x93, 0x33, 0x10, 0x32, 0xa8, 0x98, 0x7a, 0x3d,
-	0x85, 0x6b, 0x04, 0xa1, 0x49, 0x2f, 0x51, 0x3a, 0x0a, 0xf1, 0x04, 0xfc, 0xee, 0x51, 0xc1, 0x7b,
-	0x11, 0xae, 0xc3, 0x6f, 0x3f, 0xf2, 0x7a, 0x4c, 0xe2, 0xc9, 0x69, 0x1f, 0x35, 0xcd, 0x86, 0x66,
-	0xbb, 0x0d, 0x88, 0x54, 0xb6, 0x73, 0x9b, 0xad, 0x41, 0x0d, 0x2f, 0x48, 0x94, 0x66, 0x12, 0x8f,
-	0xc1, 0xce, 0x1d, 0xaf, 0x85, 0xc7, 0x61, 0xe7, 0x20, 0xf2, 0x68, 0x80, 0x6b, 0xd3, 0x3d, 0x84,
-	0xf4, 0xfc, 0xf2, 0x4a, 0x66, 0xf5, 0x7a, 0x74, 0x96, 0x53, 0xa3, 0x99, 0xd2, 0x4e, 0xcb, 0x14,
-	0x9a, 0x48, 0x04, 0xd7, 0x78, 0x8c, 0x6c, 0x43, 0x9b, 0xbc, 0x48, 0x68, 0xca, 0x05, 0x93, 0xa9,
-	0xd2, 0x32, 0xf1, 0x74, 0x22, 0x99, 0x01, 0xe3, 0xf1, 0xe9, 0xab, 0x10, 0x92, 0xf3, 0x8b, 0xce,
-	0x05, 0x1b, 0xd1, 0xd9, 0x4e, 0x99, 0x64, 0xa2, 0xe4, 0x01, 0x84, 0x26, 0x25, 0x9d, 0xed, 0xfd,
-	0x9f, 0xc2, 0x63, 0x60, 0x78, 0xcf, 0x9c, 0x94, 0x1f, 0x62, 0x69, 0x48, 0x05, 0x6f, 0x1b, 0x55,
-	0xe0, 0x91, 0x2e, 0x0b, 0xc2, 0xd4, 0xeb, 0x52, 0xa9, 0x71, 0x8d, 0x60, 0xb4, 0xb6, 0x17, 0xeb,
-	0x02, 0x31, 0x31, 0x7d, 0x10, 0xad, 0xf5, 0xe7, 0x4f, 0x2e, 0xf4, 0xaf, 0xcd, 0xb6, 0xdb, 0x8c,
-	0xd6, 0xbb, 0xed, 0x7c, 0x16, 0x07, 0xd1, 0x5c, 0xb1, 0xe1, 0x14, 0x9a, 0x00, 0x65, 0x78, 0x8c,
-	0x9c, 0x8e, 0x9a, 0xf9, 0x76, 0x78, 0x1c, 0xdc, 0xd3, 0x4b, 0x5a, 0xcc, 0xd3, 0x01, 0xae, 0x81,
-	0x7b, 0x7a, 0x31, 0x68, 0xe6, 0x68, 0x8d, 0xb7, 0x70, 0x2a, 0x77, 0x4a, 0xe9, 0x2a, 0x33, 0x5f,
-	0x3a, 0xbd, 0x6b, 0xd1, 0x54, 0xc8, 0x05, 0x07, 0x15, 0x99, 0x7b, 0x7b, 0xcc, 0xba, 0x37, 0xd2,
-	0x5d, 0x26, 0x71, 0x6d, 0x3a, 0x41, 0x53, 0x41, 0xff, 0x58, 0x30, 0x7f, 0xcd, 0xfc, 0x02, 0x2c,
-	0xfb, 0xac, 0x95, 0x74, 0xac, 0x41, 0x5c, 0xb4, 0x23, 0x3c, 0x06, 0xbf, 0x66, 0xa9, 0x14, 0xf6,
-	0x2b, 0x26, 0x65, 0x24, 0x71, 0x0d, 0x7e, 0xb6, 0xa9, 0xa6, 0x01, 0x9e, 0x80, 0x9f, 0x31, 0x15,
-	0xdc, 0xc3, 0x75, 0xf8, 0xa9, 0x25, 0xf5, 0x18, 0x9e, 0x9c, 0xfe, 0xc3, 0x3e, 0x84, 0xd4, 0xca,
-	0xe1, 0x95, 0x53, 0xcb, 0x5e, 0xff, 0xc8, 0x3c, 0x99, 0x44, 0xe3, 0x51, 0x0f, 0x9f, 0x46, 0x36,
-	0xa1, 0xb3, 0x95, 0xa6, 0x3a, 0x51, 0x5e, 0x97, 0x79, 0xbd, 0x54, 0x25, 0x9e, 0xc7, 0x94, 0xc2,
-	0x3f, 0x1c, 0x23, 0x04, 0x9d, 0x6e, 0xe3, 0xc2, 0xad, 0x3d, 0x30, 0x46, 0xce, 0x46, 0x67, 0x64,
-	0xf7, 0xe2, 0x16, 0x1f, 0x32, 0x8b, 0xd6, 0x7b, 0xf9, 0xe2, 0x8f, 0xc6, 0xc8, 0x59, 0x68, 0xad,
-	0x09, 0x07, 0xb7, 0xf4, 0xa0, 0x09, 0x04, 0xab, 0x30, 0x4e, 0x54, 0x37, 0xa5, 0x66, 0x3d, 0xf5,
-	0x99, 0xe0, 0xcc, 0xc7, 0xf3, 0x64, 0x2b, 0xda, 0x98, 0x49, 0x65, 0x74, 0x19, 0xf3, 0x74, 0x2a,
-	0x22, 0x9d, 0xb6, 0xa3, 0x44, 0xf8, 0xf8, 0x28, 0x39, 0x07, 0xed, 0xb4, 0x42, 0x1b, 0xca, 0xa9,
-	0x4f, 0x59, 0x18, 0x09, 0x03, 0x91, 0x89, 0x10, 0x5c, 0x74, 0xf0, 0x31, 0xb2, 0x0e, 0x61, 0x0b,
-	0x4a, 0x14, 0x93, 0xa9, 0x75, 0xcc, 0x15, 0xc5, 0xae, 0xd9, 0xa7, 0x89, 0xa0, 0x33, 0x94, 0x07,
-	0xb4, 0x15, 0x30, 0x7c, 0x9c, 0x6c, 0x47, 0x9b, 0x07, 0xa5, 0x89, 0xee, 0x46, 0x92, 0x1f, 0x62,
-	0x3e, 0xbe, 0xb2, 0x30, 0x2a, 0x13, 0xab, 0x39, 0xa5, 0x59, 0x08, 0xba, 0xf1, 0x55, 0x64, 0x37,
-	0xda, 0x5e, 0x11, 0x82, 0x35, 0x61, 0xe4, 0xf3, 0x36, 0x67, 0xbe, 0x81, 0x2c, 0x90, 0x73, 0xd1,
-	0xae, 0x21, 0x08, 0x0f, 0xe3, 0x80, 0x85, 0x4c, 0xe8, 0x0c, 0x75, 0x82, 0xec, 0x40, 0x5b, 0x06,
-	0x4e, 0xa7, 0x69, 0x1a, 0x44, 0x4a, 0x19, 0xf9, 0xe2, 0x90, 0xbc, 0x1d, 0xc9, 0x16, 0xf7, 0x7d,
-	0x26, 0x8c, 0xbc, 0x3f, 0x74, 0x08, 0x2f, 0x12, 0xed, 0x80, 0x7b, 0xda, 0x88, 0x4f, 0x92, 0x5d,
-	0x68, 0x5b, 0x45, 0x6c, 0x3c, 0x53, 0x72, 0xef, 0xd5, 0x64, 0x0f, 0xda, 0x51, 0x41, 0x70, 0x31,
-	0x43, 0x03, 0xee, 0xa7, 0x31, 0x95, 0xd4, 0x9e, 0x76, 0x69, 0xd0, 0x88, 0x36, 0x0f, 0x58, 0x49,
-	0xc7, 0xf2, 0xd0, 0x51, 0x3d, 0xea, 0x75, 0x59, 0xda, 0x96, 0x51, 0x98, 0xc6, 0x49, 0x10, 0x18,
-	0x2d, 0x2b, 0x64, 0x27, 0xda, 0x5a, 0x41, 0x75, 0x98, 0x4e, 0x7d, 0xde, 0x81, 0x48, 0x01, 0xc0,
-	0xa9, 0xa1, 0xb3, 0x88, 0x28, 0x55, 0x31, 0xf5, 0x98, 0x11, 0xbf, 0xad, 0xf0, 0xb9, 0x64, 0x1d,
-	0xae, 0xb4, 0x9c, 0x1b, 0xd4, 0x70, 0x4d, 0x01, 0x71, 0x2f, 0xf0, 0x32, 0xde, 0x4a, 0xe3, 0x20,
-	0xe9, 0x70, 0x61, 0x1f, 0xe1, 0xeb, 0x8b, 0x98, 0x00, 0x51, 0x47, 0x52, 0x3f, 0x60, 0x90, 0x00,
-	0x8c, 0x82, 0x37, 0x14, 0x97, 0x0e, 0xd2, 0x90, 0xce, 0x30, 0x91, 0x0b, 0xaf, 0x25, 0xd3, 0x68,
-	0x1f, 0x17, 0x5c, 0xe7, 0xe6, 0x31, 0x3d, 0x1b, 0xc9, 0x5e, 0x1a, 0x70, 0xa5, 0xb9, 0xe8, 0xa4,
-	0x79, 0xa6, 0x53, 0xf8, 0x8d, 0x64, 0x3f, 0x9a, 0x1e, 0x85, 0x75, 0xde, 0x2d, 0xb2, 0xa2, 0xa0,
-	0x21, 0xc3, 0x6f, 0x22, 0x17, 0xa3, 0x8b, 0x46, 0xe1, 0x0b, 0x9c, 0x1f, 0x31, 0x65, 0x9c, 0xce,
-	0x0e, 0x72, 0xa5, 0xf1, 0x9b, 0xc1, 0xe9, 0xaf, 0xb5, 0x43, 0x18, 0xf9, 0x0c, 0xbf, 0x05, 0x3c,
-	0x32, 0x0a, 0x15, 0x53, 0xa9, 0xac, 0x5f, 0xdf, 0x4a, 0x76, 0xa2, 0x2d, 0xe5, 0x34, 0xc0, 0x43,
-	0xda, 0x61, 0xc5, 0xbd, 0x7d, 0x7e, 0x9c, 0x9c, 0x83, 0x76, 0x94, 0x01, 0x85, 0x4d, 0x9e, 0x64,
-	0x14, 0x8e, 0x8e, 0x6f, 0x1b, 0x27, 0x7b, 0xd0, 0xf6, 0x32, 0x48, 0x26, 0xa2, 0x04, 0x04, 0x45,
-	0xb7, 0x8f, 0x93, 0xbd, 0x68, 0xd7, 0x68, 0x45, 0x9a, 0xc9, 0x90, 0x0b, 0xaa, 0x99, 0x8f, 0xef,
-	0x18, 0x27, 0x17, 0xa2, 0x7d, 0x65, 0x98, 0x4d, 0x30, 0xf0, 0x6a, 0x52, 0x19, 0x05, 0x41, 0x94,
-	0xe8, 0x34, 0x66, 0xc2, 0x87, 0x7d, 0xbf, 0xf0, 0x1a, 0x3a, 0x25, 0x53, 0x9a, 0x4a, 0x63, 0xde,
-	0x6f, 0xc7, 0xc9, 0x16, 0xb4, 0xbe, 0x0c, 0x4b, 0x44, 0x97, 0xd1, 0x40, 0x77, 0xe7, 0xf0, 0xef,
-	0x5e, 0x43, 0x05, 0x3b, 0xc8, 0xbc, 0x2c, 0x99, 0xfc, 0x7e, 0x08, 0x26, 0x22, 0x9f, 0xa5, 0x21,
-	0x0b, 0x23, 0x39, 0x97, 0xc6, 0x92, 0x29, 0x95, 0x48, 0x86, 0x3f, 0x54, 0x1b, 0xf4, 0x96, 0x81,
-	0xf9, 0x5c, 0xf5, 0x0a, 0xd0, 0x87, 0x6b, 0xe4, 0x02, 0x74, 0xee, 0x10, 0xc8, 0xdd, 0x4d, 0x39,
-	0x4b, 0x7d, 0xa4, 0x36, 0xe8, 0x58, 0x03, 0x8d, 0xe1, 0x81, 0x3a, 0x75, 0x1f, 0x1d, 0xbd, 0x67,
-	0x22, 0xe0, 0x5f, 0x7e, 0x62, 0x15, 0x7d, 0xac, 0x46, 0x76, 0xa3, 0x6d, 0x23, 0x40, 0x92, 0x51,
-	0xaf, 0x6b, 0x20, 0xd7, 0xd7, 0x06, 0x43, 0xc1, 0x9a, 0x05, 0x89, 0x96, 0x51, 0x7f, 0x0e, 0xdf,
-	0x30, 0x64, 0x4c, 0x9b, 0xf2, 0x80, 0xf9, 0x69, 0xb6, 0x11, 0xb8, 0xfa, 0xc6, 0x1a, 0x39, 0x0f,
-	0xed, 0x29, 0x63, 0xb2, 0x8a, 0x09, 0x6e, 0x15, 0xcc, 0xd3, 0x3c, 0xb2, 0xa9, 0xeb, 0xe3, 0x43,
-	0x56, 0x3b, 0x20, 0x1c, 0xae, 0xc7, 0x83, 0x80, 0xf9, 0xf8, 0x13, 0x43, 0x9e, 0xca, 0xb5, 0x05,
-	0x1c, 0x02, 0xa2, 0xcd, 0xb4, 0xd7, 0x35, 0xfa, 0x3e, 0x59, 0x1b, 0xbc, 0xa0, 0x52, 0xdc, 0x14,
-	0xb0, 0x4f, 0x0d, 0xf9, 0x21, 0x8e, 0xfc, 0x14, 0x9e, 0x08, 0xa7, 0x01, 0x3f, 0x04, 0x47, 0xb8,
-	0xbf, 0x06, 0xf5, 0xcf, 0x65, 0x10, 0x7b, 0xfd, 0x4f, 0xd5, 0x06, 0xab, 0x65, 0x26, 0xc7, 0x4f,
-	0xd7, 0xc8, 0x3e, 0xb4, 0x7b, 0x84, 0x64, 0xe0, 0x02, 0x9e, 0xa9, 0x91, 0x69, 0xb4, 0x77, 0x74,
-	0x9c, 0xcd, 0x52, 0x6e, 0x32, 0x88, 0xd3, 0xf9, 0x6c, 0x8d, 0xec, 0x40, 0x9b, 0x47, 0xe9, 0x64,
-	0x33, 0x4c, 0x68, 0xfc, 0x72, 0xad, 0x54, 0x78, 0xdd, 0x47, 0xcf, 0xd5, 0xa0, 0xf0, 0xaa, 0x39,
-	0xe1, 0xe5, 0x4b, 0xcf, 0xd7, 0x8a, 0x4a, 0xee, 0xd6, 0x5e, 0xa8, 0x91, 0x75, 0xe8, 0x4c, 0x9f,
-	0xcd, 0x98, 0xb4, 0xe0, 0x56, 0x5f, 0x34, 0xab, 0x5e, 0xc0, 0xa8, 0x48, 0xe2, 0x7c, 0xf5, 0x25,
-	0xa3, 0xb2, 0x02, 0x7c, 0xa5, 0x46, 0x36, 0xa3, 0x75, 0x03, 0x75, 0xd3, 0x8a, 0x5e, 0xad, 0xe5,
-	0x95, 0xdf, 0x2d, 0x5d, 0x37, 0x01, 0x6a, 0x8d, 0x4d, 0x46, 0x8b, 0x75, 0xe6, 0xe3, 0x13, 0x64,
-	0x17, 0xda, 0xea, 0x4c, 0xb0, 0xd9, 0x9c, 0xc9, 0x8c, 0x89, 0xfa, 0x2c, 0x56, 0xf8, 0xae, 0x3a,
-	0x84, 0xe2, 0x10, 0xc2, 0xe8, 0x36, 0x80, 0xbb, 0xeb, 0x70, 0x8d, 0x43, 0x80, 0xcc, 0x25, 0x06,
-	0x72, 0x4f, 0x7d, 0xe4, 0x2e, 0x50, 0x20, 0x79, 0x07, 0x20, 0xf8, 0xde, 0x3a, 0x39, 0x17, 0xed,
-	0x2c, 0x5c, 0xa1, 0x92, 0x38, 0x8e, 0x24, 0xd4, 0xe6, 0x99, 0xff, 0x2e, 0xa8, 0xe3, 0x7d, 0xf5,
-	0xc1, 0x67, 0x61, 0x38, 0x86, 0x47, 0x85, 0xc7, 0x4c, 0x90, 0xde, 0x3c, 0x39, 0xf8, 0x2c, 0x7c,
-	0x46, 0xfd, 0x80, 0x0b, 0x96, 0xb2, 0x83, 0x1e, 0x63, 0x3e, 0xf3, 0xf1, 0x2d, 0x93, 0xe0, 0x08,
-	0x7b, 0xc2, 0xe2, 0xcb, 0x5b, 0x27, 0xc9, 0x7a, 0x84, 0x33, 0xa3, 0x8b, 0xe5, 0x4f, 0x4f, 0x92,
-	0xad, 0x68, 0xc3, 0x40, 0x45, 0x75, 0xc2, 0xcf, 0x4c, 0x42, 0x2e, 0xab, 0x72, 0x86, 0x6c, 0x3b,
-	0xfc, 0xd9, 0x49, 0xb2, 0x1d, 0x6d, 0x32, 0xa7, 0x31, 0xa9, 0x99, 0xa5, 0x9a, 0x76, 0x3a, 0x39,
-	0x21, 0x7a, 0x47, 0x03, 0x4e, 0x62, 0xc4, 0x8e, 0x87, 0xa6, 0x31, 0x4d, 0x94, 0x25, 0x23, 0x91,
-	0xc4, 0xef, 0x6c, 0x80, 0x43, 0xaa, 0x80, 0x12, 0xcf, 0xca, 0x50, 0xef, 0x6a, 0x40, 0x74, 0x96,
-	0x77, 0x71, 0x2d, 0x8b, 0x95, 0xbf, 0xbb, 0xd8, 0x26, 0x93, 0xe7, 0x04, 0xdb, 0x02, 0xde, 0x33,
-	0x04, 0x70, 0x17, 0x9b, 0x01, 0xde, 0xdb, 0x00, 0xbf, 0x58, 0x80, 0xa1, 0x12, 0x76, 0xf9, 0x7d,
-	0x85, 0x79, 0xd9, 0x77, 0xb3, 0x14, 0xde, 0xb5, 0x96, 0xbc, 0x74, 0xca, 0xf7, 0x37, 0x20, 0xb1,
-	0x94, 0x51, 0x50, 0x05, 0xda, 0xd4, 0x2b, 0xef, 0xf0, 0x81, 0x06, 0xdc, 0x99, 0xf3, 0x7c, 0x46,
-	0xd3, 0x07, 0x32, 0xd4, 0x9f, 0x1a, 0x90, 0x51, 0xf2, 0x90, 0x6a, 0x25, 0x9d, 0xb4, 0xcb, 0x82,
-	0xd8, 0x94, 0x16, 0x2d, 0x39, 0x9b, 0xb1, 0x05, 0xf4, 0xcf, 0x0d, 0xb2, 0x11, 0x91, 0x5c, 0x95,
-	0x7d, 0x41, 0x20, 0xf8, 0x4b, 0x03, 0x6e, 0x23, 0x13, 0x98, 0xee, 0x84, 0xc6, 0x71, 0x30, 0x97,
-	0x06, 0xb4, 0xc5, 0x02, 0x85, 0x9f, 0x6c, 0xc0, 0x4b, 0x2a, 0x8b, 0x1d, 0x77, 0xc5, 0x7f, 0x2d,
-	0x7f, 0x29, 0xa2, 0x34, 0x84, 0x63, 0xc2, 0x05, 0x18, 0x47, 0xe3, 0xbf, 0x35, 0xc8, 0x36, 0xb4,
-	0xb1, 0xfc, 0xe5, 0x0c, 0x93, 0xca, 0x99, 0xfd, 0xf7, 0x86, 0x8d, 0xfb, 0x42, 0x1a, 0x72, 0x51,
-	0x41, 0xfc, 0xa3, 0x61, 0x5f, 0x97, 0x41, 0xb8, 0x84, 0x5a, 0x06, 0xfc, 0x62, 0xca, 0x3e, 0x8c,
-	0x0a, 0x20, 0x6a, 0xb7, 0x4d, 0x4c, 0x03, 0xb1, 0x30, 0xa8, 0x7f, 0x36, 0x4a, 0x28, 0x26, 0x8b,
-	0x34, 0xd6, 0x8e, 0x20, 0x26, 0x03, 0x06, 0x9e, 0xc4, 0xff, 0x2a, 0x9f, 0x05, 0xea, 0x48, 0xfe,
-	0xb2, 0x8c, 0x92, 0xa7, 0xca, 0x4a, 0x8c, 0x58, 0xb2, 0x30, 0xd2, 0xac, 0x8a, 0x7a, 0xba, 0xac,
-	0x04, 0xf8, 0x56, 0x55, 0xfc, 0x4c, 0xd9, 0x21, 0xce, 0xde, 0xdc, 0x9b, 0xcf, 0x9a, 0x78, 0xcd,
-	0xa5, 0xae, 0x7b, 0xcc, 0xe5, 0xcf, 0x55, 0x2d, 0x8c, 0x03, 0xa0, 0x9c, 0x96, 0x05, 0x81, 0xf8,
-	0xf9, 0x72, 0xa8, 0x68, 0x49, 0x85, 0x6a, 0x47, 0x32, 0xac, 0x1a, 0xf0, 0x42, 0xf9, 0x2e, 0x15,
-	0xd3, 0xf6, 0x8e, 0x8d, 0xe8, 0xc5, 0xf2, 0xee, 0xf9, 0x47, 0xb3, 0x92, 0x6b, 0xab, 0xfe, 0xa5,
-	0x72, 0x94, 0x59, 0x5a, 0x96, 0xa3, 0x8c, 0x11, 0xb6, 0x13, 0x78, 0xb9, 0x41, 0xce, 0x47, 0xe7,
-	0x94, 0x6f, 0x35, 0x0b, 0x6e, 0x61, 0x59, 0x61, 0x41, 0x19, 0x5e, 0x69, 0x40, 0x05, 0x1e, 0x08,
-	0x6d, 0x2e, 0x34, 0x93, 0x82, 0x06, 0xe5, 0x2e, 0xe6, 0xd5, 0x8a, 0xd7, 0x62, 0x6d, 0x68, 0xbd,
-	0x4b, 0xd3, 0xf8, 0xba, 0x29, 0x38, 0x92, 0xcd, 0xe6, 0xaa, 0xc8, 0x9b, 0x20, 0x7a, 0x78, 0x8a,
-	0x6c, 0x40, 0x67, 0x19, 0x91, 0xe7, 0xc4, 0xb0, 0xfe, 0x48, 0xb1, 0xce, 0xc3, 0x4e, 0x41, 0x21,
-	0x1f, 0x9d, 0x02, 0x17, 0x58, 0xbc, 0x71, 0x7f, 0xea, 0x85, 0x7e, 0x89, 0x82, 0xfe, 0x78, 0x0a,
-	0x4a, 0xe3, 0xa0, 0x1c, 0x18, 0xa4, 0x88, 0x44, 0x7a, 0x88, 0xc9, 0x08, 0x48, 0xaf, 0x35, 0xeb,
-	0x27, 0x53, 0xe0, 0xae, 0x51, 0x58, 0xcd, 0x43, 0xe6, 0x03, 0x39, 0x04, 0xd8, 0x4f, 0xa7, 0xa0,
-	0x2a, 0x8f, 0x82, 0xe5, 0x99, 0xd4, 0xe0, 0x7e, 0xb6, 0x2a, 0x0e, 0xb8, 0x5f, 0x92, 0xe7, 0x82,
-	0x9f, 0x4f, 0x41, 0x52, 0x19, 0x8d, 0xe3, 0xae, 0x9b, 0xfb, 0xe5, 0x14, 0x38, 0x74, 0x24, 0x48,
-	0x4a, 0xfc, 0xab, 0x21, 0xcb, 0x7d, 0x06, 0x3c, 0x96, 0x09, 0x8f, 0x33, 0x65, 0xa0, 0x00, 0x7b,
-	0x6c, 0x8a, 0x5c, 0x84, 0xce, 0x5b, 0x15, 0x96, 0x88, 0x90, 0x4a, 0xd5, 0xa5, 0x99, 0x6b, 0x1f,
-	0x9f, 0x82, 0x3a, 0x38, 0xb4, 0x65, 0x39, 0x3f, 0x3d, 0x61, 0xac, 0xca, 0x5a, 0xf7, 0xa1, 0x6b,
-	0xfe, 0xcd, 0x1a, 0x78, 0x7f, 0x43, 0x52, 0xdb, 0x43, 0xcc, 0xd1, 0xd0, 0x6e, 0xf3, 0x3c, 0x02,
-	0x37, 0xad, 0x82, 0x82, 0xa2, 0x17, 0x52, 0xfb, 0x0e, 0x10, 0xec, 0x95, 0x85, 0x8a, 0x01, 0xc1,
-	0x2d, 0x67, 0xa5, 0x03, 0x7f, 0xb1, 0x09, 0x71, 0x50, 0x96, 0xe6, 0x4d, 0xa4, 0x91, 0x7f, 0xa9,
-	0x09, 0xb6, 0x14, 0x05, 0xda, 0x9e, 0x7a, 0x6e, 0x00, 0xf5, 0xe5, 0x26, 0x70, 0x42, 0x87, 0x4a,
-	0xe2, 0x80, 0x7b, 0xe6, 0x1d, 0xd0, 0x90, 0xa9, 0x54, 0xd1, 0x90, 0x59, 0xd5, 0x00, 0xfd, 0x4a,
-	0x13, 0x7c, 0xb9, 0x0a, 0x94, 0x7a, 0x12, 0xda, 0x6c, 0x00, 0xdb, 0x27, 0xf6, 0xd5, 0x26, 0x54,
-	0xd6, 0x0c, 0xdd, 0xa2, 0x3e, 0x88, 0x74, 0x16, 0xda, 0x5f, 0x2b, 0xcb, 0x4c, 0x44, 0x16, 0x06,
-	0x7d, 0xbd, 0x7c, 0x2c, 0x9b, 0xe2, 0x63, 0x19, 0x15, 0x7a, 0xbf, 0x51, 0x96, 0xfb, 0xac, 0x4d,
-	0x93, 0x40, 0xa7, 0x33, 0x34, 0x48, 0x32, 0xf9, 0x37, 0x9b, 0xf0, 0x60, 0xab, 0x4e, 0xd3, 0x5d,
-	0x95, 0xaa, 0xa4, 0xa5, 0x34, 0xd7, 0x45, 0x10, 0x7e, 0xab, 0x49, 0xfe, 0x0b, 0x9d, 0x9f, 0x01,
-	0xc3, 0x24, 0xd0, 0x3c, 0xe5, 0x21, 0x30, 0x16, 0xb7, 0x5f, 0xb5, 0xfb, 0xff, 0x76, 0x13, 0x12,
-	0x57, 0x06, 0xcf, 0x2d, 0xaa, 0x3a, 0xf3, 0xce, 0x26, 0xc4, 0x75, 0x86, 0x71, 0x24, 0x93, 0xc6,
-	0xbc, 0x52, 0x0e, 0xee, 0x6a, 0x42, 0x82, 0x1c, 0x00, 0x99, 0xbb, 0xa7, 0x3a, 0x92, 0xf8, 0xee,
-	0x26, 0x94, 0x93, 0x01, 0x71, 0x9e, 0x28, 0x99, 0xc4, 0xf7, 0x34, 0x21, 0xf2, 0x9d, 0xdd, 0x5c,
-	0x99, 0x80, 0xc8, 0x93, 0x5c, 0x7e, 0x5b, 0xf7, 0x36, 0xa1, 0xf3, 0xcb, 0x60, 0x59, 0x15, 0x90,
-	0x2c, 0x8e, 0x32, 0x0e, 0x50, 0x35, 0xfc, 0xbe, 0x26, 0x10, 0x72, 0x2e, 0x54, 0xcc, 0x3c, 0x9d,
-	0x96, 0xc8, 0x3a, 0xbe, 0x1e, 0x41, 0x7c, 0x38, 0x89, 0x25, 0x4a, 0x4c, 0xcc, 0xa4, 0x34, 0x30,
-	0x5d, 0x8c, 0xed, 0x9f, 0xad, 0xe7, 0x6f, 0x58, 0x05, 0xca, 0x85, 0x17, 0x49, 0x09, 0x6b, 0x7a,
-	0x2e, 0xb6, 0xc6, 0xdd, 0x88, 0xc0, 0x99, 0x0e, 0xea, 0xfc, 0x5d, 0xb5, 0xe9, 0x26, 0x34, 0xfd,
-	0x41, 0x8c, 0xce, 0x50, 0xa7, 0x8e, 0x1d, 0x9b, 0x5f, 0x5e, 0x39, 0xde, 0x5f, 0x34, 0xd3, 0xb6,
-	0x06, 0xaa, 0x09, 0x1e, 0xe0, 0xd3, 0xc8, 0x3a, 0x84, 0xa9, 0xef, 0xe7, 0x11, 0x00, 0x47, 0xc3,
-	0x47, 0xc8, 0x06, 0x44, 0x1c, 0x69, 0x2c, 0xad, 0xcf, 0x43, 0x7b, 0x3f, 0xbc, 0x9e, 0x76, 0x82,
-	0xa8, 0x45, 0x83, 0x2c, 0x19, 0xe3, 0xa3, 0x64, 0x17, 0xda, 0xd6, 0xf1, 0x82, 0x28, 0xc9, 0xb9,
-	0x20, 0x4d, 0x74, 0x37, 0x13, 0x43, 0x6f, 0x78, 0x8c, 0x6c, 0x46, 0xeb, 0x47, 0x8b, 0xae, 0x20,
-	0x9b, 0xd0, 0x3a, 0xbb, 0x45, 0xa6, 0x22, 0x9b, 0xbe, 0xe1, 0xe3, 0x85, 0x24, 0xfb, 0xd4, 0x0d,
-	0xda, 0xae, 0x04, 0x73, 0xdb, 0xfc, 0xa0, 0xcd, 0xf9, 0x99, 0xc3, 0xcc, 0x40, 0x6c, 0x03, 0x22,
-	0x19, 0xd6, 0xcd, 0x68, 0xb4, 0x9c, 0xc3, 0x0b, 0x64, 0x0f, 0xda, 0x01, 0xf8, 0xd2, 0x44, 0x28,
-	0x67, 0x63, 0xd9, 0x21, 0x4e, 0x38, 0x8c, 0xea, 0xd1, 0x76, 0x3b, 0x0a, 0xfc, 0x9c, 0xa2, 0xe7,
-	0xc3, 0x26, 0xbc, 0x08, 0x07, 0x05, 0x4c, 0x69, 0x9e, 0xe3, 0x4e, 0x42, 0x0d, 0xcd, 0xe8, 0x93,
-	0xbd, 0x68, 0x37, 0x20, 0x56, 0x1d, 0xa0, 0x98, 0x41, 0xcb, 0x49, 0x32, 0x8d, 0xf6, 0x55, 0x8e,
-	0x36, 0x0c, 0x74, 0x87, 0xbd, 0x9a, 0xfc, 0x3f, 0xba, 0x74, 0x84, 0x4a, 0x43, 0x80, 0x66, 0xbb,
-	0x0c, 0x72, 0xbf, 0x96, 0xd4, 0xab, 0x0e, 0x7f, 0xec, 0x3e, 0x4b, 0x70, 0xdb, 0x90, 0xf9, 0xb3,
-	0x6f, 0x63, 0x99, 0x08, 0x86, 0x97, 0x61, 0x15, 0xe8, 0x81, 0xa3, 0x89, 0xed, 0x80, 0x76, 0xf0,
-	0x8a, 0x79, 0x5d, 0xb6, 0x25, 0x1c, 0x62, 0xa2, 0xf8, 0x81, 0x31, 0x93, 0x79, 0x8c, 0x38, 0x27,
-	0xe5, 0xb6, 0xd9, 0xc8, 0x66, 0xad, 0x5c, 0x28, 0x0d, 0xf5, 0xc0, 0xcc, 0xac, 0x1f, 0x34, 0x4b,
-	0x49, 0xdc, 0x91, 0xd4, 0x67, 0x76, 0xe9, 0xa1, 0x31, 0x72, 0x31, 0xba, 0x70, 0x94, 0x87, 0x2d,
-	0x29, 0x75, 0xf7, 0x11, 0xcd, 0x30, 0x29, 0xb9, 0xcf, 0x14, 0x7e, 0xd8, 0x0c, 0x76, 0xcb, 0x4a,
-	0x2e, 0xf9, 0x1f, 0xfc, 0xc8, 0x18, 0xd9, 0x8f, 0x2e, 0x58, 0x55, 0x8d, 0xa3, 0x23, 0x90, 0x5b,
-	0x63, 0xea, 0x31, 0xfc, 0xe8, 0x18, 0xb4, 0x3c, 0xce, 0x38, 0x37, 0x37, 0xff, 0xf5, 0x18, 0xd0,
-	0x8a, 0xc1, 0x06, 0x38, 0x88, 0x3a, 0x0a, 0xdf, 0x36, 0x5e, 0x9c, 0x14, 0x9e, 0x2a, 0x17, 0x4c,
-	0x29, 0x08, 0xca, 0x16, 0xc3, 0xb7, 0x97, 0x64, 0xc5, 0x67, 0x86, 0x1f, 0xe1, 0x3b, 0xc6, 0xa1,
-	0x06, 0x52, 0xdf, 0x97, 0x80, 0x5f, 0x6d, 0x2c, 0xb3, 0x13, 0x6d, 0xa9, 0x40, 0x86, 0x46, 0x32,
-	0x7b, 0xd1, 0xae, 0x0a, 0x60, 0x95, 0x71, 0xcc, 0x0e, 0xb4, 0xb9, 0x02, 0x1b, 0x1c, 0xc5, 0x0c,
-	0xee, 0x33, 0x34, 0x86, 0xd9, 0x8e, 0x36, 0x0d, 0x00, 0x2a, 0x23, 0x98, 0xad, 0x68, 0x43, 0xd5,
-	0x8c, 0xf2, 0xf8, 0xa5, 0xb4, 0xf9, 0xc8, 0xd1, 0x4b, 0xee, 0xa3, 0x6e, 0xa4, 0x74, 0x39, 0x8a,
-	0x6e, 0x32, 0x13, 0x03, 0x33, 0x10, 0xcb, 0xa3, 0x08, 0x3f, 0x5d, 0x83, 0x36, 0x2b, 0x11, 0xa6,
-	0x07, 0x2c, 0x96, 0x9f, 0x31, 0xb3, 0x80, 0x72, 0xf0, 0x26, 0x41, 0x80, 0x3f, 0x37, 0x61, 0xba,
-	0x5c, 0xa6, 0xdd, 0x5f, 0x67, 0x20, 0x76, 0xf3, 0xa6, 0xa0, 0x4d, 0x03, 0xc5, 0xf0, 0x63, 0x13,
-	0x90, 0x94, 0x1d, 0x3f, 0x08, 0xa9, 0x48, 0x68, 0x60, 0xa8, 0x06, 0xb4, 0xfc, 0x1b, 0x11, 0x71,
-	0x12, 0x6b, 0x23, 0xb0, 0x0b, 0xfc, 0xc4, 0x04, 0x9c, 0x38, 0x0b, 0xa4, 0x6c, 0xa8, 0xe4, 0x4a,
-	0x20, 0xbe, 0xb3, 0x5e, 0xaa, 0x8c, 0xf9, 0x50, 0xc7, 0xd1, 0x02, 0x9f, 0xb5, 0xcd, 0xdc, 0x26,
-	0x12, 0xf8, 0xae, 0x3a, 0x6c, 0x9c, 0x03, 0xa9, 0xe8, 0x64, 0x91, 0x88, 0xef, 0xae, 0x4a, 0x32,
-	0xfd, 0x1a, 0xaa, 0x52, 0xbd, 0xc4, 0x36, 0xb2, 0x51, 0x50, 0x96, 0xd6, 0x4b, 0x9a, 0xef, 0xad,
-	0x93, 0x03, 0x68, 0x7a, 0x35, 0x13, 0xf2, 0xaa, 0xaa, 0x58, 0x90, 0x79, 0xfa, 0xbe, 0x7a, 0xa9,
-	0xa2, 0x56, 0xd5, 0x16, 0xa0, 0xef, 0xd4, 0x4b, 0xa7, 0x86, 0x27, 0x55, 0x2a, 0xb9, 0xf8, 0x7e,
-	0x33, 0xb8, 0x70, 0x7c, 0x22, 0x08, 0xa2, 0x59, 0xd3, 0xf1, 0xe4, 0x05, 0x57, 0xe1, 0xef, 0xd6,
-	0x4b, 0x95, 0xbd, 0x40, 0xac, 0x2c, 0x1d, 0x5e, 0x5c, 0x3e, 0xda, 0x5f, 0x3a, 0x31, 0xbf, 0xb4,
-	0x8c, 0xbf, 0x57, 0x2f, 0x15, 0x5d, 0xd8, 0x62, 0x64, 0xe1, 0xc5, 0xdf, 0x2f, 0x9b, 0xcb, 0x04,
-	0x44, 0x61, 0xa5, 0xf6, 0xaa, 0x39, 0xe1, 0xe1, 0x1f, 0xd4, 0x81, 0xfe, 0xe5, 0xc5, 0x56, 0x31,
-	0xfb, 0xb7, 0x8e, 0xb9, 0x34, 0x82, 0xac, 0x38, 0xeb, 0x0e, 0x88, 0x6f, 0x9e, 0xb4, 0x93, 0x81,
-	0x02, 0x07, 0x05, 0x2f, 0xaf, 0xab, 0xf8, 0x96, 0x49, 0x38, 0x99, 0x93, 0x67, 0xe3, 0x66, 0x11,
-	0x27, 0x79, 0x41, 0xc5, 0xb7, 0x4e, 0x92, 0x33, 0x11, 0x8a, 0x62, 0x26, 0x52, 0xae, 0x54, 0xc2,
-	0xf0, 0xdb, 0x1b, 0xa5, 0x7c, 0x91, 0x31, 0xdc, 0x28, 0x0c, 0xa9, 0xf0, 0xf1, 0x1f, 0x4d, 0x1b,
-	0x6b, 0xaa, 0x4b, 0x45, 0x60, 0x3a, 0x81, 0x28, 0xd1, 0xd0, 0xc0, 0x4f, 0xa3, 0xbd, 0xa3, 0xbe,
-	0x1d, 0xa2, 0xde, 0xd0, 0xc5, 0x03, 0x55, 0xfc, 0x8f, 0x58, 0x43, 0xcd, 0xa0, 0xb5, 0xdf, 0x87,
-	0x76, 0x5b, 0xb4, 0x25, 0xdf, 0x19, 0x16, 0xfe, 0xb3, 0xad, 0xa3, 0x29, 0x03, 0x4f, 0x36, 0x5a,
-	0x97, 0x1e, 0xfa, 0xdf, 0x63, 0xc7, 0x57, 0xae, 0x38, 0x75, 0xf9, 0xfe, 0xd7, 0xf5, 0x4f, 0x1c,
-	0xe8, 0xf4, 0xfb, 0xc7, 0x16, 0xe6, 0xbd, 0xfe, 0xe2, 0xca, 0xe1, 0xe3, 0x8b, 0xf3, 0x4b, 0xba,
-	0xdf, 0x5f, 0x58, 0x3e, 0xb0, 0x7c, 0xd5, 0xe1, 0xa3, 0x47, 0xfb, 0x0b, 0x47, 0x0e, 0x98, 0x3f,
-	0x10, 0x1f, 0x30, 0x7f, 0x20, 0xbe, 0x7c, 0xd2, 0xfc, 0xe3, 0x92, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0x6e, 0x50, 0x58, 0x43, 0x1e, 0x00, 0x00,
+	// 3239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x69, 0x90, 0x1c, 0xc5,
+	0x95, 0x66, 0xa6, 0xa7, 0xa7, 0xbb, 0x53, 0x02, 0x92, 0x44, 0xf7, 0x2d, 0x40, 0x02, 0x06, 0x56,
+	0x62, 0x97, 0x0d, 0x62, 0x63, 0xff, 0x65, 0x57, 0x65, 0x77, 0xa7, 0xba, 0x2a, 0xab, 0x22, 0x33,
+	0x6b, 0x46, 0xa3, 0x3f, 0x15, 0x62, 0x35, 0x12, 0x82, 0xd1, 0xb4, 0x98, 0x19, 0xc1, 0xb2, 0x27,
+	0x1b, 0xb1, 0xf7, 0x11, 0xb1, 0xbb, 0x36, 0x87, 0x8f, 0x1f, 0x3e, 0xc3, 0x7f, 0x6c, 0x7c, 0xdf,
+	0x5c, 0x02, 0xdf, 0x08, 0xf0, 0x85, 0x0d, 0x84, 0xfd, 0xcf, 0x07, 0xd8, 0xf8, 0x08, 0x9b, 0xfb,
+	0x74, 0xbc, 0xcc, 0x3a, 0xb2, 0xba, 0x7b, 0xf0, 0x0f, 0x85, 0x6a, 0xf2, 0x7d, 0xf9, 0xae, 0x7c,
+	0xf9, 0xde, 0xcb, 0xd7, 0x68, 0xdd, 0xc2, 0xd2, 0x99, 0x53, 0x2b, 0x07, 0x4e, 0x2f, 0x0f, 0x56,
+	0x07, 0x64, 0x9d, 0xf9, 0xef, 0x80, 0x59, 0x9a, 0xb9, 0x15, 0xad, 0x6b, 0x9f, 0x39, 0xb9, 0x78,
+	0x6c, 0x61, 0x59, 0xdf, 0x76, 0x7a, 0x81, 0x6c, 0x41, 0x1b, 0x12, 0xd1, 0x17, 0xd1, 0x9c, 0x48,
+	0xdb, 0x09, 0x0f, 0x7c, 0x26, 0x53, 0x3d, 0x1f, 0x33, 0x7c, 0x1e, 0x69, 0xa0, 0xda, 0x21, 0xde,
+	0xc6, 0x13, 0xa4, 0x85, 0xea, 0x6d, 0x7a, 0x84, 0x05, 0x78, 0x92, 0x5c, 0x80, 0x90, 0x41, 0xc5,
+	0xd4, 0xeb, 0x2b, 0x5c, 0x23, 0x08, 0x4d, 0x7b, 0x89, 0xd2, 0x51, 0x88, 0xa7, 0xe0, 0xbb, 0x4f,
+	0x05, 0xef, 0x47, 0xb8, 0x0e, 0xdf, 0x7e, 0xe4, 0xf5, 0x99, 0xc4, 0xd3, 0x64, 0x1a, 0x4d, 0xf6,
+	0x23, 0xdc, 0x98, 0xf1, 0x51, 0xcb, 0x08, 0x36, 0x62, 0x37, 0x21, 0x52, 0x11, 0x9b, 0x0b, 0x5d,
+	0x87, 0x1a, 0x5e, 0x90, 0x28, 0xcd, 0x24, 0x9e, 0x00, 0x0d, 0xba, 0x5e, 0x1b, 0x4f, 0x82, 0x06,
+	0x41, 0xe4, 0xd1, 0x00, 0xd7, 0x66, 0xfa, 0x08, 0xe9, 0x85, 0x95, 0xd5, 0x4c, 0xfb, 0x8d, 0xe8,
+	0xa2, 0x9c, 0x8d, 0x66, 0x4a, 0xe7, 0x5c, 0x9a, 0x68, 0x2a, 0x11, 0x5c, 0xe3, 0x09, 0xb2, 0x03,
+	0x6d, 0xf1, 0x22, 0xa1, 0x29, 0x17, 0x4c, 0xa6, 0x4a, 0xcb, 0xc4, 0xd3, 0x89, 0x64, 0x06, 0x8c,
+	0x27, 0x67, 0x6e, 0x42, 0x48, 0x2e, 0x2c, 0xe5, 0xae, 0xd8, 0x8c, 0x2e, 0xce, 0x99, 0x49, 0x26,
+	0x1c, 0x4f, 0x20, 0x34, 0x2d, 0xe9, 0x5c, 0xff, 0x2f, 0x14, 0x9e, 0x00, 0xc5, 0xfb, 0xc6, 0x62,
+	0x7e, 0x84, 0xa5, 0x21, 0x15, 0xbc, 0x63, 0x58, 0x81, 0x67, 0x7a, 0x2c, 0x08, 0x53, 0xaf, 0x47,
+	0xa5, 0xc6, 0x35, 0x82, 0xd1, 0xfa, 0x7e, 0xac, 0x4b, 0xc4, 0xd4, 0xcc, 0x61, 0xb4, 0xde, 0x5f,
+	0x38, 0xbd, 0x38, 0xb8, 0x2d, 0x13, 0xb7, 0x15, 0x6d, 0xcc, 0xc5, 0xf9, 0x2c, 0x0e, 0xa2, 0xf9,
+	0x52, 0x60, 0x13, 0x4d, 0x01, 0x33, 0x3c, 0x41, 0xce, 0x47, 0xad, 0x42, 0x1c, 0x9e, 0x04, 0xf7,
+	0xf4, 0x93, 0x36, 0xf3, 0x74, 0x80, 0x6b, 0xe0, 0x9e, 0x7e, 0x0c, 0x9c, 0x39, 0x5a, 0xe7, 0x2d,
+	0x9e, 0x59, 0x59, 0x1d, 0x3d, 0xd2, 0xcc, 0x97, 0x39, 0xdf, 0xf5, 0xa8, 0x19, 0x72, 0xc1, 0x81,
+	0x45, 0xe6, 0xde, 0x3e, 0xb3, 0xee, 0x8d, 0x74, 0x8f, 0x49, 0x5c, 0x9b, 0x49, 0x50, 0x33, 0x18,
+	0x9c, 0x08, 0x16, 0x6e, 0x59, 0x58, 0x84, 0x65, 0x9f, 0xb5, 0x93, 0xae, 0x55, 0x88, 0x8b, 0x4e,
+	0x84, 0x27, 0xe0, 0x6b, 0x8e, 0x4a, 0x61, 0x77, 0x31, 0x29, 0x23, 0x89, 0x6b, 0xf0, 0xd9, 0xa1,
+	0x9a, 0x06, 0x78, 0x0a, 0x3e, 0x63, 0x2a, 0xb8, 0x87, 0xeb, 0xf0, 0xa9, 0x25, 0xf5, 0x18, 0x9e,
+	0x9e, 0x79, 0xe2, 0x0a, 0x84, 0xd4, 0xea, 0xd1, 0xd5, 0x33, 0x2b, 0xde, 0xe0, 0xd8, 0x02, 0x84,
+	0x44, 0xd4, 0xc7, 0xe7, 0x91, 0x2d, 0xe8, 0x62, 0xa5, 0xa9, 0x4e, 0x94, 0xd7, 0x63, 0x5e, 0x3f,
+	0x55, 0x89, 0xe7, 0x31, 0xa5, 0xf0, 0x37, 0x26, 0x08, 0x41, 0xe7, 0xdb, 0xb8, 0xc8, 0xd7, 0xbe,
+	0x39, 0x41, 0x2e, 0x46, 0x17, 0x64, 0xe7, 0x92, 0x2f, 0x9e, 0x33, 0x8b, 0xd6, 0x7b, 0xc5, 0xe2,
+	0xb7, 0x26, 0xc8, 0x45, 0x68, 0xbd, 0x09, 0x87, 0x7c, 0xe9, 0x11, 0x13, 0x08, 0x96, 0x61, 0x9c,
+	0xa8, 0x5e, 0x4a, 0xcd, 0x7a, 0xea, 0x33, 0xc1, 0x99, 0x8f, 0x17, 0xc8, 0x76, 0xb4, 0x39, 0xa3,
+	0xca, 0xe8, 0x10, 0xf3, 0x74, 0x2a, 0x22, 0x9d, 0x76, 0xa2, 0x44, 0xf8, 0xf8, 0x38, 0xb9, 0x14,
+	0xed, 0xb6, 0x44, 0x1b, 0xd2, 0xa9, 0x4f, 0x59, 0x18, 0x09, 0x03, 0x91, 0x89, 0x10, 0x5c, 0x74,
+	0xf1, 0x09, 0xb2, 0x01, 0x61, 0x0b, 0x4a, 0x14, 0x93, 0xa9, 0x75, 0xcc, 0x0d, 0xa5, 0xd4, 0x6c,
+	0x6b, 0x22, 0xe8, 0x2c, 0xe5, 0x01, 0x6d, 0x07, 0x0c, 0x9f, 0x24, 0x3b, 0xd1, 0xd6, 0x61, 0x6a,
+	0xa2, 0x7b, 0x91, 0xe4, 0x47, 0x98, 0x8f, 0x6f, 0x2c, 0x95, 0xca, 0xc8, 0x6a, 0x5e, 0x69, 0x16,
+	0x02, 0x6f, 0x7c, 0x13, 0xd9, 0x8b, 0x76, 0x56, 0x88, 0xa0, 0x4d, 0x18, 0xf9, 0xbc, 0xc3, 0x99,
+	0x6f, 0x20, 0x8b, 0xe4, 0x32, 0xb4, 0x67, 0x04, 0xc2, 0xc3, 0x38, 0x60, 0x21, 0x13, 0x3a, 0x43,
+	0x9d, 0x22, 0xbb, 0xd0, 0xb6, 0x21, 0xeb, 0x34, 0x4d, 0x83, 0x48, 0x29, 0x43, 0x5f, 0x1a, 0xa1,
+	0x77, 0x22, 0xd9, 0xe6, 0xbe, 0xcf, 0x84, 0xa1, 0x0f, 0x46, 0x8c, 0xf0, 0x22, 0xd1, 0x09, 0xb8,
+	0xa7, 0x0d, 0xf9, 0x34, 0xd9, 0x83, 0x76, 0x54, 0xc8, 0xc6, 0x33, 0x8e, 0x7b, 0x6f, 0x26, 0x97,
+	0xa0, 0x5d, 0x15, 0x04, 0x17, 0xb3, 0x34, 0xe0, 0x7e, 0x1a, 0x53, 0x49, 0xad, 0xb5, 0xcb, 0xc3,
+	0x4a, 0x74, 0x78, 0xc0, 0x1c, 0x1e, 0x2b, 0x23, 0xa6, 0x7a, 0xd4, 0xeb, 0xb1, 0xb4, 0x23, 0xa3,
+	0x30, 0x8d, 0x93, 0x20, 0x30, 0x5c, 0x56, 0xc9, 0x6e, 0xb4, 0xbd, 0x82, 0xea, 0x32, 0x9d, 0xfa,
+	0xbc, 0x0b, 0x91, 0x02, 0x80, 0x33, 0x23, 0xb6, 0x88, 0x28, 0x55, 0x31, 0xf5, 0x98, 0x21, 0xff,
+	0x63, 0xe9, 0x73, 0xc9, 0xba, 0x5c, 0x69, 0x39, 0x3f, 0xcc, 0xe1, 0x96, 0x12, 0x92, 0xdf, 0xc0,
+	0x43, 0xbc, 0x9d, 0xc6, 0x41, 0xd2, 0xe5, 0xc2, 0x5e, 0xc2, 0x5b, 0xcb, 0x98, 0x00, 0x52, 0x57,
+	0x52, 0x3f, 0x60, 0x90, 0x00, 0x0c, 0x83, 0xbf, 0x2e, 0x0f, 0x1d, 0xa8, 0x21, 0x9d, 0x65, 0xa2,
+	0x20, 0xde, 0x46, 0x66, 0xd0, 0x7e, 0x2e, 0xb8, 0x2e, 0xd4, 0x63, 0x7a, 0x2e, 0x92, 0xfd, 0x34,
+	0xe0, 0x4a, 0x73, 0xd1, 0x4d, 0x8b, 0x4c, 0xa7, 0xf0, 0xdf, 0x90, 0x03, 0x68, 0x66, 0x1c, 0x36,
+	0xf7, 0x6e, 0x81, 0x4d, 0x05, 0x0d, 0x19, 0xfe, 0x5b, 0x72, 0x0d, 0xba, 0x7a, 0x1c, 0xbe, 0xc4,
+	0xf9, 0x11, 0x53, 0xc6, 0xe9, 0xec, 0x30, 0x57, 0x1a, 0xff, 0x1d, 0x38, 0xfd, 0xed, 0x24, 0x84,
+	0x91, 0xcf, 0xf0, 0xdf, 0x83, 0x47, 0xc6, 0xa1, 0x62, 0x2a, 0x95, 0xf5, 0xeb, 0x3f, 0x90, 0xdd,
+	0x68, 0x9b, 0x9b, 0x06, 0x78, 0x48, 0xbb, 0xac, 0x3c, 0xb7, 0x8f, 0x4e, 0x92, 0x4b, 0xd1, 0x2e,
+	0x17, 0x50, 0xea, 0xe4, 0x49, 0x46, 0xc1, 0x74, 0xfc, 0xb1, 0x49, 0x72, 0x09, 0xda, 0xe9, 0x82,
+	0x64, 0x22, 0x1c, 0x20, 0x30, 0xba, 0x67, 0x92, 0xec, 0x43, 0x7b, 0xc6, 0x33, 0xd2, 0x4c, 0x86,
+	0x5c, 0x50, 0xcd, 0x7c, 0xfc, 0xf1, 0x49, 0x72, 0x15, 0xda, 0xef, 0xc2, 0x6c, 0x82, 0x81, 0x5b,
+	0x93, 0xca, 0x28, 0x08, 0xa2, 0x44, 0xa7, 0x31, 0x13, 0x3e, 0xc8, 0xfd, 0xc4, 0xdb, 0xf0, 0x94,
+	0x4c, 0x69, 0x2a, 0x8d, 0x7a, 0x3f, 0x99, 0x24, 0xdb, 0xd0, 0x46, 0x17, 0x96, 0x88, 0x1e, 0xa3,
+	0x81, 0xee, 0xcd, 0xe3, 0x9f, 0xbe, 0x0d, 0x0b, 0x76, 0x98, 0x79, 0x59, 0x32, 0xf9, 0xd9, 0x88,
+	0x5a, 0x87, 0xa2, 0x76, 0xda, 0xa6, 0x5e, 0x3f, 0xea, 0x74, 0xd2, 0x80, 0x87, 0x1c, 0x4e, 0xc6,
+	0x63, 0xcc, 0x67, 0x3e, 0xfe, 0xf9, 0x24, 0xd9, 0x8f, 0xf6, 0x0e, 0x83, 0x7d, 0x46, 0xfd, 0x80,
+	0x0b, 0x56, 0xe2, 0x9e, 0x99, 0x24, 0x7b, 0xd1, 0x8e, 0x21, 0xd9, 0x3e, 0xd7, 0x3c, 0x12, 0x69,
+	0x87, 0xf2, 0x80, 0xf9, 0xf8, 0xd9, 0x11, 0xf5, 0x44, 0xe4, 0xb3, 0x34, 0x64, 0x61, 0x24, 0xe7,
+	0xd3, 0x58, 0x32, 0xa5, 0x12, 0xc9, 0xf0, 0xff, 0xd6, 0x86, 0x4f, 0xc9, 0xc0, 0x7c, 0xae, 0xfa,
+	0x25, 0xe8, 0xff, 0x6a, 0xe4, 0x4a, 0x74, 0xd9, 0x08, 0x28, 0x8f, 0x09, 0x37, 0x3b, 0xfe, 0x7f,
+	0x6d, 0xf8, 0x40, 0x0d, 0x34, 0xe6, 0x7e, 0xc9, 0xee, 0x1d, 0xe3, 0x65, 0x26, 0x02, 0xfe, 0xf2,
+	0x13, 0xcb, 0xe8, 0x9d, 0xb5, 0x61, 0x13, 0x33, 0x90, 0x64, 0xd4, 0xeb, 0x19, 0xc8, 0x1d, 0xb5,
+	0xe1, 0x10, 0xb4, 0x6a, 0x41, 0x82, 0x67, 0xd4, 0x9f, 0xc7, 0x77, 0x8e, 0x28, 0x63, 0x9d, 0x93,
+	0x66, 0x82, 0xe0, 0x88, 0xef, 0xaa, 0x91, 0xcb, 0xd1, 0x25, 0x2e, 0x26, 0xab, 0xd4, 0xe0, 0x52,
+	0xc1, 0x3c, 0xe3, 0x53, 0x08, 0xc3, 0x77, 0x8d, 0x68, 0x9d, 0x03, 0xc1, 0xb8, 0x3e, 0x0f, 0xc0,
+	0xeb, 0xef, 0x1e, 0xf1, 0x54, 0xc1, 0x2d, 0xe0, 0x10, 0x88, 0x1d, 0xa6, 0xbd, 0x9e, 0xe1, 0xf7,
+	0x9e, 0xda, 0xf0, 0x01, 0x39, 0xf1, 0x5a, 0xc2, 0xde, 0x3b, 0xe2, 0x87, 0x38, 0xf2, 0x53, 0xb8,
+	0x9a, 0x9c, 0x06, 0xfc, 0x08, 0x98, 0xf0, 0x70, 0x0d, 0xea, 0x6e, 0x9e, 0xb9, 0x6c, 0xd8, 0x3d,
+	0x5f, 0x1b, 0xae, 0xd2, 0x19, 0x1d, 0xbf, 0x50, 0x1b, 0x8e, 0xb1, 0x7c, 0x67, 0xf5, 0x00, 0x5e,
+	0xac, 0x91, 0x19, 0xb4, 0x6f, 0x7c, 0x7c, 0xcf, 0x51, 0x6e, 0x32, 0x57, 0xce, 0xf3, 0xa5, 0x1a,
+	0xd9, 0x85, 0xb6, 0x8e, 0xe3, 0xc9, 0x66, 0x99, 0xd0, 0xf8, 0x8d, 0x9a, 0x53, 0xf0, 0xf3, 0x4d,
+	0x2f, 0xd7, 0xa0, 0xe0, 0xab, 0x79, 0xe1, 0x15, 0x4b, 0xaf, 0xd4, 0xca, 0x0e, 0x22, 0x5f, 0x7b,
+	0xb5, 0x46, 0x36, 0xa0, 0x0b, 0x7d, 0x36, 0x6b, 0xd2, 0x51, 0xbe, 0xfa, 0x9a, 0x59, 0xf5, 0x02,
+	0x46, 0x45, 0x12, 0x17, 0xab, 0xaf, 0x1b, 0x96, 0x15, 0xe0, 0x9b, 0x35, 0xb2, 0x15, 0x6d, 0x18,
+	0xaa, 0xd7, 0x96, 0xf4, 0x56, 0xad, 0xe8, 0x38, 0xf2, 0xa5, 0xdb, 0xa7, 0x40, 0xd1, 0x59, 0x26,
+	0x79, 0xa7, 0x54, 0xf4, 0x9f, 0xa6, 0x40, 0x96, 0x51, 0xd4, 0xb0, 0xb6, 0x1e, 0x7e, 0x6a, 0x8a,
+	0xec, 0x41, 0xdb, 0x73, 0xbd, 0x6c, 0x69, 0x61, 0x32, 0x6b, 0x8b, 0x7d, 0x16, 0x2b, 0x7c, 0x5f,
+	0x1d, 0xe2, 0x73, 0x04, 0x61, 0x04, 0x1a, 0xc0, 0xfd, 0x75, 0x38, 0xdb, 0x11, 0x40, 0xe6, 0x27,
+	0x03, 0x79, 0xa0, 0x3e, 0x56, 0x0a, 0x54, 0x6b, 0xde, 0x05, 0x08, 0x7e, 0xb0, 0x4e, 0x2e, 0x43,
+	0xbb, 0x4b, 0xff, 0xa8, 0x24, 0x8e, 0x23, 0xa9, 0x99, 0x9f, 0xce, 0xfe, 0x69, 0xd9, 0xc7, 0x9e,
+	0xad, 0x0f, 0xdf, 0x15, 0xd3, 0xf0, 0x78, 0x54, 0x78, 0xcc, 0x44, 0xee, 0xfb, 0xa6, 0x87, 0xef,
+	0xca, 0x68, 0xda, 0x79, 0xff, 0x34, 0x38, 0xc2, 0x5a, 0x58, 0xee, 0xfc, 0xc0, 0x34, 0xd9, 0x88,
+	0x70, 0xa6, 0x74, 0xb9, 0xfc, 0xc1, 0x69, 0xb2, 0x1d, 0x6d, 0x1a, 0x2a, 0xef, 0x39, 0xf1, 0x43,
+	0xd3, 0x90, 0x58, 0x2b, 0xc4, 0x5c, 0x1c, 0xfe, 0xf0, 0x34, 0xd9, 0x89, 0xb6, 0x18, 0x6b, 0x4c,
+	0x9d, 0x60, 0xa9, 0xa6, 0xdd, 0x6e, 0xd1, 0x9d, 0xfd, 0x4b, 0x03, 0x2c, 0x31, 0xe4, 0xbc, 0x29,
+	0x4e, 0x63, 0x9a, 0x28, 0xdb, 0x19, 0x45, 0x12, 0xff, 0x6b, 0x03, 0x1c, 0x52, 0x05, 0x38, 0x4d,
+	0x5f, 0x86, 0xfa, 0xb7, 0x06, 0x84, 0xac, 0x2b, 0x25, 0x7f, 0x47, 0x59, 0xfa, 0xbf, 0x97, 0x62,
+	0x32, 0x7a, 0xd1, 0xed, 0x5b, 0xc0, 0x7f, 0x8c, 0x00, 0xf2, 0x83, 0xcd, 0x00, 0xff, 0xd9, 0x00,
+	0xbf, 0x58, 0x80, 0xe9, 0x6b, 0xec, 0xf2, 0x7f, 0x95, 0xea, 0x65, 0xfb, 0xe6, 0x28, 0x5c, 0x76,
+	0x2d, 0xb9, 0x63, 0xe5, 0x7f, 0x37, 0x20, 0xdb, 0xb8, 0x28, 0x28, 0x49, 0x1d, 0xea, 0xb9, 0x12,
+	0xfe, 0xa7, 0x01, 0x67, 0x96, 0x7b, 0x3e, 0x7b, 0x33, 0x0c, 0xa5, 0xad, 0x67, 0x1b, 0x90, 0x66,
+	0x8a, 0x90, 0x6a, 0x27, 0xdd, 0xb4, 0xc7, 0x82, 0xd8, 0xd4, 0x39, 0x2d, 0x39, 0x9b, 0xb5, 0xd5,
+	0xfc, 0x17, 0x0d, 0xb2, 0x19, 0x91, 0x82, 0x95, 0xbd, 0x56, 0x40, 0xf8, 0x65, 0x03, 0x4e, 0x23,
+	0x23, 0x98, 0xa7, 0x12, 0x8d, 0xe3, 0x60, 0x3e, 0x0d, 0x68, 0x9b, 0x05, 0x0a, 0x3f, 0xd7, 0x80,
+	0xeb, 0xe5, 0x92, 0xf3, 0x46, 0x1a, 0xff, 0xca, 0xdd, 0x29, 0xa2, 0x34, 0x04, 0x33, 0xe1, 0x00,
+	0x8c, 0xa3, 0xf1, 0xaf, 0x1b, 0x64, 0x07, 0xda, 0xec, 0xee, 0x9c, 0x65, 0x52, 0xe5, 0x6a, 0xff,
+	0xa6, 0x61, 0xe3, 0xbe, 0xa4, 0x86, 0x5c, 0x54, 0x10, 0xbf, 0x6d, 0xd8, 0xdb, 0x65, 0x10, 0x79,
+	0x96, 0x75, 0x01, 0x4f, 0x34, 0xed, 0xc5, 0xa8, 0x00, 0xa2, 0x4e, 0xc7, 0xc4, 0x34, 0x74, 0x39,
+	0x06, 0xf5, 0xbb, 0x86, 0x83, 0x62, 0xb2, 0xcc, 0x6d, 0x9d, 0x08, 0x62, 0x32, 0x60, 0xe0, 0x49,
+	0xfc, 0x7b, 0xd7, 0x16, 0x28, 0x2e, 0xc5, 0xcd, 0x32, 0x4c, 0x9e, 0x77, 0x99, 0x18, 0xb2, 0x64,
+	0x61, 0xa4, 0x59, 0x15, 0xf5, 0x82, 0xcb, 0x04, 0x9a, 0xbf, 0x2a, 0xf9, 0x45, 0xd7, 0x21, 0xb9,
+	0xbe, 0x85, 0x37, 0x5f, 0x32, 0xf1, 0x5a, 0x50, 0xf3, 0xa7, 0x6c, 0x41, 0x7f, 0xb9, 0xaa, 0x61,
+	0x1c, 0x50, 0x8f, 0x65, 0x2d, 0x19, 0x90, 0x5f, 0x71, 0x43, 0x45, 0x4b, 0x2a, 0x54, 0x27, 0x92,
+	0x61, 0x55, 0x81, 0x57, 0xdd, 0xb3, 0x54, 0x4c, 0xdb, 0x33, 0x36, 0xa4, 0xd7, 0x5c, 0xe9, 0xc5,
+	0xa6, 0x39, 0xc9, 0xb5, 0x65, 0xff, 0xba, 0x1b, 0x65, 0xb6, 0x47, 0x2c, 0x50, 0x46, 0x09, 0xfb,
+	0x2c, 0x79, 0xa3, 0x41, 0xae, 0x40, 0x97, 0xba, 0xa7, 0x9a, 0x05, 0xb7, 0xb0, 0x2d, 0x6a, 0xd9,
+	0x47, 0xbc, 0xd9, 0x80, 0xb2, 0x3c, 0x14, 0xda, 0x5c, 0x68, 0x26, 0x05, 0x0d, 0xdc, 0x27, 0xd5,
+	0x5b, 0x15, 0xaf, 0xc5, 0xda, 0xbc, 0x31, 0xf2, 0x34, 0x8d, 0x6f, 0x6f, 0x82, 0x49, 0x36, 0xc5,
+	0xab, 0x32, 0x6f, 0x02, 0xe9, 0xd1, 0x26, 0xd9, 0x84, 0x2e, 0x32, 0x24, 0x2f, 0x27, 0xc3, 0xfa,
+	0x63, 0xe5, 0x3a, 0x0f, 0xbb, 0x65, 0x3f, 0xfb, 0x78, 0x13, 0x5c, 0x60, 0xf1, 0xc6, 0xfd, 0xa9,
+	0x17, 0xfa, 0x4e, 0x3f, 0xfc, 0xed, 0x26, 0xd4, 0xcb, 0x61, 0xba, 0x4c, 0x44, 0x2a, 0x22, 0x91,
+	0x1e, 0x61, 0x32, 0x82, 0x0e, 0xdc, 0xaa, 0xf5, 0x9d, 0x26, 0xb8, 0x6b, 0x1c, 0x56, 0xf3, 0x90,
+	0xf9, 0x51, 0x62, 0x61, 0xdf, 0x6d, 0x42, 0xa9, 0x1e, 0x07, 0x2b, 0x32, 0xa9, 0xc1, 0x7d, 0x6f,
+	0x4d, 0x1c, 0x34, 0xa2, 0x49, 0x91, 0x0b, 0xbe, 0xdf, 0x84, 0xa4, 0x32, 0x1e, 0xc7, 0xf3, 0xa7,
+	0xe5, 0x0f, 0x9a, 0xe0, 0xd0, 0xb1, 0x20, 0x29, 0xf1, 0x0f, 0x47, 0x34, 0xf7, 0x19, 0x34, 0xd5,
+	0x4c, 0x78, 0x9c, 0x29, 0x03, 0x05, 0xd8, 0x93, 0x4d, 0x72, 0x35, 0xba, 0x7c, 0x4d, 0x58, 0x22,
+	0x42, 0x2a, 0x55, 0x8f, 0x66, 0xae, 0x7d, 0xaa, 0x09, 0x75, 0x70, 0x44, 0xa4, 0x9b, 0x9f, 0x9e,
+	0x36, 0x5a, 0x65, 0x73, 0x84, 0x91, 0x63, 0xfe, 0xf1, 0x3a, 0xb8, 0x7f, 0x23, 0x54, 0xfb, 0xa0,
+	0x99, 0xa7, 0xa1, 0x15, 0xf3, 0x0a, 0x02, 0x37, 0xad, 0x81, 0x82, 0xa2, 0x17, 0x52, 0x7b, 0x0f,
+	0x10, 0x64, 0x96, 0xac, 0x09, 0xb0, 0x5a, 0x85, 0x7e, 0xde, 0x3a, 0x02, 0xe0, 0xec, 0x7a, 0xb8,
+	0x6b, 0x2e, 0xa0, 0x72, 0x6c, 0x0f, 0xad, 0x1f, 0xde, 0x0f, 0x5d, 0xbc, 0xb3, 0xff, 0xe1, 0xf5,
+	0x60, 0x4c, 0x16, 0x8b, 0x46, 0x0b, 0x08, 0xa3, 0xac, 0x36, 0xe1, 0x4f, 0xb6, 0x20, 0xd0, 0x5c,
+	0x6a, 0xf1, 0x64, 0x36, 0xf4, 0x4f, 0xb5, 0xc0, 0xd8, 0xb2, 0x03, 0xb0, 0x6e, 0x9d, 0x1f, 0x42,
+	0x7d, 0xba, 0x05, 0x9d, 0x68, 0x8e, 0x4a, 0xe2, 0x80, 0x7b, 0xe6, 0xa2, 0xd1, 0x90, 0xa9, 0x54,
+	0xd1, 0x90, 0x59, 0xd6, 0x00, 0xfd, 0x4c, 0x0b, 0x0e, 0x6b, 0x0d, 0x28, 0xf5, 0x24, 0x0c, 0x15,
+	0x00, 0x6c, 0xef, 0xf0, 0x67, 0x5b, 0x50, 0xba, 0x33, 0x74, 0x9b, 0xfa, 0x40, 0xd2, 0xd9, 0xdd,
+	0xf9, 0x9c, 0x4b, 0x33, 0x21, 0x5f, 0x2a, 0xf4, 0x79, 0xd7, 0x2c, 0x5b, 0x43, 0x62, 0x19, 0x95,
+	0x7c, 0xbf, 0xe0, 0xd2, 0x7d, 0xd6, 0xa1, 0x49, 0xa0, 0xd3, 0x59, 0x1a, 0x24, 0x19, 0xfd, 0x8b,
+	0x2d, 0xc8, 0x08, 0x55, 0xa7, 0xe9, 0x9e, 0x4a, 0x55, 0xd2, 0x56, 0x9a, 0xeb, 0x32, 0xca, 0xbf,
+	0xd4, 0x22, 0x7f, 0x82, 0xae, 0xc8, 0x80, 0x61, 0x12, 0x68, 0x0e, 0xe3, 0x93, 0x48, 0xea, 0x5c,
+	0x5e, 0x75, 0xd6, 0xf1, 0xe5, 0x16, 0x64, 0xc6, 0x0c, 0x5e, 0x68, 0x54, 0x75, 0xe6, 0xbd, 0x2d,
+	0xb8, 0x38, 0x19, 0x26, 0x6f, 0x6d, 0x69, 0xcc, 0x2b, 0xf5, 0xe6, 0xbe, 0x16, 0x44, 0xc5, 0x10,
+	0xc8, 0x04, 0x17, 0xd5, 0x91, 0xc4, 0xf7, 0xb7, 0x20, 0x2a, 0x86, 0xc8, 0x45, 0x26, 0x66, 0x12,
+	0x3f, 0xd0, 0x82, 0xab, 0x95, 0xeb, 0xcd, 0x95, 0x09, 0x88, 0x22, 0x8b, 0x16, 0xa7, 0xf5, 0x60,
+	0x0b, 0x1e, 0x94, 0x19, 0x2c, 0x2b, 0x33, 0x92, 0xc5, 0x51, 0xd6, 0x64, 0x54, 0x15, 0x3f, 0xdb,
+	0x82, 0x67, 0x00, 0x17, 0x2a, 0x66, 0x5e, 0xd1, 0xe5, 0x1a, 0xca, 0x1d, 0x08, 0xe2, 0x23, 0xa7,
+	0xd8, 0x4e, 0x8c, 0x89, 0xd9, 0x94, 0x06, 0xe6, 0xed, 0x64, 0xa7, 0x05, 0xd6, 0xf3, 0x77, 0xae,
+	0x01, 0xe5, 0xc2, 0x8b, 0xa4, 0x84, 0x35, 0x18, 0x91, 0x18, 0xe8, 0x5d, 0x08, 0x9c, 0x99, 0x43,
+	0x73, 0x7f, 0x57, 0x75, 0xba, 0x1b, 0xcd, 0x9c, 0xc3, 0xe8, 0x02, 0x75, 0xe6, 0xc4, 0x89, 0x85,
+	0x95, 0xd5, 0x93, 0x83, 0x25, 0x33, 0x5b, 0x6c, 0xa0, 0x9a, 0xe0, 0x01, 0x3e, 0x0f, 0x26, 0x72,
+	0xd4, 0xf7, 0x8b, 0x08, 0x00, 0xd3, 0xf0, 0x31, 0x98, 0xdf, 0xe6, 0x5d, 0xa9, 0xb3, 0xbe, 0x00,
+	0xc3, 0x8c, 0xd1, 0xf5, 0xb4, 0x1b, 0x44, 0x6d, 0x1a, 0x64, 0xd9, 0x1e, 0x1f, 0x87, 0x69, 0x56,
+	0xd7, 0x0b, 0xa2, 0xa4, 0x68, 0x36, 0x61, 0x60, 0x97, 0x91, 0xe1, 0x45, 0x7a, 0x02, 0xa6, 0xba,
+	0xe3, 0x49, 0x37, 0xc0, 0x5c, 0xd6, 0x8a, 0xc8, 0x58, 0x64, 0xb3, 0x46, 0x7c, 0xb2, 0xa4, 0x64,
+	0x5b, 0xf3, 0xb1, 0xe2, 0x8d, 0xa0, 0x6e, 0x87, 0x1f, 0xb6, 0x45, 0x25, 0x73, 0x98, 0x19, 0xff,
+	0x6d, 0x42, 0x24, 0xc3, 0xda, 0x55, 0xc9, 0xb4, 0x9c, 0xc7, 0x8b, 0x30, 0x4c, 0x03, 0xbc, 0x33,
+	0xff, 0x2a, 0xda, 0xbd, 0xcc, 0x88, 0x53, 0x39, 0x46, 0xf5, 0x69, 0xa7, 0x13, 0x05, 0x7e, 0xf1,
+	0x06, 0x28, 0x46, 0x6b, 0x78, 0x09, 0x0c, 0x05, 0x8c, 0x33, 0xbd, 0xca, 0x2d, 0xa1, 0xa6, 0x8f,
+	0x19, 0x90, 0x7d, 0x68, 0x2f, 0x20, 0xd6, 0x1c, 0x17, 0x99, 0xb1, 0xd2, 0x69, 0x18, 0x59, 0x55,
+	0x4c, 0x1b, 0x05, 0xe6, 0xc6, 0xde, 0x4c, 0xfe, 0x12, 0x5d, 0x37, 0x86, 0xa5, 0xe9, 0xb0, 0xe6,
+	0x7a, 0x0c, 0x8a, 0x8b, 0x96, 0xd4, 0xab, 0x8e, 0xba, 0xac, 0x9c, 0x65, 0x38, 0x6d, 0x28, 0x2d,
+	0xd9, 0xde, 0x58, 0x26, 0x82, 0xe1, 0x15, 0x58, 0x55, 0x4c, 0x17, 0x7d, 0x68, 0x27, 0xa0, 0x5d,
+	0xbc, 0x6a, 0x6e, 0x97, 0xd1, 0x69, 0xb4, 0xd5, 0x85, 0x39, 0x33, 0x64, 0x1e, 0x43, 0x2e, 0xba,
+	0x7e, 0xfb, 0x9a, 0xc9, 0x26, 0xcb, 0x5c, 0x28, 0x0d, 0x05, 0xc7, 0x4c, 0xe8, 0x1f, 0x31, 0x4b,
+	0x49, 0x0c, 0x73, 0x3c, 0x66, 0x97, 0xce, 0x4d, 0x90, 0x6b, 0xd0, 0x55, 0xe3, 0x3c, 0x6c, 0xbb,
+	0xde, 0xfc, 0x3c, 0xa2, 0x59, 0x26, 0x25, 0xf7, 0x99, 0xc2, 0x8f, 0x9a, 0x31, 0xb6, 0xcb, 0xe4,
+	0xda, 0x3f, 0xc3, 0x8f, 0x4d, 0x90, 0x03, 0xe8, 0xca, 0x35, 0xd9, 0xe4, 0xfd, 0x0e, 0xe4, 0x56,
+	0x18, 0x51, 0xe2, 0xc7, 0x27, 0xe0, 0x4d, 0x95, 0x2b, 0x97, 0xff, 0x4a, 0xf0, 0xa3, 0x09, 0xe8,
+	0x5b, 0x86, 0x9f, 0xdd, 0x41, 0xd4, 0x55, 0x30, 0x32, 0x2b, 0x2c, 0x85, 0xab, 0xca, 0x05, 0x53,
+	0x0a, 0x82, 0xb2, 0xcd, 0xf0, 0x3d, 0x0e, 0xad, 0xdc, 0x66, 0x1a, 0x30, 0x98, 0x8f, 0xed, 0x45,
+	0x3b, 0xa8, 0xef, 0x4b, 0xc0, 0xaf, 0x35, 0x0c, 0xda, 0x8d, 0xb6, 0x55, 0x20, 0x23, 0x83, 0xa0,
+	0x7d, 0x68, 0x4f, 0x05, 0xb0, 0xc6, 0x10, 0x68, 0x17, 0xda, 0x5a, 0x81, 0x0d, 0x0f, 0x80, 0x86,
+	0xe5, 0x8c, 0x0c, 0x7f, 0x76, 0xa2, 0x2d, 0x43, 0x80, 0xca, 0xe0, 0x67, 0x3b, 0xda, 0x54, 0x55,
+	0xc3, 0x1d, 0xfa, 0x38, 0xc2, 0xc7, 0x0e, 0x7c, 0x0a, 0x1f, 0xf5, 0x22, 0xa5, 0xdd, 0x28, 0xba,
+	0xdb, 0xcc, 0x29, 0xcc, 0xf8, 0xaf, 0x88, 0x22, 0x18, 0x98, 0x6c, 0x44, 0x38, 0x11, 0xe6, 0x91,
+	0x59, 0x2e, 0xbf, 0x68, 0x26, 0x10, 0x6e, 0xf0, 0x26, 0x41, 0x80, 0x3f, 0x32, 0x65, 0x9e, 0xd1,
+	0x4c, 0xe7, 0xbf, 0x45, 0x41, 0xec, 0x16, 0xaf, 0x8e, 0x0e, 0x0d, 0x14, 0xc3, 0x4f, 0x4e, 0x41,
+	0x52, 0xce, 0x1b, 0x90, 0x90, 0x8a, 0x84, 0x06, 0xa6, 0x97, 0x81, 0x99, 0xc2, 0x66, 0x44, 0x72,
+	0x8a, 0xd5, 0x11, 0xda, 0x17, 0xfc, 0xf4, 0x14, 0x58, 0x9c, 0x05, 0x92, 0x5d, 0x2f, 0x4a, 0x20,
+	0xbe, 0xb7, 0xee, 0x54, 0xc6, 0x62, 0x94, 0x94, 0xb7, 0x05, 0x3e, 0xeb, 0x00, 0x6f, 0x30, 0xef,
+	0xbe, 0x3a, 0x08, 0x2e, 0x80, 0x54, 0x74, 0xb3, 0x48, 0xc4, 0xf7, 0x57, 0x29, 0x19, 0x7f, 0x0d,
+	0x55, 0xa9, 0xee, 0x74, 0x1b, 0x96, 0x92, 0xa7, 0x75, 0x87, 0xf3, 0x83, 0x75, 0x72, 0x10, 0xcd,
+	0xac, 0xa5, 0x42, 0x51, 0x55, 0x15, 0x0b, 0x32, 0x4f, 0x9f, 0xad, 0x3b, 0x15, 0xb5, 0xca, 0xb6,
+	0x04, 0x3d, 0x54, 0x77, 0xac, 0x86, 0x2b, 0xe5, 0x94, 0x5c, 0xfc, 0xb0, 0x99, 0x8c, 0x64, 0x44,
+	0x1a, 0x04, 0xd1, 0x9c, 0x79, 0x52, 0x15, 0x05, 0x57, 0xe1, 0xaf, 0xd4, 0x9d, 0xca, 0x5e, 0x22,
+	0x56, 0x97, 0x8f, 0x2e, 0xad, 0x1c, 0x1f, 0x2c, 0x9f, 0x5a, 0x58, 0x5e, 0xc1, 0x5f, 0xad, 0x3b,
+	0x45, 0x17, 0x44, 0x8c, 0x2d, 0xbc, 0xf8, 0x6b, 0xae, 0xba, 0x4c, 0x40, 0x14, 0x56, 0x6a, 0x2f,
+	0x0c, 0x87, 0xf0, 0xd7, 0xeb, 0xd0, 0x5f, 0x16, 0xc5, 0x56, 0x31, 0xfb, 0xcb, 0xce, 0x7c, 0x1a,
+	0xc9, 0x54, 0xb0, 0xb9, 0xdc, 0x40, 0x18, 0xb5, 0x98, 0xd1, 0x43, 0x89, 0x83, 0x82, 0x57, 0xd4,
+	0x55, 0x18, 0xb3, 0xec, 0x41, 0xdb, 0x73, 0xba, 0x75, 0x0e, 0x17, 0x71, 0x52, 0x14, 0x54, 0x18,
+	0xb9, 0x5c, 0x88, 0x50, 0x14, 0x33, 0x91, 0x72, 0xa5, 0x12, 0x86, 0xff, 0xb9, 0xe1, 0xe4, 0x8b,
+	0xac, 0x85, 0x8e, 0xc2, 0x90, 0x0a, 0x1f, 0x3f, 0x63, 0xde, 0xc9, 0xa6, 0xba, 0x54, 0x08, 0xa6,
+	0x67, 0x8d, 0x12, 0x0d, 0x13, 0x82, 0x19, 0xb4, 0x6f, 0xdc, 0xde, 0x91, 0xde, 0x1e, 0xc6, 0x04,
+	0xd0, 0x2a, 0xfe, 0x51, 0xac, 0x69, 0xcd, 0x60, 0x76, 0xb0, 0x1f, 0xed, 0xb5, 0x68, 0xdb, 0x47,
+	0x67, 0x58, 0xf8, 0x67, 0xdf, 0xa6, 0xa6, 0x0c, 0x3c, 0xd7, 0x28, 0x73, 0xbb, 0xdb, 0x36, 0x47,
+	0x89, 0x8e, 0x13, 0x8d, 0xcf, 0x99, 0xde, 0x1f, 0x2c, 0x18, 0x6e, 0xb9, 0x41, 0xfd, 0x47, 0x9b,
+	0xed, 0xeb, 0x8e, 0xfc, 0xf9, 0x89, 0x93, 0xab, 0x37, 0x9c, 0xb9, 0xfe, 0xc0, 0x5f, 0x0d, 0x4e,
+	0x1d, 0xec, 0x0e, 0x06, 0x27, 0x16, 0x17, 0xbc, 0xc1, 0xd2, 0xea, 0xd1, 0x93, 0x4b, 0x0b, 0xcb,
+	0x7a, 0x30, 0x58, 0x5c, 0x39, 0xb8, 0x72, 0xd3, 0xd1, 0xe3, 0xc7, 0x07, 0x8b, 0xc7, 0x0e, 0x9a,
+	0xdf, 0xd4, 0x0f, 0x9a, 0xdf, 0xd4, 0xaf, 0x9f, 0x36, 0x7f, 0x5c, 0xfb, 0x87, 0x01, 0x00, 0x50,
+	0xcc, 0xbc, 0xf0, 0x76, 0x1f, 0x00, 0x00,
 }
@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -159,6 +160,9 @@ const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.Statu
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR)
+const StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED)
+const StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED)
+const StatusCode_STATUSCHECK_CONDITION_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_CONDITION_FAILED)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
 const StatusCode_STATUSCHECK_NODE_DISK_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE)
 const StatusCode_STATUSCHECK_NODE_NETWORK_UNAVAILABLE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_NETWORK_UNAVAILABLE)
@@ -185,6 +189,7 @@ const StatusCode_CLEANUP_UNKNOWN = StatusCode(enums.StatusCode_CLEANUP_UNKNOWN)
 const StatusCode_INIT_UNKNOWN = StatusCode(enums.StatusCode_INIT_UNKNOWN)
 const StatusCode_BUILD_DOCKER_UNKNOWN = StatusCode(enums.StatusCode_BUILD_DOCKER_UNKNOWN)
 const StatusCode_TEST_UNKNOWN = StatusCode(enums.StatusCode_TEST_UNKNOWN)
+const StatusCode_VERIFY_UNKNOWN = StatusCode(enums.StatusCode_VERIFY_UNKNOWN)
 const StatusCode_SYNC_INIT_ERROR = StatusCode(enums.StatusCode_SYNC_INIT_ERROR)
 const StatusCode_DEVINIT_REGISTER_BUILD_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_BUILD_DEPS)
 const StatusCode_DEVINIT_REGISTER_TEST_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_TEST_DEPS)
@@ -246,6 +251,9 @@ const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST
 const StatusCode_RENDER_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INIT_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR)
+const StatusCode_VERIFY_TEST_CMD_FAILED_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_CMD_FAILED_ERR)
+const StatusCode_VERIFY_TEST_TIMEDOUT_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_TIMEDOUT_ERR)
+const StatusCode_VERIFY_TEST_JOB_FAILED_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_JOB_FAILED_ERR)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -339,6 +347,8 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_VERIFY_TEST_OUTPUT = SuggestionCode(enums.SuggestionCode_CHECK_VERIFY_TEST_OUTPUT)
+const SuggestionCode_FIX_VERIFY_TEST_TIMEOUT = SuggestionCode(enums.SuggestionCode_FIX_VERIFY_TEST_TIMEOUT)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
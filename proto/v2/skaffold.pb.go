@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -159,6 +160,9 @@ const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.Statu
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR)
+const StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT_EXCEEDED)
+const StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_DEADLINE_EXCEEDED)
+const StatusCode_STATUSCHECK_CONDITION_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_CONDITION_FAILED)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
 const StatusCode_STATUSCHECK_NODE_DISK_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE)
 const StatusCode_STATUSCHECK_NODE_NETWORK_UNAVAILABLE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_NETWORK_UNAVAILABLE)
@@ -185,6 +189,7 @@ const StatusCode_CLEANUP_UNKNOWN = StatusCode(enums.StatusCode_CLEANUP_UNKNOWN)
 const StatusCode_INIT_UNKNOWN = StatusCode(enums.StatusCode_INIT_UNKNOWN)
 const StatusCode_BUILD_DOCKER_UNKNOWN = StatusCode(enums.StatusCode_BUILD_DOCKER_UNKNOWN)
 const StatusCode_TEST_UNKNOWN = StatusCode(enums.StatusCode_TEST_UNKNOWN)
+const StatusCode_VERIFY_UNKNOWN = StatusCode(enums.StatusCode_VERIFY_UNKNOWN)
 const StatusCode_SYNC_INIT_ERROR = StatusCode(enums.StatusCode_SYNC_INIT_ERROR)
 const StatusCode_DEVINIT_REGISTER_BUILD_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_BUILD_DEPS)
 const StatusCode_DEVINIT_REGISTER_TEST_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_TEST_DEPS)
@@ -246,6 +251,9 @@ const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST
 const StatusCode_RENDER_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INIT_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR)
+const StatusCode_VERIFY_TEST_CMD_FAILED_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_CMD_FAILED_ERR)
+const StatusCode_VERIFY_TEST_TIMEDOUT_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_TIMEDOUT_ERR)
+const StatusCode_VERIFY_TEST_JOB_FAILED_ERR = StatusCode(enums.StatusCode_VERIFY_TEST_JOB_FAILED_ERR)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -339,6 +347,8 @@ const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.Suggestio
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
 const SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_CHECK_TEST_COMMAND_AND_IMAGE_NAME)
+const SuggestionCode_CHECK_VERIFY_TEST_OUTPUT = SuggestionCode(enums.SuggestionCode_CHECK_VERIFY_TEST_OUTPUT)
+const SuggestionCode_FIX_VERIFY_TEST_TIMEOUT = SuggestionCode(enums.SuggestionCode_FIX_VERIFY_TEST_TIMEOUT)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -1376,6 +1386,7 @@ type Event struct {
 	//	*Event_TerminationEvent
 	//	*Event_TestEvent
 	//	*Event_RenderEvent
+	//	*Event_VerifyEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1470,6 +1481,10 @@ type Event_RenderEvent struct {
 	RenderEvent *RenderSubtaskEvent `protobuf:"bytes,14,opt,name=renderEvent,proto3,oneof"`
 }
 
+type Event_VerifyEvent struct {
+	VerifyEvent *VerifySubtaskEvent `protobuf:"bytes,15,opt,name=verifyEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_SkaffoldLogEvent) isEvent_EventType() {}
@@ -1496,6 +1511,8 @@ func (*Event_TestEvent) isEvent_EventType() {}
 
 func (*Event_RenderEvent) isEvent_EventType() {}
 
+func (*Event_VerifyEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1594,6 +1611,13 @@ func (m *Event) GetRenderEvent() *RenderSubtaskEvent {
 	return nil
 }
 
+func (m *Event) GetVerifyEvent() *VerifySubtaskEvent {
+	if x, ok := m.GetEventType().(*Event_VerifyEvent); ok {
+		return x.VerifyEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1610,6 +1634,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_TerminationEvent)(nil),
 		(*Event_TestEvent)(nil),
 		(*Event_RenderEvent)(nil),
+		(*Event_VerifyEvent)(nil),
 	}
 }
 
@@ -1992,6 +2017,7 @@ type BuildSubtaskEvent struct {
 	Step                 string         `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
 	Status               string         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	Reason               string         `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2064,6 +2090,13 @@ func (m *BuildSubtaskEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *BuildSubtaskEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // `TestSubtaskEvent` represents the status of a test, and is emitted by Skaffold
 // anytime a test starts or completes, successfully or not.
 type TestSubtaskEvent struct {
@@ -2194,6 +2227,79 @@ func (m *RenderSubtaskEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+// `VerifySubtaskEvent` represents the status of a verify test case, and is emitted by Skaffold
+// anytime a verify test case starts or completes, successfully or not.
+type VerifySubtaskEvent struct {
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId               string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status               string         `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,5,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VerifySubtaskEvent) Reset()         { *m = VerifySubtaskEvent{} }
+func (m *VerifySubtaskEvent) String() string { return proto.CompactTextString(m) }
+func (*VerifySubtaskEvent) ProtoMessage()    {}
+func (*VerifySubtaskEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{25}
+}
+
+func (m *VerifySubtaskEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySubtaskEvent.Unmarshal(m, b)
+}
+func (m *VerifySubtaskEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySubtaskEvent.Marshal(b, m, deterministic)
+}
+func (m *VerifySubtaskEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySubtaskEvent.Merge(m, src)
+}
+func (m *VerifySubtaskEvent) XXX_Size() int {
+	return xxx_messageInfo_VerifySubtaskEvent.Size(m)
+}
+func (m *VerifySubtaskEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySubtaskEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySubtaskEvent proto.InternalMessageInfo
+
+func (m *VerifySubtaskEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VerifySubtaskEvent) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *VerifySubtaskEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VerifySubtaskEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *VerifySubtaskEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // `DeploySubtaskEvent` represents the status of a deployment, and is emitted by Skaffold
 // anytime a deployment starts or completes, successfully or not.
 type DeploySubtaskEvent struct {
@@ -2210,7 +2316,7 @@ func (m *DeploySubtaskEvent) Reset()         { *m = DeploySubtaskEvent{} }
 func (m *DeploySubtaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DeploySubtaskEvent) ProtoMessage()    {}
 func (*DeploySubtaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{25}
+	return fileDescriptor_39088757fd9c8e40, []int{26}
 }
 
 func (m *DeploySubtaskEvent) XXX_Unmarshal(b []byte) error {
@@ -2281,7 +2387,7 @@ func (m *StatusCheckSubtaskEvent) Reset()         { *m = StatusCheckSubtaskEvent
 func (m *StatusCheckSubtaskEvent) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckSubtaskEvent) ProtoMessage()    {}
 func (*StatusCheckSubtaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{26}
+	return fileDescriptor_39088757fd9c8e40, []int{27}
 }
 
 func (m *StatusCheckSubtaskEvent) XXX_Unmarshal(b []byte) error {
@@ -2353,27 +2459,31 @@ func (m *StatusCheckSubtaskEvent) GetActionableErr() *ActionableErr {
 
 // PortForwardEvent Event describes each port forwarding event.
 type PortForwardEvent struct {
-	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId               string       `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	LocalPort            int32        `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
-	PodName              string       `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
-	ContainerName        string       `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
-	Namespace            string       `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PortName             string       `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
-	ResourceType         string       `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
-	ResourceName         string       `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
-	Address              string       `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
-	TargetPort           *IntOrString `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId               string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	LocalPort            int32          `protobuf:"varint,3,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	PodName              string         `protobuf:"bytes,4,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string         `protobuf:"bytes,5,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string         `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string         `protobuf:"bytes,7,opt,name=portName,proto3" json:"portName,omitempty"`
+	ResourceType         string         `protobuf:"bytes,8,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	ResourceName         string         `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Address              string         `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	TargetPort           *IntOrString   `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	Status               string         `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string         `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,14,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	ReservedPort         int32          `protobuf:"varint,15,opt,name=reservedPort,proto3" json:"reservedPort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *PortForwardEvent) Reset()         { *m = PortForwardEvent{} }
 func (m *PortForwardEvent) String() string { return proto.CompactTextString(m) }
 func (*PortForwardEvent) ProtoMessage()    {}
 func (*PortForwardEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{27}
+	return fileDescriptor_39088757fd9c8e40, []int{28}
 }
 
 func (m *PortForwardEvent) XXX_Unmarshal(b []byte) error {
@@ -2471,6 +2581,34 @@ func (m *PortForwardEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortForwardEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PortForwardEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PortForwardEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
+func (m *PortForwardEvent) GetReservedPort() int32 {
+	if m != nil {
+		return m.ReservedPort
+	}
+	return 0
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2488,7 +2626,7 @@ func (m *FileSyncEvent) Reset()         { *m = FileSyncEvent{} }
 func (m *FileSyncEvent) String() string { return proto.CompactTextString(m) }
 func (*FileSyncEvent) ProtoMessage()    {}
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{28}
+	return fileDescriptor_39088757fd9c8e40, []int{29}
 }
 
 func (m *FileSyncEvent) XXX_Unmarshal(b []byte) error {
@@ -2572,7 +2710,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{29}
+	return fileDescriptor_39088757fd9c8e40, []int{30}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2674,7 +2812,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{30}
+	return fileDescriptor_39088757fd9c8e40, []int{31}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2713,7 +2851,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{31}
+	return fileDescriptor_39088757fd9c8e40, []int{32}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2755,7 +2893,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{32}
+	return fileDescriptor_39088757fd9c8e40, []int{33}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2821,7 +2959,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{33}
+	return fileDescriptor_39088757fd9c8e40, []int{34}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2876,7 +3014,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{34}
+	return fileDescriptor_39088757fd9c8e40, []int{35}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2925,7 +3063,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{35}
+	return fileDescriptor_39088757fd9c8e40, []int{36}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -3002,6 +3140,7 @@ func init() {
 	proto.RegisterType((*BuildSubtaskEvent)(nil), "proto.v2.BuildSubtaskEvent")
 	proto.RegisterType((*TestSubtaskEvent)(nil), "proto.v2.TestSubtaskEvent")
 	proto.RegisterType((*RenderSubtaskEvent)(nil), "proto.v2.RenderSubtaskEvent")
+	proto.RegisterType((*VerifySubtaskEvent)(nil), "proto.v2.VerifySubtaskEvent")
 	proto.RegisterType((*DeploySubtaskEvent)(nil), "proto.v2.DeploySubtaskEvent")
 	proto.RegisterType((*StatusCheckSubtaskEvent)(nil), "proto.v2.StatusCheckSubtaskEvent")
 	proto.RegisterType((*PortForwardEvent)(nil), "proto.v2.PortForwardEvent")
@@ -3019,155 +3158,159 @@ func init() {
 func init() { proto.RegisterFile("v2/skaffold.proto", fileDescriptor_39088757fd9c8e40) }
 
 var fileDescriptor_39088757fd9c8e40 = []byte{
-	// 2353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0xf6, 0x90, 0x1a, 0x92, 0x53, 0x94, 0x68, 0xa9, 0x65, 0x5b, 0x0c, 0x2d, 0x7b, 0xed, 0xd9,
-	0xdd, 0xc4, 0xfb, 0x22, 0x6d, 0x3a, 0x59, 0x2f, 0x8c, 0x78, 0x37, 0xf2, 0x53, 0x5a, 0xbf, 0xd6,
-	0x2d, 0xed, 0x02, 0x79, 0x6c, 0x8c, 0xd1, 0x4c, 0x8b, 0x1e, 0x88, 0x9c, 0x61, 0x66, 0x9a, 0x5a,
-	0xf3, 0x16, 0xe4, 0x10, 0xe4, 0x90, 0x53, 0xb2, 0x40, 0x80, 0x9c, 0xf6, 0x47, 0xe4, 0x96, 0x63,
-	0x80, 0xfc, 0x81, 0x00, 0xf9, 0x01, 0x41, 0x0e, 0x41, 0x90, 0x73, 0xce, 0x41, 0xbf, 0x66, 0xba,
-	0x67, 0x48, 0x4b, 0xb2, 0x63, 0x24, 0x17, 0x69, 0xba, 0xfb, 0xab, 0xea, 0xea, 0xea, 0xaf, 0xab,
-	0xab, 0x9a, 0xb0, 0x72, 0xd0, 0xef, 0xa5, 0xfb, 0xde, 0xde, 0x5e, 0x3c, 0x0c, 0xba, 0xe3, 0x24,
-	0xa6, 0x31, 0x6a, 0xf0, 0x7f, 0xdd, 0x83, 0x7e, 0x67, 0x7d, 0x10, 0xc7, 0x83, 0x21, 0xe9, 0x79,
-	0xe3, 0xb0, 0xe7, 0x45, 0x51, 0x4c, 0x3d, 0x1a, 0xc6, 0x51, 0x2a, 0x70, 0x9d, 0x37, 0xe4, 0x28,
-	0x6f, 0xed, 0x4e, 0xf6, 0x7a, 0x34, 0x1c, 0x91, 0x94, 0x7a, 0xa3, 0xb1, 0x04, 0x9c, 0x2d, 0x02,
-	0xc8, 0x68, 0x4c, 0xa7, 0x72, 0x70, 0x85, 0x44, 0x93, 0x51, 0xda, 0xe3, 0x7f, 0x45, 0x97, 0xfb,
-	0x21, 0x2c, 0x6d, 0x53, 0x8f, 0x12, 0x4c, 0xd2, 0x71, 0x1c, 0xa5, 0x04, 0xbd, 0x0d, 0x76, 0xca,
-	0x3a, 0xda, 0xd6, 0x05, 0xeb, 0x52, 0xb3, 0x7f, 0xb2, 0xab, 0x2c, 0xeb, 0x0a, 0x9c, 0x18, 0x75,
-	0xd7, 0xa1, 0x91, 0x89, 0x2c, 0x43, 0x75, 0x94, 0x0e, 0xb8, 0x80, 0x83, 0xd9, 0xa7, 0x7b, 0x0e,
-	0xea, 0x98, 0xfc, 0x6c, 0x42, 0x52, 0x8a, 0x10, 0x2c, 0x44, 0xde, 0x88, 0xc8, 0x51, 0xfe, 0xed,
-	0xfe, 0xde, 0x06, 0x9b, 0x6b, 0x43, 0xdf, 0x05, 0xd8, 0x9d, 0x84, 0xc3, 0x60, 0x5b, 0x9b, 0xf2,
-	0x54, 0x3e, 0xe5, 0xcd, 0x6c, 0x0c, 0x6b, 0x38, 0x74, 0x0d, 0x9a, 0x01, 0x19, 0x0f, 0xe3, 0xa9,
-	0x10, 0xab, 0x70, 0xb1, 0xd3, 0xb9, 0xd8, 0xed, 0x7c, 0x10, 0xeb, 0x48, 0x74, 0x1f, 0x5a, 0x7b,
-	0x71, 0xf2, 0x95, 0x97, 0x04, 0x24, 0xf8, 0x2c, 0x4e, 0x68, 0xda, 0xae, 0x5e, 0xa8, 0x5e, 0x6a,
-	0xf6, 0xdf, 0x2c, 0xac, 0xb2, 0x7b, 0xd7, 0x40, 0xdd, 0x89, 0x68, 0x32, 0xc5, 0x05, 0x51, 0x74,
-	0x17, 0x96, 0x99, 0x2f, 0x26, 0xe9, 0xad, 0x67, 0xc4, 0xdf, 0x17, 0xa6, 0x2c, 0x70, 0x53, 0x3a,
-	0xa6, 0x3a, 0x1d, 0x81, 0x4b, 0x32, 0xe8, 0x06, 0x2c, 0xed, 0x85, 0x43, 0xb2, 0x3d, 0x8d, 0x7c,
-	0xa1, 0xc4, 0xe6, 0x4a, 0xd6, 0x72, 0x25, 0x77, 0xf5, 0x61, 0x6c, 0xa2, 0xd1, 0x36, 0xac, 0x06,
-	0x64, 0x77, 0x32, 0x18, 0x84, 0xd1, 0xe0, 0x56, 0x1c, 0x51, 0x2f, 0x8c, 0x48, 0x92, 0xb6, 0x6b,
-	0x7c, 0x61, 0x17, 0x75, 0xa7, 0x14, 0x41, 0x77, 0x0e, 0x48, 0x44, 0xf1, 0x2c, 0x69, 0xd4, 0x85,
-	0xc6, 0x88, 0x50, 0x2f, 0xf0, 0xa8, 0xd7, 0xae, 0x73, 0x73, 0x50, 0xae, 0xe9, 0xa1, 0x1c, 0xc1,
-	0x19, 0x06, 0x5d, 0x01, 0x87, 0x92, 0x94, 0x0a, 0xfb, 0x1b, 0x5c, 0x60, 0x35, 0x17, 0xd8, 0x51,
-	0x43, 0x38, 0x47, 0xb1, 0x4d, 0x4c, 0x48, 0x14, 0x90, 0x44, 0x08, 0x39, 0xc5, 0x4d, 0xc4, 0xf9,
-	0x20, 0xd6, 0x91, 0x9d, 0x2f, 0x61, 0x75, 0xc6, 0xf6, 0x30, 0x16, 0xee, 0x93, 0x29, 0xe7, 0x90,
-	0x8d, 0xd9, 0x27, 0xba, 0x0c, 0xf6, 0x81, 0x37, 0x9c, 0x28, 0x82, 0x68, 0xbb, 0xc2, 0xc4, 0xa4,
-	0x0e, 0xe1, 0x04, 0x01, 0xbc, 0x5e, 0xf9, 0xc8, 0x72, 0xff, 0x56, 0x81, 0x86, 0x5a, 0x21, 0xfa,
-	0x00, 0x6c, 0xce, 0x3b, 0x49, 0xcd, 0xb5, 0x02, 0x35, 0x33, 0x4f, 0x08, 0x14, 0xba, 0x0c, 0x35,
-	0x41, 0x37, 0x39, 0x65, 0xbb, 0xc8, 0xc9, 0x4c, 0x40, 0xe2, 0xd0, 0xbb, 0xb0, 0xc0, 0x5c, 0xd2,
-	0xae, 0x72, 0xfc, 0x19, 0xd3, 0x67, 0x19, 0x9a, 0x63, 0xd0, 0x29, 0xb0, 0x93, 0x49, 0xb4, 0x75,
-	0x9b, 0xb3, 0xcc, 0xc1, 0xa2, 0xc1, 0xe6, 0x14, 0xde, 0x91, 0xbc, 0x69, 0x17, 0x5d, 0x98, 0xcf,
-	0x29, 0x70, 0xe8, 0x26, 0x80, 0x17, 0x04, 0x21, 0x8b, 0x2b, 0xde, 0xb0, 0xed, 0x73, 0xa2, 0xb8,
-	0xe5, 0xed, 0xed, 0x6e, 0x64, 0x20, 0x71, 0x00, 0x34, 0xa9, 0xce, 0x0d, 0x38, 0x59, 0x18, 0xd6,
-	0x37, 0xc0, 0x11, 0x1b, 0x70, 0x4a, 0xdf, 0x00, 0x47, 0x77, 0xf2, 0xaf, 0xab, 0xb0, 0x64, 0x78,
-	0x10, 0x7d, 0x0c, 0x8e, 0x97, 0xd0, 0x70, 0xcf, 0xf3, 0x69, 0xda, 0xb6, 0xb8, 0x4d, 0x17, 0xe6,
-	0x78, 0xbb, 0xbb, 0x21, 0x81, 0x38, 0x17, 0xe1, 0x8e, 0x9c, 0x8e, 0xc5, 0x54, 0xad, 0xcc, 0x91,
-	0x22, 0xd4, 0x71, 0xe9, 0x9d, 0xe9, 0x98, 0x60, 0x8e, 0x41, 0xf7, 0x66, 0x38, 0xe0, 0x3b, 0x73,
-	0x27, 0x7b, 0x81, 0x17, 0x7e, 0x69, 0x41, 0x43, 0x19, 0x83, 0xde, 0x97, 0x16, 0x58, 0xdc, 0x82,
-	0x76, 0xd9, 0x02, 0x92, 0x68, 0x36, 0xa8, 0xb8, 0x58, 0xc9, 0xe3, 0x22, 0x6a, 0x43, 0xdd, 0x8f,
-	0x23, 0x4a, 0x9e, 0x0b, 0x3e, 0x38, 0x58, 0x35, 0xd1, 0x79, 0x80, 0x20, 0xf6, 0xf7, 0x49, 0xc2,
-	0xce, 0xbe, 0xdc, 0x7f, 0xad, 0xe7, 0x55, 0xb7, 0xe3, 0x6b, 0x0b, 0x16, 0x75, 0xc2, 0xa1, 0x6b,
-	0x50, 0x67, 0x6d, 0x16, 0x48, 0xc4, 0x5e, 0x9c, 0x9b, 0xcd, 0xcc, 0xae, 0x40, 0x61, 0x85, 0xee,
-	0xdc, 0x87, 0x9a, 0xf8, 0x44, 0xef, 0x19, 0xee, 0x58, 0x33, 0xdc, 0x21, 0x20, 0x9a, 0x37, 0x4e,
-	0x81, 0xed, 0xc7, 0x93, 0x88, 0x72, 0xd3, 0x6c, 0x2c, 0x1a, 0xee, 0x37, 0x16, 0xb4, 0x4c, 0x0e,
-	0xa3, 0x4f, 0xc0, 0x11, 0x3d, 0xb9, 0x69, 0x17, 0xe7, 0x11, 0xbe, 0xab, 0x90, 0x38, 0x97, 0xe9,
-	0x3c, 0x64, 0x17, 0x97, 0x68, 0xbc, 0xd0, 0x44, 0x01, 0x3a, 0xd4, 0xc4, 0xbf, 0x5a, 0xd0, 0x32,
-	0x8f, 0x36, 0x33, 0x51, 0x1c, 0xee, 0x99, 0x26, 0x9a, 0x60, 0xd9, 0x64, 0x26, 0x66, 0x32, 0xa8,
-	0x0f, 0x75, 0x7f, 0x38, 0x61, 0x1e, 0x92, 0x6c, 0x36, 0xb9, 0x74, 0x4b, 0x8c, 0x71, 0xd3, 0x14,
-	0xb0, 0xf3, 0x18, 0x1a, 0x4a, 0x15, 0xfa, 0xc0, 0x58, 0xd6, 0xb7, 0x0c, 0x61, 0x05, 0x3a, 0x74,
-	0x61, 0xff, 0xb0, 0x00, 0xf2, 0xeb, 0x17, 0x6d, 0x94, 0x8f, 0xe7, 0x9b, 0xb3, 0xee, 0xe9, 0xec,
-	0x6c, 0xca, 0x4b, 0x53, 0x3b, 0xa1, 0x17, 0xa0, 0xe9, 0x4d, 0x68, 0xbc, 0x93, 0x84, 0x83, 0x81,
-	0x5c, 0x5a, 0x03, 0xeb, 0x5d, 0xe8, 0x1a, 0x80, 0xbc, 0x1d, 0xe3, 0x80, 0xf0, 0x23, 0x50, 0xdc,
-	0x95, 0xed, 0x6c, 0x18, 0x6b, 0xd0, 0xce, 0xf7, 0xa1, 0x65, 0xce, 0x7b, 0x2c, 0xf6, 0xff, 0x04,
-	0x9c, 0xec, 0x86, 0x42, 0x67, 0xa0, 0x26, 0x14, 0x4b, 0x59, 0xd9, 0x2a, 0xd8, 0x56, 0x39, 0xb2,
-	0x6d, 0xee, 0x4f, 0xa1, 0xa9, 0x5d, 0x65, 0xff, 0x7d, 0xfd, 0x3f, 0xb7, 0xa0, 0xa9, 0x25, 0x3c,
-	0x73, 0x27, 0x78, 0x7d, 0xee, 0x77, 0xff, 0x69, 0xc1, 0x72, 0x31, 0xd1, 0x99, 0x6b, 0xc7, 0x3d,
-	0x70, 0x12, 0x92, 0xc6, 0x93, 0xc4, 0x27, 0x69, 0xbb, 0xc2, 0x99, 0xf4, 0xce, 0xfc, 0x7c, 0xa9,
-	0x8b, 0x15, 0x56, 0xf2, 0x29, 0x93, 0x7d, 0x25, 0xb6, 0x98, 0x5a, 0x8f, 0xc5, 0x96, 0x2d, 0x58,
-	0x32, 0xf2, 0xb1, 0x97, 0x77, 0xb8, 0xfb, 0xef, 0x3a, 0xd8, 0x3c, 0xff, 0x40, 0x1f, 0x81, 0x93,
-	0x65, 0xf2, 0x32, 0xd7, 0xe8, 0x74, 0x45, 0x2a, 0xdf, 0x55, 0xa9, 0x7c, 0x77, 0x47, 0x21, 0x70,
-	0x0e, 0x46, 0x57, 0xc1, 0x61, 0x59, 0x18, 0x57, 0x23, 0xb3, 0x8e, 0x55, 0xf3, 0x2e, 0xe7, 0x43,
-	0x9b, 0x27, 0x70, 0x8e, 0x43, 0x9b, 0xb0, 0xac, 0x0a, 0x90, 0x07, 0xf1, 0x40, 0xc8, 0x56, 0x4b,
-	0xa9, 0x6b, 0x01, 0xb1, 0x79, 0x02, 0x97, 0xa4, 0xd0, 0x13, 0x58, 0xf5, 0xc6, 0xe3, 0x61, 0xe8,
-	0xf3, 0x32, 0x25, 0x53, 0x26, 0xf2, 0x60, 0xed, 0xd2, 0xd8, 0x28, 0x83, 0x36, 0x4f, 0xe0, 0x59,
-	0xb2, 0x6c, 0x45, 0xd4, 0x4b, 0xf7, 0x85, 0x22, 0xbb, 0x94, 0x4b, 0xaa, 0x21, 0xb6, 0xa2, 0x0c,
-	0x87, 0xee, 0xc3, 0x8a, 0x28, 0x10, 0x26, 0xbb, 0xb9, 0x70, 0x8d, 0x0b, 0x9f, 0x2d, 0xc6, 0x29,
-	0x0d, 0xb2, 0x79, 0x02, 0x97, 0xe5, 0xd0, 0x23, 0x40, 0xb2, 0x6a, 0xd0, 0xb5, 0x89, 0x3c, 0x78,
-	0xbd, 0x54, 0x66, 0x98, 0xea, 0x66, 0x48, 0xa2, 0xeb, 0xe0, 0x8c, 0xe3, 0x84, 0x0a, 0x35, 0x8d,
-	0xc3, 0x92, 0x51, 0xb6, 0xb0, 0x0c, 0x8e, 0xbe, 0x84, 0x35, 0xbd, 0x62, 0xd0, 0x0d, 0x12, 0x29,
-	0xf3, 0xc5, 0xd9, 0x87, 0xc7, 0xb4, 0x6a, 0x9e, 0x0e, 0xf4, 0x49, 0x5e, 0x7c, 0x08, 0xa5, 0x30,
-	0xaf, 0xf8, 0x50, 0xaa, 0x4c, 0x3c, 0xb3, 0x2f, 0x98, 0x5d, 0x59, 0xb4, 0x9b, 0x45, 0xfb, 0xe6,
-	0x94, 0x20, 0xcc, 0xbe, 0x39, 0x3a, 0x18, 0x53, 0x29, 0x49, 0x46, 0x61, 0xc4, 0x39, 0x22, 0xf4,
-	0x2e, 0x16, 0x3d, 0xb8, 0x53, 0x40, 0x30, 0xa6, 0x16, 0xa5, 0xd8, 0x26, 0xb0, 0x2c, 0x5a, 0xa8,
-	0x58, 0x2a, 0xab, 0x48, 0x69, 0xc1, 0x67, 0x39, 0x1c, 0xfd, 0x40, 0xd5, 0x2a, 0x42, 0xba, 0x55,
-	0x64, 0x82, 0x0c, 0xf0, 0xa6, 0xbc, 0x2e, 0x72, 0x73, 0x11, 0x80, 0xb0, 0x8f, 0xa7, 0xec, 0xca,
-	0x75, 0x3f, 0x87, 0xe5, 0xa2, 0xcd, 0x73, 0xc3, 0xc8, 0x3b, 0x50, 0x25, 0x49, 0x22, 0x8f, 0xb6,
-	0xb6, 0x2f, 0x1b, 0x3e, 0xcf, 0xf6, 0x76, 0x87, 0xe4, 0x4e, 0x92, 0x60, 0x86, 0x61, 0x69, 0xdc,
-	0x92, 0xd1, 0x8d, 0xae, 0x40, 0x9d, 0x24, 0x09, 0x0f, 0x90, 0xd6, 0x8b, 0x03, 0xa4, 0xc2, 0xb1,
-	0x24, 0x74, 0x44, 0xd2, 0xd4, 0x1b, 0xa8, 0xd8, 0xa7, 0x9a, 0xe8, 0x43, 0x68, 0xa6, 0x93, 0xc1,
-	0x80, 0xa4, 0xfc, 0x45, 0x42, 0x96, 0xce, 0x5a, 0xb5, 0xbe, 0x9d, 0x0d, 0x62, 0x1d, 0xe8, 0x3e,
-	0x01, 0x27, 0x8b, 0x43, 0x2c, 0xb0, 0x12, 0x16, 0x73, 0xe5, 0x2a, 0x45, 0xc3, 0xa8, 0x37, 0x2b,
-	0x87, 0xd7, 0x9b, 0xee, 0xef, 0xd8, 0x8d, 0x53, 0x8c, 0x45, 0x6b, 0x50, 0x67, 0xfe, 0x7f, 0x1a,
-	0x06, 0xca, 0x85, 0xac, 0xb9, 0x15, 0xa0, 0x73, 0x00, 0xa9, 0xd8, 0x1b, 0x36, 0x26, 0x56, 0xe5,
-	0xc8, 0x9e, 0xad, 0x00, 0xbd, 0x07, 0xf6, 0x90, 0x1c, 0x90, 0x21, 0x8f, 0x5a, 0xad, 0xac, 0x06,
-	0x15, 0x2e, 0x7a, 0x10, 0x0f, 0x1e, 0xb0, 0x41, 0x2c, 0x30, 0xba, 0x7b, 0x6c, 0xc3, 0x3d, 0x9f,
-	0x2e, 0x34, 0xaa, 0xcb, 0x0b, 0xee, 0x1f, 0x2d, 0x58, 0x9d, 0x11, 0xec, 0xd0, 0x5b, 0xb0, 0xe4,
-	0x2b, 0x6a, 0x3f, 0xca, 0x1f, 0x44, 0xcc, 0x4e, 0xa6, 0x7d, 0x1c, 0x07, 0x8f, 0xf2, 0xc2, 0x40,
-	0x35, 0x19, 0x3d, 0xc6, 0x09, 0xd9, 0x0b, 0x9f, 0xcb, 0xd2, 0x40, 0xb6, 0x74, 0x7b, 0x16, 0xcc,
-	0xed, 0xea, 0xc3, 0xa9, 0x24, 0xf4, 0x9f, 0xdd, 0x8d, 0x93, 0x91, 0x47, 0x29, 0x09, 0x1e, 0x1a,
-	0x66, 0xcf, 0x1c, 0x73, 0xff, 0x6c, 0x81, 0x93, 0x45, 0x58, 0xd4, 0x82, 0x4a, 0xe6, 0xcb, 0x4a,
-	0x18, 0xb0, 0x9a, 0x85, 0xb9, 0x4c, 0xd5, 0x2c, 0xec, 0x9b, 0xdd, 0x72, 0x01, 0x49, 0xfd, 0x24,
-	0x1c, 0xb3, 0xe5, 0x4a, 0xe3, 0xf4, 0x2e, 0xb4, 0x0e, 0x4e, 0x48, 0x49, 0xc2, 0xdd, 0xc1, 0x6d,
-	0xb4, 0x71, 0xde, 0xa1, 0xd1, 0xde, 0x36, 0x68, 0x7f, 0x03, 0x96, 0x3c, 0x9d, 0xca, 0x32, 0x98,
-	0xcf, 0x3d, 0x00, 0x26, 0xda, 0xfd, 0x93, 0x05, 0x2b, 0xa5, 0x68, 0x5f, 0x5a, 0x90, 0xc6, 0x98,
-	0x8a, 0xc1, 0x98, 0x0e, 0x34, 0x54, 0xe2, 0x2a, 0x97, 0x94, 0xb5, 0x99, 0x17, 0x52, 0x4a, 0xc6,
-	0xd2, 0xdd, 0xfc, 0xfb, 0x75, 0xad, 0xe2, 0x37, 0x16, 0x0b, 0x14, 0x66, 0x64, 0x3a, 0xfa, 0x22,
-	0x72, 0xa3, 0xaa, 0x2f, 0x36, 0x6a, 0xe1, 0x58, 0x46, 0x7d, 0x6d, 0x01, 0x2a, 0x07, 0xbc, 0xff,
-	0x0b, 0xb3, 0xca, 0x37, 0xf2, 0xff, 0xdc, 0xac, 0x5f, 0x55, 0x60, 0x6d, 0xce, 0xbd, 0x7c, 0x2c,
-	0x3a, 0xaa, 0xbc, 0x57, 0xd1, 0x51, 0xb5, 0x35, 0xbb, 0x17, 0x0c, 0xbb, 0xe7, 0x06, 0xaa, 0x42,
-	0xe2, 0x5c, 0x3b, 0x72, 0xe2, 0x5c, 0x76, 0x45, 0xfd, 0x58, 0xae, 0xf8, 0x57, 0x05, 0x96, 0x8b,
-	0xc9, 0xce, 0xd1, 0x7d, 0xb0, 0x0e, 0xce, 0x30, 0xf6, 0xbd, 0x21, 0xd3, 0xc0, 0x9d, 0x60, 0xe3,
-	0xbc, 0x43, 0x0f, 0x9c, 0x0b, 0x66, 0xe0, 0x2c, 0x05, 0x5e, 0x7b, 0x56, 0xe0, 0x5d, 0x07, 0x27,
-	0xf2, 0x46, 0x24, 0x1d, 0x7b, 0xbe, 0x70, 0x89, 0x83, 0xf3, 0x0e, 0xe6, 0x7f, 0x96, 0x91, 0x71,
-	0xf1, 0xba, 0xf0, 0xbf, 0x6a, 0x23, 0x17, 0x16, 0xd5, 0x5e, 0xb0, 0xa2, 0x9a, 0xe7, 0x77, 0x0e,
-	0x36, 0xfa, 0x74, 0x0c, 0xd7, 0xe1, 0x98, 0x18, 0x15, 0xfa, 0xbd, 0x20, 0x48, 0x48, 0x9a, 0xf2,
-	0x1c, 0xcc, 0xc1, 0xaa, 0x89, 0xbe, 0x07, 0x40, 0xbd, 0x64, 0x40, 0x28, 0x5f, 0x7a, 0xb3, 0xf8,
-	0x50, 0xba, 0x15, 0xd1, 0xc7, 0xc9, 0x36, 0x4d, 0xc2, 0x68, 0x80, 0x35, 0x20, 0x0b, 0x81, 0x4b,
-	0x46, 0xf2, 0x76, 0x2c, 0x5f, 0xb3, 0x2c, 0xef, 0x16, 0x7f, 0x16, 0x90, 0xbe, 0xce, 0x3a, 0xd8,
-	0x15, 0x1e, 0x8e, 0xf2, 0x0b, 0x47, 0x34, 0x5e, 0x57, 0x08, 0xfc, 0xa6, 0x0a, 0x6b, 0x73, 0xf2,
-	0xc6, 0x57, 0x3f, 0xdb, 0xaf, 0x9d, 0x35, 0xd9, 0x25, 0x52, 0x2f, 0x5c, 0x22, 0x6d, 0xa8, 0x27,
-	0x93, 0x88, 0x95, 0x71, 0x92, 0x30, 0xaa, 0x89, 0xce, 0x03, 0x7c, 0x15, 0x27, 0xfb, 0x61, 0x34,
-	0xb8, 0x1d, 0x26, 0x92, 0x29, 0x5a, 0x0f, 0x7a, 0x02, 0xc0, 0x93, 0x65, 0xf1, 0xfb, 0x05, 0xf0,
-	0x24, 0xec, 0xca, 0xa1, 0x39, 0xb6, 0xe8, 0xd7, 0x7e, 0xcd, 0xd0, 0x94, 0x74, 0x6e, 0xc0, 0xc9,
-	0xc2, 0xf0, 0x61, 0x15, 0xf1, 0x92, 0x5e, 0x11, 0xdf, 0x80, 0x95, 0xcf, 0x53, 0x92, 0x6c, 0x45,
-	0x94, 0x44, 0x54, 0xfd, 0xee, 0x73, 0x09, 0x6a, 0x21, 0xef, 0x90, 0xe5, 0xec, 0xb2, 0x41, 0x58,
-	0x06, 0x94, 0xe3, 0xee, 0xc7, 0xd0, 0x92, 0x05, 0xb1, 0x92, 0x7d, 0xdf, 0xfc, 0x0d, 0x4a, 0x7f,
-	0x15, 0x17, 0x40, 0xe3, 0xa7, 0xa8, 0x2b, 0xb0, 0xa8, 0x77, 0xa3, 0x0e, 0xd4, 0x09, 0xa7, 0x8f,
-	0xa0, 0x46, 0x63, 0xf3, 0x04, 0x56, 0x1d, 0x37, 0x6d, 0xa8, 0x1e, 0x78, 0x43, 0xf7, 0x53, 0xa8,
-	0x09, 0x23, 0xd8, 0xaa, 0xf2, 0x07, 0xfe, 0x86, 0x7a, 0xc7, 0x67, 0x57, 0xfc, 0x34, 0xf2, 0x65,
-	0xcd, 0xce, 0xbf, 0x19, 0x87, 0xe4, 0xdb, 0x7e, 0x95, 0xf7, 0xca, 0x96, 0x1b, 0x02, 0xe4, 0x89,
-	0x2f, 0xba, 0x05, 0xad, 0x3c, 0xf5, 0xd5, 0xf2, 0xee, 0xb3, 0x66, 0x7c, 0x35, 0x20, 0xb8, 0x20,
-	0xc2, 0xa6, 0x12, 0x87, 0x40, 0xd1, 0x58, 0xb4, 0xdc, 0x27, 0xd0, 0xd4, 0x0e, 0x3b, 0x4f, 0xc7,
-	0xd4, 0x3b, 0x9f, 0x2d, 0x1f, 0xf3, 0xce, 0x70, 0xb7, 0x7f, 0xe1, 0x0d, 0xe5, 0x6b, 0x9e, 0x6c,
-	0x89, 0x13, 0x90, 0xb0, 0xfe, 0xec, 0x04, 0xb0, 0x56, 0xff, 0x0f, 0x35, 0x58, 0x51, 0x89, 0xf4,
-	0x17, 0xfd, 0x6d, 0x92, 0x1c, 0x84, 0x3e, 0x41, 0x77, 0xa1, 0x71, 0x8f, 0xa8, 0x07, 0xb1, 0xd2,
-	0x3b, 0xc4, 0x9d, 0xd1, 0x98, 0x4e, 0x3b, 0xc5, 0x5f, 0x06, 0xdd, 0x95, 0x5f, 0xfc, 0xe5, 0xef,
-	0xbf, 0xad, 0x34, 0x91, 0xd3, 0x3b, 0xe8, 0xf7, 0xf8, 0xd6, 0xa0, 0x7b, 0x50, 0xe3, 0xec, 0x4b,
-	0x8f, 0xa2, 0x85, 0x23, 0x5d, 0xc4, 0xb5, 0x2c, 0x22, 0x60, 0x5a, 0x78, 0xc9, 0x94, 0x5e, 0xb6,
-	0xd0, 0x0f, 0xe1, 0xa4, 0x99, 0x54, 0x1f, 0x43, 0xe3, 0x59, 0xae, 0xf1, 0x34, 0x5a, 0x65, 0x1a,
-	0xcd, 0x07, 0x07, 0xa6, 0x7a, 0x1b, 0x16, 0xb5, 0x4a, 0xe2, 0x18, 0x7a, 0xdb, 0x5c, 0x2f, 0x42,
-	0xcb, 0x3d, 0xed, 0xf7, 0x5c, 0xa9, 0xf4, 0xc7, 0x50, 0xbf, 0xf3, 0x9c, 0xf8, 0x13, 0x4a, 0x90,
-	0xf6, 0xfc, 0x50, 0x3a, 0x25, 0x9d, 0x39, 0x93, 0x29, 0x9b, 0xdd, 0x26, 0xf7, 0x82, 0xd0, 0x74,
-	0x5d, 0x1e, 0x18, 0x14, 0x80, 0xb3, 0x31, 0xa1, 0x31, 0x4f, 0x6f, 0x51, 0xbb, 0x74, 0x38, 0x0e,
-	0xd3, 0xfd, 0x36, 0xd7, 0xfd, 0x46, 0xe7, 0x0c, 0xd3, 0xcd, 0xf9, 0xde, 0xf3, 0x26, 0x34, 0x7e,
-	0xaa, 0xa6, 0x11, 0xc7, 0x0a, 0xed, 0x42, 0x83, 0xcd, 0xc2, 0x6e, 0x8f, 0x97, 0x98, 0xe4, 0x2d,
-	0x3e, 0xc9, 0xf9, 0xce, 0x69, 0xee, 0x9c, 0x69, 0xe4, 0xcf, 0x9c, 0x63, 0x0f, 0x80, 0xcd, 0x21,
-	0xd2, 0xb6, 0x97, 0x98, 0xe5, 0xdb, 0x7c, 0x96, 0x0b, 0x9d, 0x35, 0x36, 0x8b, 0x38, 0x8f, 0x33,
-	0xe7, 0x79, 0x0c, 0xb5, 0x4d, 0x2f, 0x0a, 0x86, 0x04, 0x15, 0x77, 0x71, 0xae, 0xea, 0x75, 0xae,
-	0xfa, 0x8c, 0xbb, 0x92, 0xf3, 0xb0, 0xf7, 0x8c, 0xeb, 0xb8, 0x6e, 0xbd, 0x7b, 0xf3, 0xea, 0x8f,
-	0xae, 0x0c, 0x42, 0xfa, 0x6c, 0xb2, 0xdb, 0xf5, 0xe3, 0x51, 0xef, 0x1e, 0xd7, 0x90, 0x05, 0xdc,
-	0x9d, 0x38, 0x1e, 0xa6, 0x19, 0x23, 0xc4, 0x4f, 0xf1, 0xbd, 0x83, 0xfe, 0x67, 0xd5, 0xdd, 0x1a,
-	0xff, 0xbe, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xbb, 0xf5, 0xeb, 0x02, 0x20, 0x00,
-	0x00,
+	// 2430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x5a, 0x92, 0xfb, 0x28, 0xd1, 0xd2, 0xc8, 0xb6, 0xb6, 0xb4, 0x92, 0x28, 0x9b,
+	0xa4, 0xcd, 0x27, 0x69, 0xcb, 0x6d, 0x1c, 0x18, 0x75, 0x52, 0xf9, 0x53, 0x8a, 0xbf, 0xe2, 0x91,
+	0x62, 0xa0, 0x1f, 0xa9, 0xb1, 0xda, 0x1d, 0xd1, 0x0b, 0x91, 0xbb, 0xec, 0xec, 0x52, 0xb1, 0x6e,
+	0x45, 0x0f, 0x45, 0x0f, 0x3d, 0xb5, 0x01, 0x0a, 0xf4, 0x94, 0x63, 0xff, 0x80, 0x1e, 0x0a, 0xf4,
+	0x58, 0xa0, 0xff, 0x40, 0x81, 0x5e, 0x7a, 0x2b, 0x7a, 0x28, 0xfa, 0x4f, 0xb4, 0x98, 0xaf, 0xdd,
+	0x99, 0x5d, 0xd2, 0x92, 0xec, 0x1a, 0xb9, 0xd8, 0x9c, 0x99, 0xdf, 0xfb, 0xcd, 0x9b, 0x37, 0x6f,
+	0xde, 0xbc, 0x37, 0x2b, 0x58, 0x3a, 0x58, 0xef, 0xa7, 0xfb, 0xfe, 0xde, 0x5e, 0x32, 0x0c, 0x7b,
+	0x63, 0x9a, 0x64, 0x09, 0x6a, 0xf1, 0xff, 0x7a, 0x07, 0xeb, 0xdd, 0xd5, 0x41, 0x92, 0x0c, 0x86,
+	0xa4, 0xef, 0x8f, 0xa3, 0xbe, 0x1f, 0xc7, 0x49, 0xe6, 0x67, 0x51, 0x12, 0xa7, 0x02, 0xd7, 0x7d,
+	0x4d, 0x8e, 0xf2, 0xd6, 0xee, 0x64, 0xaf, 0x9f, 0x45, 0x23, 0x92, 0x66, 0xfe, 0x68, 0x2c, 0x01,
+	0xe7, 0xcb, 0x00, 0x32, 0x1a, 0x67, 0x87, 0x72, 0x70, 0x89, 0xc4, 0x93, 0x51, 0xda, 0xe7, 0xff,
+	0x8a, 0x2e, 0xef, 0x43, 0x58, 0xd8, 0xce, 0xfc, 0x8c, 0x60, 0x92, 0x8e, 0x93, 0x38, 0x25, 0xe8,
+	0x2d, 0xb0, 0x53, 0xd6, 0xe1, 0x5a, 0x6b, 0xd6, 0xdb, 0xed, 0xf5, 0xd3, 0x3d, 0xa5, 0x59, 0x4f,
+	0xe0, 0xc4, 0xa8, 0xb7, 0x0a, 0xad, 0x5c, 0x64, 0x11, 0xea, 0xa3, 0x74, 0xc0, 0x05, 0x1c, 0xcc,
+	0x7e, 0x7a, 0xaf, 0x40, 0x13, 0x93, 0x9f, 0x4d, 0x48, 0x9a, 0x21, 0x04, 0x73, 0xb1, 0x3f, 0x22,
+	0x72, 0x94, 0xff, 0xf6, 0x7e, 0x6f, 0x83, 0xcd, 0xd9, 0xd0, 0x77, 0x01, 0x76, 0x27, 0xd1, 0x30,
+	0xdc, 0xd6, 0xa6, 0x3c, 0x53, 0x4c, 0x79, 0x2d, 0x1f, 0xc3, 0x1a, 0x0e, 0x5d, 0x86, 0x76, 0x48,
+	0xc6, 0xc3, 0xe4, 0x50, 0x88, 0xd5, 0xb8, 0xd8, 0xd9, 0x42, 0xec, 0x46, 0x31, 0x88, 0x75, 0x24,
+	0xba, 0x03, 0x9d, 0xbd, 0x84, 0x7e, 0xe9, 0xd3, 0x90, 0x84, 0x9f, 0x25, 0x34, 0x4b, 0xdd, 0xfa,
+	0x5a, 0xfd, 0xed, 0xf6, 0xfa, 0x1b, 0xa5, 0x55, 0xf6, 0x6e, 0x19, 0xa8, 0x9b, 0x71, 0x46, 0x0f,
+	0x71, 0x49, 0x14, 0xdd, 0x82, 0x45, 0x66, 0x8b, 0x49, 0x7a, 0xfd, 0x09, 0x09, 0xf6, 0x85, 0x2a,
+	0x73, 0x5c, 0x95, 0xae, 0x49, 0xa7, 0x23, 0x70, 0x45, 0x06, 0x5d, 0x85, 0x85, 0xbd, 0x68, 0x48,
+	0xb6, 0x0f, 0xe3, 0x40, 0x90, 0xd8, 0x9c, 0x64, 0xa5, 0x20, 0xb9, 0xa5, 0x0f, 0x63, 0x13, 0x8d,
+	0xb6, 0x61, 0x39, 0x24, 0xbb, 0x93, 0xc1, 0x20, 0x8a, 0x07, 0xd7, 0x93, 0x38, 0xf3, 0xa3, 0x98,
+	0xd0, 0xd4, 0x6d, 0xf0, 0x85, 0xbd, 0xae, 0x1b, 0xa5, 0x0c, 0xba, 0x79, 0x40, 0xe2, 0x0c, 0x4f,
+	0x93, 0x46, 0x3d, 0x68, 0x8d, 0x48, 0xe6, 0x87, 0x7e, 0xe6, 0xbb, 0x4d, 0xae, 0x0e, 0x2a, 0x98,
+	0xee, 0xc9, 0x11, 0x9c, 0x63, 0xd0, 0x45, 0x70, 0x32, 0x92, 0x66, 0x42, 0xff, 0x16, 0x17, 0x58,
+	0x2e, 0x04, 0x76, 0xd4, 0x10, 0x2e, 0x50, 0x6c, 0x13, 0x29, 0x89, 0x43, 0x42, 0x85, 0x90, 0x53,
+	0xde, 0x44, 0x5c, 0x0c, 0x62, 0x1d, 0xd9, 0xfd, 0x02, 0x96, 0xa7, 0x6c, 0x0f, 0xf3, 0xc2, 0x7d,
+	0x72, 0xc8, 0x7d, 0xc8, 0xc6, 0xec, 0x27, 0xba, 0x00, 0xf6, 0x81, 0x3f, 0x9c, 0x28, 0x07, 0xd1,
+	0x76, 0x85, 0x89, 0x49, 0x0e, 0x61, 0x04, 0x01, 0xbc, 0x52, 0xfb, 0xc8, 0xf2, 0xfe, 0x59, 0x83,
+	0x96, 0x5a, 0x21, 0xfa, 0x00, 0x6c, 0xee, 0x77, 0xae, 0x55, 0xde, 0x13, 0xee, 0x9a, 0xb9, 0x25,
+	0x04, 0x0a, 0x5d, 0x80, 0x86, 0x70, 0x37, 0x39, 0xa5, 0x5b, 0xf6, 0xc9, 0x5c, 0x40, 0xe2, 0xd0,
+	0xbb, 0x30, 0xc7, 0x4c, 0xe2, 0xd6, 0x39, 0xfe, 0x9c, 0x69, 0xb3, 0x1c, 0xcd, 0x31, 0xe8, 0x0c,
+	0xd8, 0x74, 0x12, 0x6f, 0xdd, 0xe0, 0x5e, 0xe6, 0x60, 0xd1, 0x60, 0x73, 0x0a, 0xeb, 0xb8, 0x76,
+	0x79, 0x4e, 0x61, 0xc2, 0x62, 0x4e, 0x81, 0x43, 0xd7, 0x00, 0xfc, 0x30, 0x8c, 0x58, 0x5c, 0xf1,
+	0x87, 0x6e, 0xc0, 0x1d, 0xc5, 0xab, 0x6e, 0x6f, 0x6f, 0x23, 0x07, 0x89, 0x03, 0xa0, 0x49, 0x75,
+	0xaf, 0xc2, 0xe9, 0xd2, 0xb0, 0xbe, 0x01, 0x8e, 0xd8, 0x80, 0x33, 0xfa, 0x06, 0x38, 0xba, 0x91,
+	0x7f, 0x5d, 0x87, 0x05, 0xc3, 0x82, 0xe8, 0x63, 0x70, 0x7c, 0x9a, 0x45, 0x7b, 0x7e, 0x90, 0xa5,
+	0xae, 0xc5, 0x75, 0x5a, 0x9b, 0x61, 0xed, 0xde, 0x86, 0x04, 0xe2, 0x42, 0x84, 0x1b, 0xf2, 0x70,
+	0x2c, 0xa6, 0xea, 0xe4, 0x86, 0x14, 0xa1, 0x8e, 0x4b, 0xef, 0x1c, 0x8e, 0x09, 0xe6, 0x18, 0x74,
+	0x7b, 0x8a, 0x01, 0xbe, 0x33, 0x73, 0xb2, 0x67, 0x58, 0xe1, 0x97, 0x16, 0xb4, 0x94, 0x32, 0xe8,
+	0x7d, 0xa9, 0x81, 0xc5, 0x35, 0x70, 0xab, 0x1a, 0x10, 0xaa, 0xe9, 0xa0, 0xe2, 0x62, 0xad, 0x88,
+	0x8b, 0xc8, 0x85, 0x66, 0x90, 0xc4, 0x19, 0x79, 0x2a, 0xfc, 0xc1, 0xc1, 0xaa, 0x89, 0x5e, 0x05,
+	0x08, 0x93, 0x60, 0x9f, 0x50, 0x76, 0xf6, 0xe5, 0xfe, 0x6b, 0x3d, 0x2f, 0xba, 0x1d, 0x5f, 0x59,
+	0x30, 0xaf, 0x3b, 0x1c, 0xba, 0x0c, 0x4d, 0xd6, 0x26, 0x54, 0xed, 0xc5, 0x2b, 0xd3, 0x3d, 0xb3,
+	0x27, 0x50, 0x58, 0xa1, 0xbb, 0x77, 0xa0, 0x21, 0x7e, 0xa2, 0xf7, 0x0c, 0x73, 0xac, 0x18, 0xe6,
+	0x10, 0x10, 0xcd, 0x1a, 0x67, 0xc0, 0x0e, 0x92, 0x49, 0x9c, 0x71, 0xd5, 0x6c, 0x2c, 0x1a, 0xde,
+	0xd7, 0x16, 0x74, 0x4c, 0x1f, 0x46, 0x9f, 0x80, 0x23, 0x7a, 0x0a, 0xd5, 0x5e, 0x9f, 0xe5, 0xf0,
+	0x3d, 0x85, 0xc4, 0x85, 0x4c, 0xf7, 0x1e, 0xb4, 0x54, 0xe3, 0x99, 0x2a, 0x0a, 0xd0, 0x91, 0x2a,
+	0xfe, 0xdd, 0x82, 0x8e, 0x79, 0xb4, 0x99, 0x8a, 0xe2, 0x70, 0x4f, 0x55, 0xd1, 0x04, 0xcb, 0x26,
+	0x53, 0x31, 0x97, 0x41, 0xeb, 0xd0, 0x0c, 0x86, 0x13, 0x66, 0x21, 0xb7, 0x36, 0xc5, 0x97, 0xae,
+	0x8b, 0x31, 0xae, 0x9a, 0x02, 0x76, 0x1f, 0x40, 0x4b, 0x51, 0xa1, 0x0f, 0x8c, 0x65, 0x7d, 0xcb,
+	0x10, 0x56, 0xa0, 0x23, 0x17, 0xf6, 0x6f, 0x0b, 0xa0, 0xb8, 0x7e, 0xd1, 0x46, 0xf5, 0x78, 0xbe,
+	0x31, 0xed, 0x9e, 0xce, 0xcf, 0xa6, 0xbc, 0x34, 0x0b, 0x29, 0xb4, 0x06, 0x6d, 0x7f, 0x92, 0x25,
+	0x3b, 0x34, 0x1a, 0x0c, 0xe4, 0xd2, 0x5a, 0x58, 0xef, 0x42, 0x97, 0x01, 0xe4, 0xed, 0x98, 0x84,
+	0xc4, 0xad, 0x4f, 0xd9, 0x95, 0xed, 0x7c, 0x18, 0x6b, 0xd0, 0xee, 0xf7, 0xa1, 0x63, 0xce, 0x7b,
+	0x22, 0xef, 0xff, 0x09, 0x38, 0xf9, 0x0d, 0x85, 0xce, 0x41, 0x43, 0x10, 0x4b, 0x59, 0xd9, 0x2a,
+	0xe9, 0x56, 0x3b, 0xb6, 0x6e, 0xde, 0x4f, 0xa1, 0xad, 0x5d, 0x65, 0xff, 0x7f, 0xfe, 0x9f, 0x5b,
+	0xd0, 0xd6, 0x12, 0x9e, 0x99, 0x13, 0xbc, 0x3c, 0xf3, 0x7b, 0xff, 0xb1, 0x60, 0xb1, 0x9c, 0xe8,
+	0xcc, 0xd4, 0xe3, 0x36, 0x38, 0x94, 0xa4, 0xc9, 0x84, 0x06, 0x24, 0x75, 0x6b, 0xdc, 0x93, 0xde,
+	0x99, 0x9d, 0x2f, 0xf5, 0xb0, 0xc2, 0x4a, 0x7f, 0xca, 0x65, 0x5f, 0xc8, 0x5b, 0x4c, 0xd6, 0x13,
+	0x79, 0xcb, 0x16, 0x2c, 0x18, 0xf9, 0xd8, 0xf3, 0x1b, 0xdc, 0xfb, 0x53, 0x0b, 0x6c, 0x9e, 0x7f,
+	0xa0, 0x8f, 0xc0, 0xc9, 0x33, 0x79, 0x99, 0x6b, 0x74, 0x7b, 0x22, 0x95, 0xef, 0xa9, 0x54, 0xbe,
+	0xb7, 0xa3, 0x10, 0xb8, 0x00, 0xa3, 0x4b, 0xe0, 0xb0, 0x2c, 0x8c, 0xd3, 0xb8, 0xb5, 0x72, 0xe6,
+	0x75, 0x4f, 0x0d, 0x6d, 0x9e, 0xc2, 0x05, 0x0e, 0x6d, 0xc2, 0xa2, 0x2a, 0x40, 0xee, 0x26, 0x03,
+	0x21, 0x5b, 0xaf, 0xa4, 0xae, 0x25, 0xc4, 0xe6, 0x29, 0x5c, 0x91, 0x42, 0x0f, 0x61, 0xd9, 0x1f,
+	0x8f, 0x87, 0x51, 0xc0, 0xcb, 0x94, 0x9c, 0x4c, 0xe4, 0xc1, 0xda, 0xa5, 0xb1, 0x51, 0x05, 0x6d,
+	0x9e, 0xc2, 0xd3, 0x64, 0xd9, 0x8a, 0x32, 0x3f, 0xdd, 0x17, 0x44, 0x76, 0x25, 0x97, 0x54, 0x43,
+	0x6c, 0x45, 0x39, 0x0e, 0xdd, 0x81, 0x25, 0x51, 0x20, 0x4c, 0x76, 0x0b, 0xe1, 0x06, 0x17, 0x3e,
+	0x5f, 0x8e, 0x53, 0x1a, 0x64, 0xf3, 0x14, 0xae, 0xca, 0xa1, 0xfb, 0x80, 0x64, 0xd5, 0xa0, 0xb3,
+	0x89, 0x3c, 0x78, 0xb5, 0x52, 0x66, 0x98, 0x74, 0x53, 0x24, 0xd1, 0x15, 0x70, 0xc6, 0x09, 0xcd,
+	0x04, 0x4d, 0xeb, 0xa8, 0x64, 0x94, 0x2d, 0x2c, 0x87, 0xa3, 0x2f, 0x60, 0x45, 0xaf, 0x18, 0x74,
+	0x85, 0x44, 0xca, 0xfc, 0xfa, 0xf4, 0xc3, 0x63, 0x6a, 0x35, 0x8b, 0x03, 0x7d, 0x52, 0x14, 0x1f,
+	0x82, 0x14, 0x66, 0x15, 0x1f, 0x8a, 0xca, 0xc4, 0x33, 0xfd, 0xc2, 0xe9, 0x95, 0x85, 0xdb, 0x5e,
+	0xb3, 0x8e, 0x55, 0x82, 0x30, 0xfd, 0x66, 0x70, 0x30, 0x4f, 0xcd, 0x08, 0x1d, 0x45, 0x31, 0xf7,
+	0x11, 0xc1, 0x3b, 0x5f, 0xb6, 0xe0, 0x4e, 0x09, 0xc1, 0x3c, 0xb5, 0x2c, 0xc5, 0x36, 0x21, 0x23,
+	0xa9, 0xdc, 0x84, 0x85, 0x2a, 0x45, 0x9a, 0x95, 0x6c, 0x56, 0xc0, 0xd1, 0x0f, 0x54, 0xad, 0x22,
+	0xa4, 0x3b, 0x65, 0x4f, 0x90, 0x01, 0xde, 0x94, 0xd7, 0x45, 0x18, 0xc3, 0x01, 0xa1, 0xd1, 0xde,
+	0xa1, 0x60, 0x38, 0x5d, 0x66, 0x78, 0xc4, 0x07, 0xcb, 0x0c, 0x9a, 0xc8, 0xb5, 0x79, 0x00, 0xc2,
+	0x7e, 0x3c, 0x66, 0x97, 0xb6, 0xf7, 0x39, 0x2c, 0x96, 0x57, 0x3d, 0x33, 0x10, 0xbd, 0x03, 0x75,
+	0x42, 0xa9, 0x5b, 0x2b, 0xef, 0xec, 0x46, 0xc0, 0x64, 0xfd, 0xdd, 0x21, 0xb9, 0x49, 0x29, 0x66,
+	0x18, 0x96, 0x08, 0x2e, 0x18, 0xdd, 0xe8, 0x22, 0x34, 0x09, 0xa5, 0x3c, 0xc4, 0x5a, 0xcf, 0x0e,
+	0xb1, 0x0a, 0xc7, 0xd2, 0xd8, 0x11, 0x49, 0x53, 0x7f, 0xa0, 0xa2, 0xa7, 0x6a, 0xa2, 0x0f, 0xa1,
+	0x9d, 0x4e, 0x06, 0x03, 0x92, 0xb2, 0x19, 0x54, 0xf1, 0xad, 0xd5, 0xfb, 0xdb, 0xf9, 0x20, 0xd6,
+	0x81, 0xde, 0x43, 0x70, 0xf2, 0x48, 0xc6, 0x42, 0x33, 0x61, 0x51, 0x5b, 0xae, 0x52, 0x34, 0x8c,
+	0x8a, 0xb5, 0x76, 0x74, 0xc5, 0xea, 0xfd, 0x8e, 0xdd, 0x59, 0xe5, 0x68, 0xb6, 0x02, 0x4d, 0x66,
+	0xff, 0xc7, 0x51, 0xa8, 0x4c, 0xc8, 0x9a, 0x5b, 0x21, 0x7a, 0x05, 0x20, 0x9d, 0xec, 0xaa, 0x31,
+	0xb1, 0x2a, 0x47, 0xf6, 0x6c, 0x85, 0xe8, 0x3d, 0xb0, 0x87, 0xe4, 0x80, 0x0c, 0x79, 0xdc, 0xeb,
+	0xac, 0x9f, 0x35, 0x4c, 0x74, 0x37, 0x19, 0xdc, 0x65, 0x83, 0x58, 0x60, 0x74, 0xf3, 0xd8, 0x86,
+	0x79, 0x3e, 0x9d, 0x6b, 0xd5, 0x17, 0xe7, 0xbc, 0x3f, 0x5b, 0xb0, 0x3c, 0x25, 0x5c, 0xa2, 0x37,
+	0x61, 0x21, 0x50, 0x87, 0xe3, 0x7e, 0xf1, 0xa4, 0x62, 0x76, 0x32, 0xf6, 0x71, 0x12, 0xde, 0x2f,
+	0x4a, 0x0b, 0xd5, 0x64, 0xee, 0x31, 0xa6, 0x64, 0x2f, 0x7a, 0x2a, 0x8b, 0x0b, 0xd9, 0xd2, 0xf5,
+	0x99, 0x33, 0xb7, 0x6b, 0x1d, 0xce, 0xd0, 0x28, 0x78, 0x72, 0x2b, 0xa1, 0x23, 0x3f, 0xcb, 0x48,
+	0x78, 0xcf, 0x50, 0x7b, 0xea, 0x98, 0xf7, 0x57, 0x0b, 0x9c, 0x3c, 0x46, 0xa3, 0x0e, 0xd4, 0x72,
+	0x5b, 0xd6, 0xa2, 0x90, 0x55, 0x3d, 0xcc, 0x64, 0xaa, 0xea, 0x61, 0xbf, 0xd9, 0x3d, 0x19, 0x92,
+	0x34, 0xa0, 0xd1, 0x98, 0x2d, 0x57, 0x2a, 0xa7, 0x77, 0xa1, 0x55, 0x70, 0xa2, 0x8c, 0x50, 0x6e,
+	0x0e, 0xae, 0xa3, 0x8d, 0x8b, 0x0e, 0xcd, 0xed, 0x6d, 0xc3, 0xed, 0xaf, 0xc2, 0x82, 0xaf, 0xbb,
+	0xb2, 0xbc, 0x0e, 0x66, 0x1e, 0x00, 0x13, 0xed, 0xfd, 0xc3, 0x82, 0xa5, 0xca, 0x7d, 0x51, 0x59,
+	0x90, 0xe6, 0x31, 0x35, 0xc3, 0x63, 0xba, 0xd0, 0x52, 0xa9, 0xaf, 0x5c, 0x52, 0xde, 0x66, 0x56,
+	0x48, 0x33, 0x32, 0x96, 0xe6, 0xe6, 0xbf, 0x5f, 0xd2, 0x2a, 0x18, 0x2d, 0x25, 0x7e, 0x9a, 0xc4,
+	0xfc, 0xfa, 0x72, 0xb0, 0x6c, 0x79, 0xbf, 0xb1, 0x60, 0xb1, 0x1c, 0xf3, 0x8e, 0xbf, 0xb8, 0x42,
+	0xd9, 0xfa, 0xb3, 0x95, 0x9d, 0x3b, 0x91, 0xc9, 0xbf, 0xb2, 0x00, 0x55, 0x43, 0xe9, 0x37, 0xae,
+	0xd6, 0x1f, 0x2c, 0x40, 0xd5, 0xf8, 0x7c, 0x7c, 0xb5, 0x54, 0xa9, 0x5f, 0xd7, 0x4a, 0xfd, 0x42,
+	0xd5, 0xb9, 0x67, 0xab, 0x6a, 0x9f, 0xd8, 0x82, 0xd5, 0xb4, 0xe4, 0x1b, 0xb7, 0xe0, 0xaf, 0x6a,
+	0xb0, 0x32, 0x23, 0x39, 0x39, 0xd1, 0x89, 0x52, 0xc9, 0xbf, 0x3a, 0x51, 0xaa, 0x3d, 0xd3, 0x9c,
+	0x33, 0x63, 0x6d, 0xa9, 0x7a, 0x68, 0x1c, 0xbb, 0x7a, 0xa8, 0x9a, 0xa2, 0x79, 0x22, 0x53, 0xfc,
+	0xb7, 0x0e, 0x8b, 0xe5, 0x8c, 0xef, 0xf8, 0x36, 0x58, 0x05, 0x67, 0x98, 0x04, 0xfe, 0x90, 0x31,
+	0x70, 0x23, 0xd8, 0xb8, 0xe8, 0xd0, 0x63, 0xff, 0x9c, 0x19, 0xfb, 0x2b, 0x77, 0x87, 0x3d, 0xed,
+	0xee, 0x58, 0x05, 0x87, 0x39, 0x67, 0x3a, 0xf6, 0x03, 0x61, 0x12, 0x07, 0x17, 0x1d, 0xcc, 0xfe,
+	0x2c, 0x2d, 0xe5, 0xe2, 0x22, 0x98, 0xe4, 0x6d, 0xe4, 0xc1, 0xbc, 0xda, 0x0b, 0xf6, 0xb2, 0xc0,
+	0x93, 0x5c, 0x07, 0x1b, 0x7d, 0x3a, 0x86, 0x73, 0x38, 0x26, 0x46, 0xdd, 0x5e, 0x7e, 0x18, 0x52,
+	0x92, 0xa6, 0x3c, 0x11, 0x75, 0xb0, 0x6a, 0xa2, 0xef, 0x01, 0x64, 0x3e, 0x1d, 0x90, 0x8c, 0x2f,
+	0xbd, 0x5d, 0x7e, 0x2d, 0xde, 0x8a, 0xb3, 0x07, 0x74, 0x3b, 0xa3, 0x51, 0x3c, 0xc0, 0x1a, 0x50,
+	0x73, 0x8c, 0x79, 0xc3, 0x31, 0x8a, 0xb8, 0xb8, 0xa0, 0xc7, 0xc5, 0xea, 0xee, 0x76, 0x4e, 0x14,
+	0x6e, 0xc5, 0x1a, 0x09, 0x3d, 0x10, 0x4f, 0xd3, 0x3c, 0xcf, 0xb3, 0xb1, 0xd1, 0xe7, 0xfd, 0xc5,
+	0x2a, 0x2a, 0xc8, 0x93, 0x6f, 0x3f, 0xcb, 0xbe, 0xaf, 0xf3, 0xe7, 0x1a, 0xb9, 0xfd, 0x79, 0x07,
+	0x4b, 0x8c, 0xa2, 0x51, 0x71, 0x8d, 0x8b, 0xc6, 0xcb, 0xba, 0x1e, 0xbf, 0xae, 0xc3, 0xca, 0x8c,
+	0x7c, 0xfe, 0xc5, 0xc3, 0xcd, 0x4b, 0x77, 0xe4, 0xfc, 0x6a, 0x6e, 0x96, 0xae, 0x66, 0x17, 0x9a,
+	0x74, 0x12, 0xb3, 0xf2, 0x5a, 0xfa, 0xb0, 0x6a, 0xb2, 0x27, 0xd8, 0x2f, 0x13, 0xba, 0x1f, 0xc5,
+	0x83, 0x1b, 0x11, 0x95, 0xce, 0xab, 0xf5, 0xa0, 0x87, 0x00, 0xbc, 0x88, 0x11, 0xdf, 0x95, 0x80,
+	0xa7, 0xb6, 0x17, 0x8f, 0xac, 0x7d, 0x7a, 0x37, 0x72, 0x19, 0xf9, 0xbc, 0x5c, 0x90, 0xb0, 0x57,
+	0xdd, 0xd2, 0xf0, 0x51, 0x2f, 0x15, 0x0b, 0xfa, 0x4b, 0xc5, 0x55, 0x58, 0xfa, 0x3c, 0x25, 0x74,
+	0x2b, 0xce, 0x48, 0x9c, 0xa9, 0xef, 0x71, 0x6f, 0x43, 0x23, 0xe2, 0x1d, 0xf2, 0x99, 0x61, 0xd1,
+	0x38, 0x43, 0x0c, 0x28, 0xc7, 0xbd, 0x8f, 0xa1, 0x23, 0x1f, 0x2a, 0x94, 0xec, 0xfb, 0xe6, 0xb7,
+	0x41, 0xfd, 0x6b, 0x85, 0x00, 0x1a, 0x9f, 0x08, 0x2f, 0xc2, 0xbc, 0xde, 0x8d, 0xba, 0xd0, 0x24,
+	0xdc, 0x7d, 0x84, 0x6b, 0xb4, 0x36, 0x4f, 0x61, 0xd5, 0x71, 0xcd, 0x86, 0xfa, 0x81, 0x3f, 0xf4,
+	0x3e, 0x85, 0x86, 0x50, 0x82, 0xad, 0xaa, 0xf8, 0xf0, 0xd2, 0x52, 0xdf, 0x57, 0x58, 0xe2, 0x74,
+	0x18, 0x07, 0xf2, 0x2d, 0x85, 0xff, 0x66, 0x3e, 0x24, 0xbf, 0xb9, 0xd4, 0x79, 0xaf, 0x6c, 0x79,
+	0x11, 0x40, 0x51, 0x4e, 0xa0, 0xeb, 0xd0, 0x29, 0x0a, 0x0a, 0xad, 0x9a, 0x39, 0x6f, 0x86, 0x7c,
+	0x03, 0x82, 0x4b, 0x22, 0x6c, 0x2a, 0x71, 0x08, 0x94, 0x1b, 0x8b, 0x96, 0xf7, 0x10, 0xda, 0x5a,
+	0xfc, 0x61, 0x5a, 0xe6, 0xef, 0xaf, 0xb6, 0x7c, 0x64, 0x3d, 0xc7, 0xcd, 0xfe, 0xc8, 0x1f, 0xca,
+	0x57, 0x56, 0xd9, 0x12, 0x27, 0x80, 0xb2, 0xfe, 0xfc, 0x04, 0xb0, 0xd6, 0xfa, 0x1f, 0x1b, 0xb0,
+	0xa4, 0xca, 0x93, 0x47, 0xeb, 0xdb, 0x84, 0x1e, 0x44, 0x01, 0x41, 0xb7, 0xa0, 0x75, 0x9b, 0xa8,
+	0x87, 0xca, 0xca, 0xfb, 0xd0, 0x4d, 0xf6, 0xa9, 0xb7, 0x5b, 0xfe, 0x62, 0xeb, 0x2d, 0xfd, 0xe2,
+	0x6f, 0xff, 0xfa, 0x6d, 0xad, 0x8d, 0x9c, 0x3e, 0xfb, 0xee, 0xcc, 0x65, 0x6f, 0x43, 0x83, 0x7b,
+	0x5f, 0x7a, 0x1c, 0x16, 0x8e, 0xf4, 0x10, 0x67, 0x99, 0x47, 0xc0, 0x58, 0x78, 0x21, 0x9a, 0x5e,
+	0xb0, 0xd0, 0x0f, 0xe1, 0xb4, 0x59, 0xaa, 0x9c, 0x80, 0xf1, 0x3c, 0x67, 0x3c, 0x8b, 0x96, 0x19,
+	0xa3, 0xf9, 0x10, 0xc4, 0xa8, 0xb7, 0x61, 0x5e, 0xab, 0xcf, 0x4e, 0xc0, 0xeb, 0x72, 0x5e, 0x84,
+	0x16, 0xfb, 0xda, 0x77, 0x76, 0x49, 0xfa, 0x63, 0x68, 0xde, 0x7c, 0x4a, 0x82, 0x49, 0x46, 0x90,
+	0xf6, 0x2c, 0x54, 0x39, 0x25, 0xdd, 0x19, 0x93, 0x29, 0x9d, 0xbd, 0x36, 0xb7, 0x82, 0x60, 0xba,
+	0x22, 0x0f, 0x0c, 0x0a, 0xc1, 0xd9, 0x98, 0x64, 0x09, 0x2f, 0x1a, 0x90, 0x5b, 0x39, 0x1c, 0x47,
+	0x71, 0xbf, 0xc5, 0xb9, 0x5f, 0xeb, 0x9e, 0x63, 0xdc, 0xdc, 0xdf, 0xfb, 0xfe, 0x24, 0x4b, 0x1e,
+	0xab, 0x69, 0xc4, 0xb1, 0x42, 0xbb, 0xd0, 0x62, 0xb3, 0xb0, 0xdb, 0xe3, 0x39, 0x26, 0x79, 0x93,
+	0x4f, 0xf2, 0x6a, 0xf7, 0x2c, 0x37, 0xce, 0x61, 0x1c, 0x4c, 0x9d, 0x63, 0x0f, 0x80, 0xcd, 0x21,
+	0x32, 0xc9, 0xe7, 0x98, 0xe5, 0xdb, 0x7c, 0x96, 0xb5, 0xee, 0x0a, 0x9b, 0x45, 0x9c, 0xc7, 0xa9,
+	0xf3, 0x3c, 0x80, 0xc6, 0xa6, 0x1f, 0x87, 0x43, 0x82, 0xca, 0xbb, 0x38, 0x93, 0x7a, 0x95, 0x53,
+	0x9f, 0xf3, 0x96, 0x0a, 0x3f, 0xec, 0x3f, 0xe1, 0x1c, 0x57, 0xac, 0x77, 0xaf, 0x5d, 0xfa, 0xd1,
+	0xc5, 0x41, 0x94, 0x3d, 0x99, 0xec, 0xf6, 0x82, 0x64, 0xd4, 0xbf, 0xcd, 0x19, 0xf2, 0x80, 0xbb,
+	0x93, 0x24, 0xc3, 0x34, 0xf7, 0x08, 0xf1, 0x27, 0x12, 0xfd, 0x83, 0xf5, 0xcf, 0xea, 0xbb, 0x0d,
+	0xfe, 0xfb, 0xd2, 0xff, 0x06, 0x00, 0x96, 0x9a, 0x53, 0x49, 0x9a, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,6 +30,8 @@ import (
 	"sync/atomic"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/client"
 	reg "github.com/docker/docker/registry"
@@ -70,6 +72,12 @@ type FakeAPIClient struct {
 	Built []types.ImageBuildOptions
 	// ref -> [id]
 	LocalImages map[string][]string
+
+	Containers []types.Container
+	// container id -> state
+	ContainerStates map[string]*types.ContainerState
+	// names of the created containers, in creation order
+	CreatedContainers []string
 }
 
 func (f *FakeAPIClient) ServerVersion(ctx context.Context) (types.Version, error) {
@@ -306,6 +314,35 @@ func (f *FakeAPIClient) DiskUsage(ctx context.Context) (types.DiskUsage, error)
 	}, nil
 }
 
+func (f *FakeAPIClient) ContainerList(ctx context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	return f.Containers, nil
+}
+
+func (f *FakeAPIClient) ContainerInspect(ctx context.Context, id string) (types.ContainerJSON, error) {
+	state, found := f.ContainerStates[id]
+	if !found {
+		return types.ContainerJSON{}, fmt.Errorf("no such container: %s", id)
+	}
+	return types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID:    id,
+			State: state,
+		},
+	}, nil
+}
+
+func (f *FakeAPIClient) ContainerCreate(_ context.Context, _ *container.Config, _ *container.HostConfig, _ *network.NetworkingConfig, _ *v1.Platform, name string) (container.ContainerCreateCreatedBody, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.CreatedContainers = append(f.CreatedContainers, name)
+	return container.ContainerCreateCreatedBody{ID: "id-" + name}, nil
+}
+
+func (f *FakeAPIClient) ContainerStart(context.Context, string, types.ContainerStartOptions) error {
+	return nil
+}
+
 func (f *FakeAPIClient) Close() error { return nil }
 
 // TODO(dgageot): create something that looks more like an actual tar file.